@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package AWSR003
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for sensitive string arguments without a write-only counterpart
+
+The AWSR003 analyzer reports when a schema map contains an Optional or
+Required TypeString attribute with Sensitive set to true, but no matching
+"<name>_wo" attribute. Write-only arguments are never persisted to the
+Terraform plan or state, so every secret input should offer one.
+
+Reviewed exceptions are listed in the analyzer's allowlist.
+`
+
+const analyzerName = "AWSR003"
+
+const (
+	schemaMapTypeString = "map[string]*github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema.Schema"
+	writeOnlySuffix     = "_wo"
+)
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.CompositeLit)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		compositeLit := n.(*ast.CompositeLit)
+
+		if t := pass.TypesInfo.TypeOf(compositeLit); t == nil || types.TypeString(t, nil) != schemaMapTypeString {
+			return
+		}
+
+		attributeNames := make(map[string]struct{}, len(compositeLit.Elts))
+		for _, elt := range compositeLit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if name, ok := stringValue(pass, kv.Key); ok {
+					attributeNames[name] = struct{}{}
+				}
+			}
+		}
+
+		for _, elt := range compositeLit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+
+			name, ok := stringValue(pass, kv.Key)
+			if !ok || strings.HasSuffix(name, writeOnlySuffix) {
+				continue
+			}
+
+			if !isSensitiveStringArgument(kv.Value) {
+				continue
+			}
+
+			if _, ok := attributeNames[name+writeOnlySuffix]; ok {
+				continue
+			}
+
+			if isAllowlisted(pass.Fset.Position(kv.Pos()).Filename, name) {
+				continue
+			}
+
+			pass.Reportf(kv.Key.Pos(), "%s: sensitive argument %q should have a write-only %q counterpart", analyzerName, name, name+writeOnlySuffix)
+		}
+	})
+
+	return nil, nil
+}
+
+// isSensitiveStringArgument returns whether the expression is a schema.Schema literal declaring an Optional or Required, Sensitive TypeString attribute that is not itself WriteOnly.
+func isSensitiveStringArgument(expr ast.Expr) bool {
+	if unaryExpr, ok := expr.(*ast.UnaryExpr); ok {
+		expr = unaryExpr.X
+	}
+
+	compositeLit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+
+	var isString, isArgument, isSensitive, isWriteOnly bool
+	for _, elt := range compositeLit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+
+		field, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch field.Name {
+		case "Type":
+			if selectorExpr, ok := kv.Value.(*ast.SelectorExpr); ok && selectorExpr.Sel.Name == "TypeString" {
+				isString = true
+			}
+		case "Optional", "Required":
+			if isTrue(kv.Value) {
+				isArgument = true
+			}
+		case "Sensitive":
+			isSensitive = isTrue(kv.Value)
+		case "WriteOnly":
+			isWriteOnly = isTrue(kv.Value)
+		}
+	}
+
+	return isString && isArgument && isSensitive && !isWriteOnly
+}
+
+func isTrue(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+
+	return ok && ident.Name == "true"
+}
+
+// stringValue returns the value of a string literal or constant expression, such as names.AttrPassword.
+func stringValue(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	tv, ok := pass.TypesInfo.Types[expr]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(tv.Value), true
+}
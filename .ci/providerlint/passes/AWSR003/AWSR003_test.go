@@ -0,0 +1,22 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package AWSR003
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAWSR003(t *testing.T) {
+	allowlist["a/allowlisted.go"] = map[string]string{
+		"password": "testing",
+	}
+	t.Cleanup(func() {
+		delete(allowlist, "a/allowlisted.go")
+	})
+
+	testdata := analysistest.TestData()
+	analysistest.Run(t, testdata, Analyzer, "testdata/src/a")
+}
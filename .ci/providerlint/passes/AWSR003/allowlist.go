@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package AWSR003
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// allowlist contains the reviewed sensitive arguments that are exempt from the check, keyed by
+// source file path (relative to the repository root) and then by attribute name, with the reason.
+// Additions require review: a genuine secret input should get a write-only counterpart instead.
+var allowlist = map[string]map[string]string{
+	// Identifiers and other values that are not secrets.
+	"internal/service/cloudcontrol/resource.go": {
+		"schema": "CloudFormation resource type schema, not a secret",
+	},
+	"internal/service/dms/certificate.go": {
+		"certificate_pem":    "public certificate, not a secret",
+		"certificate_wallet": "Oracle wallet certificate, not a secret",
+	},
+	"internal/service/ds/shared_directory.go": {
+		"notes": "free-form notes shown to the directory consumer, not a secret",
+	},
+	"internal/service/elasticsearch/domain_saml_options.go": {
+		"master_user_name": "user name, not a secret",
+	},
+	"internal/service/events/connection.go": {
+		"value": "HTTP parameter value is only secret when is_value_secret is set and is nested in lists without MaxItems: 1",
+	},
+	"internal/service/iot/ca_certificate.go": {
+		"ca_certificate_pem":           "public certificate, not a secret",
+		"verification_certificate_pem": "public certificate, not a secret",
+	},
+	"internal/service/iot/certificate.go": {
+		"ca_pem":          "public certificate, not a secret",
+		"certificate_pem": "public certificate, not a secret",
+	},
+	"internal/service/opensearch/domain_saml_options.go": {
+		"master_user_name": "user name, not a secret",
+	},
+	"internal/service/pinpoint/adm_channel.go": {
+		"client_id": "OAuth client identifier, not a secret",
+	},
+	"internal/service/pinpoint/apns_channel.go": {
+		"bundle_id":    "application identifier, not a secret",
+		"certificate":  "public certificate, not a secret",
+		"team_id":      "Apple team identifier, not a secret",
+		"token_key_id": "signing key identifier, not a secret",
+	},
+	"internal/service/pinpoint/apns_sandbox_channel.go": {
+		"bundle_id":    "application identifier, not a secret",
+		"certificate":  "public certificate, not a secret",
+		"team_id":      "Apple team identifier, not a secret",
+		"token_key_id": "signing key identifier, not a secret",
+	},
+	"internal/service/pinpoint/apns_voip_channel.go": {
+		"bundle_id":    "application identifier, not a secret",
+		"certificate":  "public certificate, not a secret",
+		"team_id":      "Apple team identifier, not a secret",
+		"token_key_id": "signing key identifier, not a secret",
+	},
+	"internal/service/pinpoint/apns_voip_sandbox_channel.go": {
+		"bundle_id":    "application identifier, not a secret",
+		"certificate":  "public certificate, not a secret",
+		"team_id":      "Apple team identifier, not a secret",
+		"token_key_id": "signing key identifier, not a secret",
+	},
+	"internal/service/quicksight/schema/data_source.go": {
+		"username": "user name, not a secret",
+	},
+	"internal/service/redshiftserverless/namespace.go": {
+		"admin_username": "user name, not a secret",
+	},
+	"internal/service/s3/object_copy.go": {
+		"kms_encryption_context": "encryption context, not a secret",
+		"kms_key_id":             "KMS key ARN, not a secret",
+	},
+	"internal/service/sns/platform_application.go": {
+		"platform_principal": "platform principal such as a certificate or client ID, not a secret",
+	},
+	"internal/service/transfer/certificate.go": {
+		"certificate":       "public certificate, not a secret",
+		"certificate_chain": "public certificate chain, not a secret",
+	},
+	"internal/service/transfer/server.go": {
+		"post_authentication_login_banner": "login banner text, not a secret",
+		"pre_authentication_login_banner":  "login banner text, not a secret",
+	},
+
+	// Values that the API returns on read, so a write-only argument would not keep them out of state.
+	"internal/service/apigateway/api_key.go": {
+		"value": "returned by the API when the key is read",
+	},
+	"internal/service/appconfig/hosted_configuration_version.go": {
+		"content": "returned by the API when the configuration version is read",
+	},
+	"internal/service/ssm/maintenance_window_task.go": {
+		"input":   "returned by the API when the task is read",
+		"payload": "returned by the API when the task is read",
+	},
+
+	// Schemas that cannot hold write-only attributes.
+	"internal/service/amplify/app.go": {
+		"basic_auth_credentials": "nested in a Computed block",
+	},
+	"internal/service/appconfig/configuration_profile.go": {
+		"content": "nested in a TypeSet block",
+	},
+	"internal/service/chime/voice_connector_termination_credentials.go": {
+		"password": "nested in a TypeSet block",
+	},
+	"internal/service/elasticache/replication_group_migrate.go": {
+		"auth_token": "prior schema version used only for state upgrades",
+	},
+	"internal/service/elasticsearch/domain.go": {
+		"master_user_password": "nested in a Computed block, the write-only counterpart is the top-level master_user_password_wo argument",
+	},
+	"internal/service/fsx/ontap_storage_virtual_machine_migrate.go": {
+		"password":           "prior schema version used only for state upgrades",
+		"svm_admin_password": "prior schema version used only for state upgrades",
+	},
+	"internal/service/kms/ciphertext_data_source.go": {
+		"plaintext": "data source argument, data sources have no write-only arguments",
+	},
+	"internal/service/mq/broker.go": {
+		"password": "nested in a TypeSet block, the write-only counterpart is the top-level user_passwords_wo argument",
+	},
+	"internal/service/opensearch/domain.go": {
+		"master_user_password": "nested in a Computed block, the write-only counterpart is the top-level master_user_password_wo argument",
+	},
+	"internal/service/rds/cluster_migrate.go": {
+		"master_password": "prior schema version used only for state upgrades",
+	},
+	"internal/service/rds/instance_migrate.go": {
+		"password": "prior schema version used only for state upgrades",
+	},
+	"internal/service/sesv2/email_identity.go": {
+		"domain_signing_private_key": "nested in a Computed block",
+	},
+}
+
+// isAllowlisted returns whether the named attribute declared in the file is exempt from the check.
+func isAllowlisted(filename, name string) bool {
+	filename = filepath.ToSlash(filename)
+
+	for path, attributes := range allowlist {
+		if _, ok := attributes[name]; ok && (filename == path || strings.HasSuffix(filename, "/"+path)) {
+			return true
+		}
+	}
+
+	return false
+}
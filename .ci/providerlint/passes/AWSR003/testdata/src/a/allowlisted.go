@@ -0,0 +1,30 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func g() {
+	/* Allowlisted cases */
+
+	_ = map[string]*schema.Schema{
+		"password": {
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	}
+
+	/* Failing cases */
+
+	_ = map[string]*schema.Schema{
+		"secret": { // want "sensitive argument \"secret\" should have a write-only \"secret_wo\" counterpart"
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	}
+}
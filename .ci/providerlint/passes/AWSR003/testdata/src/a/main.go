@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package a
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const attrPassword = "password"
+
+func f() {
+	/* Passing cases */
+
+	_ = map[string]*schema.Schema{
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"password_wo": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			WriteOnly: true,
+			Sensitive: true,
+		},
+	}
+
+	_ = map[string]*schema.Schema{
+		"secret": {
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		"count": {
+			Type:      schema.TypeInt,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+
+	/* Failing cases */
+
+	_ = map[string]*schema.Schema{
+		//lintignore:AWSR003
+		"password": { // want "sensitive argument \"password\" should have a write-only \"password_wo\" counterpart"
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	}
+
+	_ = map[string]*schema.Schema{
+		"password": { // want "sensitive argument \"password\" should have a write-only \"password_wo\" counterpart"
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+
+	_ = map[string]*schema.Schema{
+		attrPassword: &schema.Schema{ // want "sensitive argument \"password\" should have a write-only \"password_wo\" counterpart"
+			Type:      schema.TypeString,
+			Required:  true,
+			Sensitive: true,
+		},
+	}
+}
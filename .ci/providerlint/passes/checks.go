@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSAT006"
 	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR001"
 	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR002"
+	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSR003"
 	"github.com/hashicorp/terraform-provider-aws/ci/providerlint/passes/AWSV001"
 	"golang.org/x/tools/go/analysis"
 )
@@ -25,5 +26,6 @@ var AllChecks = []*analysis.Analyzer{
 	AWSAT006.Analyzer,
 	AWSR001.Analyzer,
 	AWSR002.Analyzer,
+	AWSR003.Analyzer,
 	AWSV001.Analyzer,
 }
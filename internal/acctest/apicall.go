@@ -9,7 +9,11 @@ package acctest
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -19,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 )
 
 // APICallRecorderWrapper returns a [ConfigureWrapper] that attaches rec
@@ -139,3 +144,167 @@ func formatCalls(calls []apicall.Call) string {
 	}
 	return strings.Join(parts, ", ")
 }
+
+// apiCallLogDir returns the directory to which API call logs are written,
+// or "" if API call logging is disabled.
+//
+// API call logging is opt-in via the TF_ACC_API_CALL_LOG_DIR environment
+// variable. When enabled, every AWS SDK operation made by an acceptance test
+// is written to <dir>/<test name>.jsonl (see apicall.WriteLog) when the test
+// completes. internal/generate/iampolicy aggregates the logs into a
+// per-resource IAM permissions manifest.
+func apiCallLogDir() string {
+	return os.Getenv(envvar.AccAPICallLogDir)
+}
+
+// apiCallLog holds the recorders whose calls are written to a test's API
+// call log.
+type apiCallLog struct {
+	mu        sync.Mutex
+	recorder  *apicall.Recorder   // Attached to clients that have no recorder of their own.
+	recorders []*apicall.Recorder // Distinct recorders attached to the test's clients.
+}
+
+func (l *apiCallLog) add(rec *apicall.Recorder) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !slices.Contains(l.recorders, rec) {
+		l.recorders = append(l.recorders, rec)
+	}
+}
+
+func (l *apiCallLog) calls() []apicall.Call {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var calls []apicall.Call
+	for _, rec := range l.recorders {
+		calls = append(calls, rec.Calls()...)
+	}
+	return calls
+}
+
+// apiCallLogs holds the API call log of each running test.
+var apiCallLogs sync.Map // map[*testing.T]*apiCallLog
+
+// apiCallLogForTest returns t's API call log, registering a cleanup that
+// writes the log to dir on first use.
+func apiCallLogForTest(t *testing.T, dir string) *apiCallLog {
+	v, loaded := apiCallLogs.LoadOrStore(t, &apiCallLog{recorder: apicall.NewRecorder()})
+	l := v.(*apiCallLog)
+	if !loaded {
+		t.Cleanup(func() {
+			apiCallLogs.Delete(t)
+
+			if err := writeAPICallLog(dir, t.Name(), l.calls()); err != nil {
+				t.Errorf("writing API call log: %s", err)
+			}
+		})
+	}
+	return l
+}
+
+func writeAPICallLog(dir, testName string, calls []apicall.Call) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.Create(filepath.Join(dir, strings.ReplaceAll(testName, "/", "_")+".jsonl"))
+	if err != nil {
+		return err
+	}
+
+	if err := apicall.WriteLog(f, calls); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// apiCallLogConfigureWrapper returns a [ConfigureWrapper] that adds the
+// AWS SDK operations made through the configured *conns.AWSClient to t's
+// API call log, or nil if API call logging is disabled.
+//
+// A client with no recorder of its own (see [APICallRecorderWrapper]) is
+// given the log's recorder. The wrapper must run outside any
+// APICallRecorderWrapper so that it sees the recorder that wrapper attaches.
+func apiCallLogConfigureWrapper(t *testing.T) ConfigureWrapper {
+	dir := apiCallLogDir()
+	if dir == "" {
+		return nil
+	}
+
+	l := apiCallLogForTest(t, dir)
+
+	return func(next schema.ConfigureContextFunc) schema.ConfigureContextFunc {
+		return func(ctx context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
+			v, ds := next(ctx, d)
+			if c, ok := v.(*conns.AWSClient); ok && c != nil {
+				rec := c.CallRecorder()
+				if rec == nil {
+					rec = l.recorder
+					c.SetCallRecorder(rec)
+				}
+				l.add(rec)
+			}
+			return v, ds
+		}
+	}
+}
+
+// apiCallLogAutoWrapDisabledTests holds the set of *testing.T values whose
+// factories were built by [ProtoV5ProviderFactoriesWithWrappers] with API
+// call logging composed in. Cleared on test cleanup.
+var apiCallLogAutoWrapDisabledTests sync.Map // map[*testing.T]struct{}
+
+// disableAPICallLogAutoWrap signals to [apiCallLogTestCase] that t's
+// factories already include API call logging.
+func disableAPICallLogAutoWrap(t *testing.T) {
+	if _, loaded := apiCallLogAutoWrapDisabledTests.LoadOrStore(t, struct{}{}); !loaded {
+		t.Cleanup(func() { apiCallLogAutoWrapDisabledTests.Delete(t) })
+	}
+}
+
+// isAPICallLogAutoWrapDisabled reports whether [disableAPICallLogAutoWrap]
+// has been called for t.
+func isAPICallLogAutoWrapDisabled(t *testing.T) bool {
+	_, ok := apiCallLogAutoWrapDisabledTests.Load(t)
+	return ok
+}
+
+// apiCallLogTestCase replaces any ProtoV5ProviderFactories at the test case
+// or step level with factories that write to t's API call log.
+//
+// The replacement factories are built by [ProtoV5ProviderFactoriesWithWrappers],
+// which also composes VCR when enabled.
+func apiCallLogTestCase(ctx context.Context, t *testing.T, c *resource.TestCase) {
+	t.Helper()
+
+	if isAPICallLogAutoWrapDisabled(t) {
+		return
+	}
+
+	var factory func() (tfprotov5.ProviderServer, error)
+	replace := func(input map[string]func() (tfprotov5.ProviderServer, error)) map[string]func() (tfprotov5.ProviderServer, error) {
+		if factory == nil {
+			factory = ProtoV5ProviderFactoriesWithWrappers(ctx, t)[ProviderName]
+		}
+
+		output := make(map[string]func() (tfprotov5.ProviderServer, error), len(input))
+		for name := range input {
+			output[name] = factory
+		}
+		return output
+	}
+
+	if c.ProtoV5ProviderFactories != nil {
+		c.ProtoV5ProviderFactories = replace(c.ProtoV5ProviderFactories)
+	}
+	for i := range c.Steps {
+		if c.Steps[i].ProtoV5ProviderFactories != nil {
+			c.Steps[i].ProtoV5ProviderFactories = replace(c.Steps[i].ProtoV5ProviderFactories)
+		}
+	}
+}
@@ -6,13 +6,17 @@ package acctest
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -170,3 +174,67 @@ func TestAPICallRecorderWrapper(t *testing.T) {
 		_, _ = wrapped(context.Background(), nil)
 	})
 }
+
+func TestAPICallLogConfigureWrapper(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(envvar.AccAPICallLogDir, dir)
+
+	var testName string
+	t.Run("log", func(t *testing.T) {
+		testName = t.Name()
+
+		own := apicall.NewRecorder()
+		clients := []*conns.AWSClient{{}, {}}
+		clients[1].SetCallRecorder(own)
+
+		for _, client := range clients {
+			original := func(_ context.Context, _ *schema.ResourceData) (any, diag.Diagnostics) {
+				return client, nil
+			}
+			if _, diags := apiCallLogConfigureWrapper(t)(original)(context.Background(), nil); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		}
+
+		if clients[0].CallRecorder() == nil {
+			t.Fatal("expected recorder to be attached")
+		}
+		if clients[1].CallRecorder() != own {
+			t.Error("replaced the client's own recorder")
+		}
+
+		clients[0].CallRecorder().RecordCall(apicall.Call{
+			Service:   "Pinpoint",
+			Operation: "CreateApp",
+			Scope:     apicall.Scope{TypeName: "aws_pinpoint_app", Handler: apicall.HandlerCreate},
+		})
+		own.Record("Pinpoint", "GetApp", nil)
+	})
+
+	f, err := os.Open(filepath.Join(dir, strings.ReplaceAll(testName, "/", "_")+".jsonl"))
+	if err != nil {
+		t.Fatalf("opening API call log: %s", err)
+	}
+	defer f.Close()
+
+	entries, err := apicall.ReadLog(f)
+	if err != nil {
+		t.Fatalf("reading API call log: %s", err)
+	}
+
+	want := []apicall.LogEntry{
+		{Service: "Pinpoint", Operation: "CreateApp", TypeName: "aws_pinpoint_app", Handler: apicall.HandlerCreate},
+		{Service: "Pinpoint", Operation: "GetApp"},
+	}
+	if diff := cmp.Diff(entries, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAPICallLogConfigureWrapper_Disabled(t *testing.T) {
+	t.Setenv(envvar.AccAPICallLogDir, "")
+
+	if apiCallLogConfigureWrapper(t) != nil {
+		t.Error("expected nil wrapper when API call logging is disabled")
+	}
+}
@@ -51,6 +51,7 @@ func chainConfigureWrappers(inner schema.ConfigureContextFunc, wrappers ...Confi
 // true at construction time, a VCR wrapper is prepended to the chain
 // and the test is marked so [Test] / [ParallelTest] do not double-wrap.
 // Tests therefore behave correctly under VCR without any code change.
+// API call logging (TF_ACC_API_CALL_LOG_DIR) is composed in the same way.
 //
 // Example:
 //
@@ -72,6 +73,11 @@ func ProtoV5ProviderFactoriesWithWrappers(
 		disableVCRAutoWrap(t)
 	}
 
+	apiCallLogWrapper := apiCallLogConfigureWrapper(t)
+	if apiCallLogWrapper != nil {
+		disableAPICallLogAutoWrap(t)
+	}
+
 	return map[string]func() (tfprotov5.ProviderServer, error){
 		ProviderName: func() (tfprotov5.ProviderServer, error) {
 			providerServerFactory, primary, err := provider.ProtoV5ProviderServerFactory(ctx)
@@ -80,10 +86,15 @@ func ProtoV5ProviderFactoriesWithWrappers(
 			}
 
 			chain := wrappers
+			if apiCallLogWrapper != nil {
+				// API call logging runs outside the caller's wrappers so that it
+				// sees any recorder attached by APICallRecorderWrapper.
+				chain = append([]ConfigureWrapper{apiCallLogWrapper}, chain...)
+			}
 			if vcrEnabled {
 				// VCR runs outermost so its HTTP-client swap and provider-identity
 				// cache happen around any inner wrapper.
-				chain = append([]ConfigureWrapper{vcrConfigureWrapper(primary, t)}, wrappers...)
+				chain = append([]ConfigureWrapper{vcrConfigureWrapper(primary, t)}, chain...)
 			}
 			primary.ConfigureContextFunc = chainConfigureWrappers(primary.ConfigureContextFunc, chain...)
 
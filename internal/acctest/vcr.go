@@ -508,10 +508,14 @@ func stepFactoriesPresent(c *resource.TestCase) bool {
 	return false
 }
 
-// ParallelTest wraps resource.ParallelTest, initializing VCR if enabled
+// ParallelTest wraps resource.ParallelTest, initializing VCR and API call logging if enabled
 func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
+	if apiCallLogDir() != "" {
+		apiCallLogTestCase(ctx, t, &c)
+	}
+
 	if vcr.IsEnabled() {
 		if !vcrTestCase(ctx, t, &c) {
 			t.Skip("ProtoV5ProviderFactories not set at TestCase or TestStep level")
@@ -522,10 +526,14 @@ func ParallelTest(ctx context.Context, t *testing.T, c resource.TestCase) {
 	resource.ParallelTest(t, c)
 }
 
-// Test wraps resource.Test, initializing VCR if enabled
+// Test wraps resource.Test, initializing VCR and API call logging if enabled
 func Test(ctx context.Context, t *testing.T, c resource.TestCase) {
 	t.Helper()
 
+	if apiCallLogDir() != "" {
+		apiCallLogTestCase(ctx, t, &c)
+	}
+
 	if vcr.IsEnabled() {
 		if !vcrTestCase(ctx, t, &c) {
 			t.Skip("ProtoV5ProviderFactories not set at TestCase or TestStep level")
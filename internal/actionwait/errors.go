@@ -7,6 +7,8 @@ import (
 	"errors"
 	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 // TimeoutError is returned when the operation does not reach a success state within Timeout.
@@ -19,6 +21,10 @@ func (e *TimeoutError) Error() string {
 	return "timeout waiting for target status after " + e.Timeout.String()
 }
 
+func (e *TimeoutError) DiagnosticCode() errs.Code {
+	return errs.CodeWaitTimeout
+}
+
 // FailureStateError indicates the operation entered a declared failure state.
 type FailureStateError struct {
 	Status Status
@@ -51,6 +57,8 @@ var (
 	_ error = (*TimeoutError)(nil)
 	_ error = (*FailureStateError)(nil)
 	_ error = (*UnexpectedStateError)(nil)
+
+	_ errs.Coder = (*TimeoutError)(nil)
 )
 
 // Helper functions for error type checking
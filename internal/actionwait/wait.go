@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 // DefaultPollInterval is the default fixed polling interval used when no custom IntervalStrategy is provided.
@@ -93,7 +94,7 @@ type ProgressMeta struct {
 // WaitForStatus polls using fetch until a success state, failure state, timeout, unexpected state,
 // context cancellation, or fetch error occurs.
 // On success, the final FetchResult is returned with nil error.
-func WaitForStatus[T any](ctx context.Context, fetch FetchFunc[T], opts Options[T]) (FetchResult[T], error) { //nolint:cyclop // complexity driven by classification/state machine; readability preferred
+func WaitForStatus[T any](ctx context.Context, fetch FetchFunc[T], opts Options[T]) (FetchResult[T], error) {
 	if err := validateOptions(opts); err != nil {
 		var zero FetchResult[T]
 		return zero, err
@@ -101,52 +102,59 @@ func WaitForStatus[T any](ctx context.Context, fetch FetchFunc[T], opts Options[
 
 	normalizeOptions(&opts)
 
-	start := time.Now()
-	deadline := start.Add(opts.Timeout)
-	var lastProgress time.Time
-	var attempt uint
-	var successStreak int
-	var last FetchResult[T]
-
-	// Precompute allowed states for unexpected classification (success + transitional + failure)
-	// Failure states are excluded from Allowed to ensure they classify distinctly.
-	allowedTransient := append([]Status{}, opts.SuccessStates...)
-	allowedTransient = append(allowedTransient, opts.TransitionalStates...)
-
-	for {
-		// Early return: context cancelled
-		if ctx.Err() != nil {
-			return last, ctx.Err()
-		}
-
-		// Early return: timeout exceeded
-		if time.Now().After(deadline) {
-			return last, &TimeoutError{LastStatus: last.Status, Timeout: opts.Timeout}
-		}
-
-		// Fetch current status
+	w := retry.WaitFor(func(ctx context.Context) (FetchResult[T], Status, error) {
 		fr, err := fetch(ctx)
-		if err != nil {
-			return fr, err // Early return: fetch error
-		}
-		last = fr
+		return fr, fr.Status, err
+	}).
+		Target(opts.SuccessStates...).
+		Pending(opts.TransitionalStates...).
+		Failure(opts.FailureStates...).
+		ContinuousTargetOccurence(opts.ConsecutiveSuccess).
+		// A status is always returned, there is no "not found" result.
+		NotFoundWhen(func(FetchResult[T]) bool { return false }).
+		// The first poll is immediate, subsequent polls follow the interval strategy.
+		Delay(backoff.DelayFunc(func(n uint) time.Duration {
+			if n == 0 {
+				return 0
+			}
+			return opts.Interval.NextPoll(n - 1)
+		})).
+		GracePeriod(0)
+
+	if opts.ProgressSink != nil {
+		w.Progress(opts.ProgressInterval, func(_ context.Context, p retry.Progress[FetchResult[T], Status]) {
+			opts.ProgressSink(anyFetchResult(p.Value), ProgressMeta{
+				Attempt:    p.Attempt,
+				Elapsed:    p.Elapsed,
+				Remaining:  p.Remaining,
+				Deadline:   p.Deadline,
+				NextPollIn: p.NextPollIn,
+			})
+		})
+	}
 
-		// Classify status and determine if we should terminate
-		isTerminal, classifyErr := classifyStatus(fr, opts, &successStreak, allowedTransient)
-		if isTerminal {
-			return fr, classifyErr // Early return: terminal state (success or failure)
+	fr, err := w.Wait(ctx, opts.Timeout)
+
+	// Classify the wait's outcome using this package's error types.
+	switch err.(type) { //nolint:errorlint // Explicitly does *not* match wrapped errors, which are returned by fetch
+	case *retry.UnexpectedStateError:
+		if slices.Contains(opts.FailureStates, fr.Status) {
+			return fr, &FailureStateError{Status: fr.Status}
 		}
 
-		// Handle progress reporting
-		handleProgressReport(opts, fr, start, deadline, attempt, &lastProgress)
+		// Failure states are excluded from Allowed to ensure they classify distinctly.
+		allowed := slices.Concat(opts.SuccessStates, opts.TransitionalStates)
+		return fr, &UnexpectedStateError{Status: fr.Status, Allowed: allowed}
 
-		// Sleep until next attempt, with context cancellation check
-		if err := sleepWithContext(ctx, opts.Interval.NextPoll(attempt)); err != nil {
-			return last, err // Early return: context cancelled during sleep
-		}
+	case *retry.TimeoutError:
+		return fr, &TimeoutError{LastStatus: fr.Status, Timeout: opts.Timeout}
+	}
 
-		attempt++
+	if err != nil && errors.Is(err, context.Cause(ctx)) {
+		return fr, ctx.Err()
 	}
+
+	return fr, err
 }
 
 // anyFetchResult converts a typed FetchResult[T] into FetchResult[any] for ProgressSink.
@@ -154,13 +162,6 @@ func anyFetchResult[T any](fr FetchResult[T]) FetchResult[any] {
 	return FetchResult[any]{Status: fr.Status, Value: any(fr.Value)}
 }
 
-func maxDuration(a, b time.Duration) time.Duration {
-	if a > b {
-		return a
-	}
-	return b
-}
-
 // validateOptions performs early validation of required options.
 func validateOptions[T any](opts Options[T]) error {
 	if opts.Timeout <= 0 {
@@ -187,68 +188,3 @@ func normalizeOptions[T any](opts *Options[T]) {
 		opts.Interval = FixedInterval(DefaultPollInterval)
 	}
 }
-
-// classifyStatus determines the next action based on the current status.
-// Returns: (isTerminal, error) - if isTerminal is true, polling should stop.
-func classifyStatus[T any](fr FetchResult[T], opts Options[T], successStreak *int, allowedTransient []Status) (bool, error) {
-	// Classification precedence: failure -> success -> transitional -> unexpected
-	if slices.Contains(opts.FailureStates, fr.Status) {
-		return true, &FailureStateError{Status: fr.Status}
-	}
-
-	if slices.Contains(opts.SuccessStates, fr.Status) {
-		*successStreak++
-		if *successStreak >= opts.ConsecutiveSuccess {
-			return true, nil // Success!
-		}
-		return false, nil // Continue polling for consecutive successes
-	}
-
-	// Not a success state, reset streak
-	*successStreak = 0
-
-	// Check if transitional state is allowed
-	// If TransitionalStates is specified, status must be in that list
-	// If TransitionalStates is empty, any non-success/non-failure state is allowed
-	if len(opts.TransitionalStates) > 0 && !slices.Contains(opts.TransitionalStates, fr.Status) {
-		return true, &UnexpectedStateError{Status: fr.Status, Allowed: allowedTransient}
-	}
-
-	return false, nil // Continue polling
-}
-
-// handleProgressReport sends progress updates if conditions are met.
-func handleProgressReport[T any](opts Options[T], fr FetchResult[T], start time.Time, deadline time.Time, attempt uint, lastProgress *time.Time) {
-	if opts.ProgressSink == nil || opts.ProgressInterval <= 0 {
-		return
-	}
-
-	if lastProgress.IsZero() || time.Since(*lastProgress) >= opts.ProgressInterval {
-		nextPoll := opts.Interval.NextPoll(attempt)
-		opts.ProgressSink(anyFetchResult(fr), ProgressMeta{
-			Attempt:    attempt,
-			Elapsed:    time.Since(start),
-			Remaining:  maxDuration(0, time.Until(deadline)),
-			Deadline:   deadline,
-			NextPollIn: nextPoll,
-		})
-		*lastProgress = time.Now()
-	}
-}
-
-// sleepWithContext sleeps for the specified duration while respecting context cancellation.
-func sleepWithContext(ctx context.Context, duration time.Duration) error {
-	if duration <= 0 {
-		return nil
-	}
-
-	timer := time.NewTimer(duration)
-	defer timer.Stop()
-
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case <-timer.C:
-		return nil
-	}
-}
@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
 )
@@ -137,6 +136,7 @@ var (
 
 // LoopConfig configures a loop.
 type LoopConfig struct {
+	clock       Clock
 	delay       Delay
 	gracePeriod time.Duration
 	timer       Timer
@@ -172,19 +172,22 @@ func WithTimer(t Timer) Option {
 	}
 }
 
-// Default timer is a wrapper around time.After
-type timerImpl struct{}
-
-func (t *timerImpl) After(d time.Duration) <-chan time.Time {
-	return time.After(d)
+// WithClock provides a way to swap out the clock used to track the loop's deadline and to wait between attempts.
+// This primarily is useful for testing with a FakeClock, where loops run without waiting.
+func WithClock(clock Clock) Option {
+	return func(c *LoopConfig) {
+		c.clock = clock
+		c.timer = clock
+	}
 }
 
 // The default RetryConfig is backwards compatible with github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry.
 func defaultLoopConfig(ctx context.Context) LoopConfig {
 	return LoopConfig{
+		clock:       realClock{},
 		delay:       DefaultSDKv2HelperRetryCompatibleDelay(ctx),
 		gracePeriod: 30 * time.Second,
-		timer:       &timerImpl{},
+		timer:       realClock{},
 	}
 }
 
@@ -192,8 +195,9 @@ func defaultLoopConfig(ctx context.Context) LoopConfig {
 type Loop struct {
 	attempt     uint
 	config      LoopConfig
-	deadline    inttypes.Deadline
+	deadline    time.Time
 	gracePeriod time.Duration
+	next        *time.Duration
 }
 
 // NewLoopWithOptions returns a new loop configured with the provided options.
@@ -205,7 +209,7 @@ func NewLoopWithOptions(ctx context.Context, timeout time.Duration, opts ...Opti
 
 	return &Loop{
 		config:      config,
-		deadline:    inttypes.NewDeadline(timeout),
+		deadline:    config.clock.Now().Add(timeout),
 		gracePeriod: config.gracePeriod,
 	}
 }
@@ -228,8 +232,9 @@ func (r *Loop) Continue(ctx context.Context) bool {
 		r.gracePeriod = 0
 	}
 
-	r.sleep(ctx, r.config.delay.Next(r.attempt))
+	r.sleep(ctx, r.NextDelay())
 	r.attempt++
+	r.next = nil
 
 	return context.Cause(ctx) == nil
 }
@@ -237,11 +242,41 @@ func (r *Loop) Continue(ctx context.Context) bool {
 // Reset resets a Loop to its initial state.
 func (r *Loop) Reset() {
 	r.attempt = 0
+	r.next = nil
+}
+
+// Attempt returns the number of attempts started.
+func (r *Loop) Attempt() uint {
+	return r.attempt
+}
+
+// NextDelay returns the duration that the next call to Continue will sleep for.
+func (r *Loop) NextDelay() time.Duration {
+	if r.next == nil {
+		d := r.config.delay.Next(r.attempt)
+		r.next = &d
+	}
+
+	return *r.next
+}
+
+// Now returns the current time according to the loop's clock.
+func (r *Loop) Now() time.Time {
+	return r.config.clock.Now()
+}
+
+// Deadline returns the time at which the loop's timeout expires.
+func (r *Loop) Deadline() time.Time {
+	return r.deadline
 }
 
 // Remaining returns how long the duration has remaining.
 func (r *Loop) Remaining() time.Duration {
-	return r.deadline.Remaining()
+	if v := r.deadline.Sub(r.config.clock.Now()); v > 0 {
+		return v
+	}
+
+	return 0
 }
 
 // sleep sleeps for the specified duration or until the context is canceled, whichever occurs first.
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backoff
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock represents the clock used to track time.
+type Clock interface {
+	Timer
+
+	// Now returns the current time.
+	Now() time.Time
+}
+
+// Default clock is a wrapper around time.Now and time.After.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// FakeClock is a Clock whose time only moves forward when a timer is started or when it is explicitly advanced.
+// Timers fire immediately, advancing the clock by their duration, so loops using a FakeClock run without waiting.
+//
+// FakeClock should only be used for testing.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock whose current time is now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{
+		now: now,
+	}
+}
+
+// Now returns the current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// After advances the clock by d and returns a channel on which the new current time is available.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- c.Advance(d)
+
+	return ch
+}
+
+// Advance advances the clock by d and returns the new current time.
+func (c *FakeClock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if d > 0 {
+		c.now = c.now.Add(d)
+	}
+
+	return c.now
+}
+
+var (
+	_ Clock = realClock{}
+	_ Clock = (*FakeClock)(nil)
+)
@@ -0,0 +1,65 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package backoff
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	if got, want := clock.Now(), start; !got.Equal(want) {
+		t.Errorf("Now = %v, want %v", got, want)
+	}
+
+	if got, want := <-clock.After(1*time.Minute), start.Add(1*time.Minute); !got.Equal(want) {
+		t.Errorf("After = %v, want %v", got, want)
+	}
+
+	if got, want := clock.Advance(1*time.Minute), start.Add(2*time.Minute); !got.Equal(want) {
+		t.Errorf("Advance = %v, want %v", got, want)
+	}
+}
+
+func TestLoopWithFakeClock(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts []Option
+		want int
+	}{
+		"default grace period": {
+			want: 3,
+		},
+		"no grace period": {
+			opts: []Option{WithGracePeriod(0)},
+			want: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			clock := NewFakeClock(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC))
+			opts := append([]Option{WithDelay(FixedDelay(1 * time.Second)), WithClock(clock)}, testCase.opts...)
+
+			var n int
+			for r := NewLoopWithOptions(ctx, 1*time.Minute, opts...); r.Continue(ctx); {
+				clock.Advance(35 * time.Second)
+				n++
+			}
+
+			if got, want := n, testCase.want; got != want {
+				t.Errorf("Iterations = %v, want %v", got, want)
+			}
+		})
+	}
+}
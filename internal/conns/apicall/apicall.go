@@ -5,7 +5,8 @@
 // through the provider's service clients, for use in tests.
 //
 // The Smithy middleware is opt-in per request: when no Recorder is attached
-// to the operation context (see NewContext), it is a no-op.
+// to the operation context (see NewContext and NewFailuresContext), it is a
+// no-op.
 //
 // The middleware runs at the end of Initialize, after RegisterServiceMetadata
 // populates ServiceID and OperationName, and captures the final post-retry
@@ -16,7 +17,8 @@
 // (TracerProvider / MeterProvider) wired via aws.Config.ServiceOptions.
 // smithyoteltracing.Adapt and smithyotelmetrics.Adapt bridge those to a
 // real OTEL SDK. This package's recorder is intentionally limited to the
-// "did this operation happen" assertion use case.
+// "did this operation happen" assertion use case, and to the API call logs
+// (see WriteLog) from which least-privilege IAM policies are generated.
 package apicall
 
 import (
@@ -38,6 +40,40 @@ type Call struct {
 	At        time.Time     // Time of recording (after the call returned).
 	Duration  time.Duration // Wall-clock time spent in the SDK stack, including retries.
 	RequestID string        // AWS request ID from the response, when available.
+	Scope     Scope         // Provider handler that made the call, when known.
+}
+
+// Handler names used in Scope.
+const (
+	HandlerCreate = "create"
+	HandlerRead   = "read"
+	HandlerUpdate = "update"
+	HandlerDelete = "delete"
+)
+
+// Scope identifies the provider handler on whose behalf an operation is made.
+type Scope struct {
+	TypeName   string // Terraform resource or data source type name, e.g. "aws_pinpoint_app".
+	DataSource bool   // Whether TypeName is a data source.
+	Handler    string // e.g. HandlerCreate.
+}
+
+// scopeKey is the typed context key under which a Scope is stored.
+var scopeKey = inttypes.NewContextKey[*Scope]()
+
+// NewScopeContext returns ctx with s attached. The middleware records s
+// against each operation whose context descends from the returned context.
+func NewScopeContext(ctx context.Context, s Scope) context.Context {
+	return scopeKey.NewContext(ctx, &s)
+}
+
+// ScopeFromContext extracts the Scope attached to ctx, if any.
+func ScopeFromContext(ctx context.Context) (Scope, bool) {
+	s := scopeKey.FromContext(ctx)
+	if s == nil {
+		return Scope{}, false
+	}
+	return *s, true
 }
 
 // Cursor is an opaque position into a Recorder's call log. Use Mark to obtain
@@ -165,11 +201,31 @@ func FromContext(ctx context.Context) (*Recorder, bool) {
 	return r, r != nil
 }
 
+// failuresKey is the typed context key under which the per-request failed
+// call log is stored.
+var failuresKey = inttypes.NewContextKey[*Recorder]()
+
+// NewFailuresContext returns ctx with a new, empty failed call log attached.
+// The middleware records each operation that returns an error against the
+// log, in addition to any Recorder attached via NewContext.
+//
+// The log lets request-scoped tooling, such as diagnostic enrichment, inspect
+// the AWS API errors that led to a failed CRUD operation.
+func NewFailuresContext(ctx context.Context) context.Context {
+	return failuresKey.NewContext(ctx, NewRecorder())
+}
+
+// FailuresFromContext extracts the failed call log attached to ctx, if any.
+func FailuresFromContext(ctx context.Context) (*Recorder, bool) {
+	r := failuresKey.FromContext(ctx)
+	return r, r != nil
+}
+
 // MiddlewareID is the Smithy stack identifier of the recording middleware.
 const MiddlewareID = "TerraformProviderAWSCallRecorder"
 
 // recorderMiddleware records each operation against the Recorder attached
-// to its context. Runs at Initialize.After: after RegisterServiceMetadata
+// to its context, and each failed operation against the failed call log. Runs at Initialize.After: after RegisterServiceMetadata
 // populates ctx, and after the rest of the stack returns the final error.
 type recorderMiddleware struct{}
 
@@ -183,17 +239,29 @@ func (recorderMiddleware) HandleInitialize(
 	start := time.Now()
 	out, metadata, err := next.HandleInitialize(ctx, in)
 
-	if rec, ok := FromContext(ctx); ok {
+	rec, ok := FromContext(ctx)
+	failures, failed := FailuresFromContext(ctx)
+	failed = failed && err != nil
+
+	if ok || failed {
 		end := time.Now()
 		reqID, _ := awsmiddleware.GetRequestIDMetadata(metadata)
-		rec.RecordCall(Call{
+		call := Call{
 			Service:   awsmiddleware.GetServiceID(ctx),
 			Operation: awsmiddleware.GetOperationName(ctx),
 			Err:       err,
 			At:        end,
 			Duration:  end.Sub(start),
 			RequestID: reqID,
-		})
+		}
+		call.Scope, _ = ScopeFromContext(ctx)
+
+		if ok {
+			rec.RecordCall(call)
+		}
+		if failed {
+			failures.RecordCall(call)
+		}
 	}
 
 	return out, metadata, err
@@ -413,3 +413,88 @@ func TestRecorder_ConcurrentReaderWriter(t *testing.T) {
 		t.Errorf("len(Calls()) = %d, want %d", got, writes)
 	}
 }
+
+func TestMiddleware_RecordsFailuresOnly(t *testing.T) {
+	t.Parallel()
+
+	r := NewRecorder()
+	ctx := NewFailuresContext(NewContext(context.Background(), r))
+	wantErr := errors.New("simulated failure")
+
+	failures, ok := FailuresFromContext(ctx)
+	if !ok {
+		t.Fatal("FailuresFromContext failed to find failed call log")
+	}
+	if failures == r {
+		t.Fatal("FailuresFromContext returned the Recorder attached via NewContext")
+	}
+
+	for _, handler := range []middleware.Handler{noopHandler{}, failingHandler{err: wantErr}} {
+		stack := middleware.NewStack("test", smithyRequestBuilder)
+		if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+			ServiceID:     "EC2",
+			OperationName: "RunInstances",
+		}, middleware.Before); err != nil {
+			t.Fatalf("adding RegisterServiceMetadata: %v", err)
+		}
+		if err := Middleware()(stack); err != nil {
+			t.Fatalf("adding recorder middleware: %v", err)
+		}
+
+		middleware.DecorateHandler(handler, stack).Handle(ctx, nil) //nolint:errcheck // error is asserted via the recorders
+	}
+
+	if got, want := len(r.Calls()), 2; got != want {
+		t.Errorf("len(Calls()) = %d, want %d", got, want)
+	}
+
+	calls := failures.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("len(failures.Calls()) = %d, want 1", len(calls))
+	}
+	if got, want := calls[0].Operation, "RunInstances"; got != want {
+		t.Errorf("Operation = %q, want %q", got, want)
+	}
+	if !errors.Is(calls[0].Err, wantErr) {
+		t.Errorf("Err = %v, want %v", calls[0].Err, wantErr)
+	}
+
+	if _, ok := FailuresFromContext(context.Background()); ok {
+		t.Error("FailuresFromContext found failed call log when none was set")
+	}
+}
+
+func TestMiddleware_RecordsScope(t *testing.T) {
+	t.Parallel()
+
+	r := NewRecorder()
+	scope := Scope{TypeName: "aws_pinpoint_app", Handler: HandlerCreate}
+	ctx := NewScopeContext(NewContext(context.Background(), r), scope)
+
+	stack := middleware.NewStack("test", smithyRequestBuilder)
+	if err := stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{
+		ServiceID:     "Pinpoint",
+		OperationName: "CreateApp",
+	}, middleware.Before); err != nil {
+		t.Fatalf("adding RegisterServiceMetadata: %v", err)
+	}
+	if err := Middleware()(stack); err != nil {
+		t.Fatalf("adding recorder middleware: %v", err)
+	}
+
+	if _, _, err := middleware.DecorateHandler(noopHandler{}, stack).Handle(ctx, nil); err != nil {
+		t.Fatalf("stack.Handle: %v", err)
+	}
+
+	calls := r.Calls()
+	if len(calls) != 1 {
+		t.Fatalf("len(Calls()) = %d, want 1", len(calls))
+	}
+	if got, want := calls[0].Scope, scope; got != want {
+		t.Errorf("Scope = %+v, want %+v", got, want)
+	}
+
+	if _, ok := ScopeFromContext(context.Background()); ok {
+		t.Error("ScopeFromContext found scope when none was set")
+	}
+}
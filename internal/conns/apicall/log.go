@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apicall
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	smithy "github.com/aws/smithy-go"
+)
+
+// LogEntry is the serialized form of a Call in an API call log.
+// An API call log is a JSON Lines document with one LogEntry per line.
+type LogEntry struct {
+	Service    string `json:"service"`
+	Operation  string `json:"operation"`
+	TypeName   string `json:"type_name,omitempty"`
+	DataSource bool   `json:"data_source,omitempty"`
+	Handler    string `json:"handler,omitempty"`
+	ErrorCode  string `json:"error_code,omitempty"` // AWS error code of the final error, if any.
+}
+
+// NewLogEntry returns the LogEntry for c.
+func NewLogEntry(c Call) LogEntry {
+	e := LogEntry{
+		Service:    c.Service,
+		Operation:  c.Operation,
+		TypeName:   c.Scope.TypeName,
+		DataSource: c.Scope.DataSource,
+		Handler:    c.Scope.Handler,
+	}
+	if c.Err != nil {
+		if apiErr, ok := errors.AsType[smithy.APIError](c.Err); ok {
+			e.ErrorCode = apiErr.ErrorCode()
+		} else {
+			e.ErrorCode = "error"
+		}
+	}
+	return e
+}
+
+// WriteLog writes calls to w as an API call log.
+func WriteLog(w io.Writer, calls []Call) error {
+	enc := json.NewEncoder(w)
+	for _, c := range calls {
+		if err := enc.Encode(NewLogEntry(c)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadLog reads the entries of an API call log from r.
+func ReadLog(r io.Reader) ([]LogEntry, error) {
+	var entries []LogEntry
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e LogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package apicall
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	smithy "github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWriteReadLog(t *testing.T) {
+	t.Parallel()
+
+	calls := []Call{
+		{Service: "STS", Operation: "GetCallerIdentity"},
+		{
+			Service:   "Pinpoint",
+			Operation: "CreateApp",
+			Scope:     Scope{TypeName: "aws_pinpoint_app", Handler: HandlerCreate},
+		},
+		{
+			Service:   "Pinpoint",
+			Operation: "GetApp",
+			Err:       &smithy.GenericAPIError{Code: "NotFoundException"},
+			Scope:     Scope{TypeName: "aws_pinpoint_app", DataSource: true, Handler: HandlerRead},
+		},
+		{
+			Service:   "Pinpoint",
+			Operation: "DeleteApp",
+			Err:       errors.New("boom"),
+			Scope:     Scope{TypeName: "aws_pinpoint_app", Handler: HandlerDelete},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteLog(&buf, calls); err != nil {
+		t.Fatalf("WriteLog: %s", err)
+	}
+
+	got, err := ReadLog(&buf)
+	if err != nil {
+		t.Fatalf("ReadLog: %s", err)
+	}
+
+	want := []LogEntry{
+		{Service: "STS", Operation: "GetCallerIdentity"},
+		{Service: "Pinpoint", Operation: "CreateApp", TypeName: "aws_pinpoint_app", Handler: HandlerCreate},
+		{Service: "Pinpoint", Operation: "GetApp", TypeName: "aws_pinpoint_app", DataSource: true, Handler: HandlerRead, ErrorCode: "NotFoundException"},
+		{Service: "Pinpoint", Operation: "DeleteApp", TypeName: "aws_pinpoint_app", Handler: HandlerDelete, ErrorCode: "error"},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestReadLog_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := ReadLog(strings.NewReader("{\"service\":\"STS\"}\n\nnot json\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("ReadLog err = %v, want error for line 3", err)
+	}
+}
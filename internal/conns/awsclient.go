@@ -11,7 +11,6 @@ import (
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
-	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -38,13 +37,16 @@ type AWSClient struct {
 	callRecorder              *apicall.Recorder         // For acceptance tests asserting which AWS API operations are made.
 	clients                   map[string]map[string]any // Region -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
+	deletionProtectionConfig  *DeletionProtectionConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
-	randomnessSource          rand.Source // For VCR deterministic randomness.
+	quotaPreflightConfig      *QuotaPreflightConfig
+	quotaPreflightState       quotaPreflightState // Service quotas checked while planning.
+	randomnessSource          rand.Source         // For VCR deterministic randomness.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -87,6 +89,10 @@ func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
 	return c.defaultTagsConfig
 }
 
+func (c *AWSClient) DeletionProtectionConfig(context.Context) *DeletionProtectionConfig {
+	return c.deletionProtectionConfig
+}
+
 func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
@@ -95,6 +101,10 @@ func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
+func (c *AWSClient) QuotaPreflightConfig(context.Context) *QuotaPreflightConfig {
+	return c.quotaPreflightConfig
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -146,9 +156,11 @@ func (c *AWSClient) SetCallRecorder(r *apicall.Recorder) {
 //   - the AWS client logger
 //   - the VCR randomness source, when VCR testing is active
 //   - the API-call recorder, when one is attached for the test
+//   - the failed API call log, used to enrich access denied diagnostics
 //   - the AutoFlex logger
 //
-// Each element is a no-op when the corresponding feature is inactive.
+// Except for the failed API call log, each element is a no-op when the
+// corresponding feature is inactive.
 // Safe to call on a nil receiver, in which case ctx is returned unchanged.
 //
 // HTTP request and response body redaction is intentionally NOT included
@@ -166,6 +178,7 @@ func (c *AWSClient) RequestContext(ctx context.Context) context.Context {
 		ctx = vcr.NewContext(ctx, s)
 	}
 	ctx = apicall.NewContext(ctx, c.callRecorder)
+	ctx = apicall.NewFailuresContext(ctx)
 	return fwflex.RegisterLogger(ctx)
 }
 
@@ -375,32 +388,22 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
 func (c *AWSClient) EC2RegionalPrivateDNSSuffix(ctx context.Context) string {
-	region := c.Region(ctx)
-	if region == endpoints.UsEast1RegionID {
-		return "ec2.internal"
-	}
-
-	return fmt.Sprintf("%s.compute.internal", region)
+	return dns.EC2RegionalPrivateDNSSuffix(c.Region(ctx))
 }
 
 // EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the configured AWS Region.
 func (c *AWSClient) EC2RegionalPublicDNSSuffix(ctx context.Context) string {
-	region := c.Region(ctx)
-	if region == endpoints.UsEast1RegionID {
-		return "compute-1"
-	}
-
-	return fmt.Sprintf("%s.compute", region)
+	return dns.EC2RegionalPublicDNSSuffix(c.Region(ctx))
 }
 
 // EC2PrivateDNSNameForIP returns a EC2 private DNS name in the configured AWS Region.
 func (c *AWSClient) EC2PrivateDNSNameForIP(ctx context.Context, ip string) string {
-	return fmt.Sprintf("ip-%s.%s", convertIPToDashIP(ip), c.EC2RegionalPrivateDNSSuffix(ctx))
+	return dns.EC2PrivateDNSNameForIP(c.Region(ctx), ip)
 }
 
 // EC2PublicDNSNameForIP returns a EC2 public DNS name in the configured AWS Region.
 func (c *AWSClient) EC2PublicDNSNameForIP(ctx context.Context, ip string) string {
-	return c.PartitionHostname(ctx, fmt.Sprintf("ec2-%s.%s", convertIPToDashIP(ip), c.EC2RegionalPublicDNSSuffix(ctx)))
+	return c.PartitionHostname(ctx, dns.EC2PublicDNSNamePrefixForIP(c.Region(ctx), ip))
 }
 
 // ValidateInContextRegionInPartition verifies that the value of the top-level `region` attribute is in the configured AWS partition.
@@ -416,10 +419,6 @@ func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) erro
 	return nil
 }
 
-func convertIPToDashIP(ip string) string {
-	return strings.Replace(ip, ".", "-", -1)
-}
-
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
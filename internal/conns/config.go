@@ -35,6 +35,7 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DeletionProtectionConfig       *DeletionProtectionConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -47,6 +48,7 @@ type Config struct {
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	QuotaPreflightConfig           *QuotaPreflightConfig
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3OriginalRegion               string
@@ -229,7 +231,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	client.accountID = accountID
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.deletionProtectionConfig = c.DeletionProtectionConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.quotaPreflightConfig = c.QuotaPreflightConfig
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// DeletionProtectionConfig contains options related to protecting resources from deletion.
+// A resource is protected if it matches any resource type pattern, any ARN pattern or all of the tags.
+type DeletionProtectionConfig struct {
+	// ARNs are ARN patterns, e.g. "arn:aws:kms:*:123456789012:key/*".
+	ARNs []string
+
+	// ResourceTypes are Terraform resource type patterns, e.g. "aws_route53_*".
+	ResourceTypes []string
+
+	// Tags are resource tags. A tag value of "*" matches any value.
+	Tags map[string]string
+}
+
+// Protects returns whether the specified resource is protected from deletion and, if so, the reason.
+func (c *DeletionProtectionConfig) Protects(typeName, arn string, tags map[string]string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	for _, pattern := range c.ResourceTypes {
+		if wildcardMatch(pattern, typeName) {
+			return fmt.Sprintf("resource type matches %q", pattern), true
+		}
+	}
+
+	if arn != "" {
+		for _, pattern := range c.ARNs {
+			if wildcardMatch(pattern, arn) {
+				return fmt.Sprintf("ARN matches %q", pattern), true
+			}
+		}
+	}
+
+	if len(c.Tags) > 0 && tagsMatch(c.Tags, tags) {
+		keys := slices.Sorted(maps.Keys(c.Tags))
+		return fmt.Sprintf("tags match %s", formatTags(keys, c.Tags)), true
+	}
+
+	return "", false
+}
+
+// tagsMatch returns whether tags contain all of the selector's tags.
+func tagsMatch(selector, tags map[string]string) bool {
+	for k, want := range selector {
+		got, ok := tags[k]
+		if !ok {
+			return false
+		}
+		if want != "*" && got != want {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatTags(keys []string, tags map[string]string) string {
+	var sb strings.Builder
+
+	sb.WriteString("{")
+	for i, k := range keys {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "%q = %q", k, tags[k])
+	}
+	sb.WriteString("}")
+
+	return sb.String()
+}
+
+// wildcardMatch returns whether s matches pattern, where "*" matches any sequence of characters
+// (including "/" and ":") and "?" matches any single character.
+func wildcardMatch(pattern, s string) bool {
+	p, i := 0, 0
+	star, mark := -1, 0
+
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, mark = p, i
+			p++
+		case star >= 0:
+			p = star + 1
+			mark++
+			i = mark
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
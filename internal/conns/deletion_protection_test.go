@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestDeletionProtectionConfigProtects(t *testing.T) {
+	t.Parallel()
+
+	config := &DeletionProtectionConfig{
+		ARNs: []string{
+			"arn:aws:s3:::prod-*",
+			"arn:aws:dynamodb:us-west-2:123456789012:table/orders", //lintignore:AWSAT003,AWSAT005
+		},
+		ResourceTypes: []string{
+			"aws_kms_key",
+			"aws_route53_*",
+		},
+		Tags: map[string]string{
+			"Environment": "production",
+			"Owner":       "*",
+		},
+	}
+
+	testCases := map[string]struct {
+		config         *DeletionProtectionConfig
+		typeName       string
+		arn            string
+		tags           map[string]string
+		expected       bool
+		expectedReason string
+	}{
+		"nil config": {
+			typeName: "aws_kms_key",
+		},
+		"resource type": {
+			config:         config,
+			typeName:       "aws_kms_key",
+			expected:       true,
+			expectedReason: `resource type matches "aws_kms_key"`,
+		},
+		"resource type pattern": {
+			config:         config,
+			typeName:       "aws_route53_zone",
+			expected:       true,
+			expectedReason: `resource type matches "aws_route53_*"`,
+		},
+		"resource type no match": {
+			config:   config,
+			typeName: "aws_kms_alias",
+		},
+		"ARN pattern": {
+			config:         config,
+			typeName:       "aws_s3_bucket",
+			arn:            "arn:aws:s3:::prod-logs",
+			expected:       true,
+			expectedReason: `ARN matches "arn:aws:s3:::prod-*"`,
+		},
+		"ARN": {
+			config:         config,
+			typeName:       "aws_dynamodb_table",
+			arn:            "arn:aws:dynamodb:us-west-2:123456789012:table/orders", //lintignore:AWSAT003,AWSAT005
+			expected:       true,
+			expectedReason: `ARN matches "arn:aws:dynamodb:us-west-2:123456789012:table/orders"`, //lintignore:AWSAT003,AWSAT005
+		},
+		"ARN no match": {
+			config:   config,
+			typeName: "aws_s3_bucket",
+			arn:      "arn:aws:s3:::dev-logs",
+		},
+		"tags": {
+			config:   config,
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"Environment": "production",
+				"Owner":       "team-a",
+				"Name":        "web",
+			},
+			expected:       true,
+			expectedReason: `tags match {"Environment" = "production", "Owner" = "*"}`,
+		},
+		"tags value mismatch": {
+			config:   config,
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"Environment": "staging",
+				"Owner":       "team-a",
+			},
+		},
+		"tags missing key": {
+			config:   config,
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"Environment": "production",
+			},
+		},
+		"no tags selector": {
+			config:   &DeletionProtectionConfig{ResourceTypes: []string{"aws_kms_key"}},
+			typeName: "aws_instance",
+			tags: map[string]string{
+				"Environment": "production",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reason, ok := testCase.config.Protects(testCase.typeName, testCase.arn, testCase.tags)
+
+			if got, want := ok, testCase.expected; got != want {
+				t.Errorf("Protects() = %t, want %t", got, want)
+			}
+			if got, want := reason, testCase.expectedReason; got != want {
+				t.Errorf("Protects() reason = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern  string
+		s        string
+		expected bool
+	}{
+		{pattern: "", s: "", expected: true},
+		{pattern: "", s: "a", expected: false},
+		{pattern: "*", s: "", expected: true},
+		{pattern: "*", s: "arn:aws:kms:us-west-2:123456789012:key/abcd", expected: true}, //lintignore:AWSAT003,AWSAT005
+		{pattern: "aws_s3_bucket", s: "aws_s3_bucket", expected: true},
+		{pattern: "aws_s3_bucket", s: "aws_s3_bucket_policy", expected: false},
+		{pattern: "aws_s3_bucket*", s: "aws_s3_bucket_policy", expected: true},
+		{pattern: "aws_*_zone", s: "aws_route53_zone", expected: true},
+		{pattern: "aws_*_zone", s: "aws_route53_zone_association", expected: false},
+		{pattern: "arn:aws:kms:*:key/*", s: "arn:aws:kms:us-west-2:123456789012:key/abcd", expected: true},    //lintignore:AWSAT003,AWSAT005
+		{pattern: "arn:aws:kms:*:alias/*", s: "arn:aws:kms:us-west-2:123456789012:key/abcd", expected: false}, //lintignore:AWSAT003,AWSAT005
+		{pattern: "aws_?ms_key", s: "aws_kms_key", expected: true},
+		{pattern: "aws_?ms_key", s: "aws_ms_key", expected: false},
+		{pattern: "a*b*c", s: "aXbYbZc", expected: true},
+		{pattern: "a*b*c", s: "aXbYbZ", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		if got, want := wildcardMatch(testCase.pattern, testCase.s), testCase.expected; got != want {
+			t.Errorf("wildcardMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// QuotaPreflightCheckEnvVar is the environment variable that configures plan-time service quota pre-flight checks.
+	QuotaPreflightCheckEnvVar = "TF_AWS_QUOTA_PREFLIGHT_CHECK"
+)
+
+// QuotaPreflightConfig contains options related to plan-time service quota pre-flight checks.
+type QuotaPreflightConfig struct {
+	// Severity indicates the severity of the diagnostic
+	//
+	// Must be one of "error" or "warning". This is a higher level abstraction on
+	// the diagnostic severity types exposed by the plugin libraries, as it must be
+	// shared across both Plugin SDK V2 and Plugin Framework based resources.
+	//
+	// Plugin SDK V2 based resources can only return errors at plan time,
+	// so "warning" diagnostics for those resources are written to the provider log.
+	Severity string
+}
+
+// QuotaUsage is a service quota's value and its current usage in a Region.
+type QuotaUsage struct {
+	QuotaName string
+	Usage     float64
+	Value     float64
+}
+
+// Exceeded returns whether creating the specified number of additional resources would exceed the quota.
+func (u *QuotaUsage) Exceeded(planned int) bool {
+	return u.Usage+float64(planned) > u.Value
+}
+
+type quotaKey struct {
+	region, serviceCode, quotaCode string
+}
+
+// quotaPreflightEntry is a service quota's value and usage, read once per provider instance,
+// and the number of creates planned against it.
+type quotaPreflightEntry struct {
+	once    sync.Once
+	usage   *QuotaUsage
+	err     error
+	planned int
+}
+
+// quotaPreflightState holds the service quotas checked while planning.
+type quotaPreflightState struct {
+	lock    sync.Mutex
+	entries map[quotaKey]*quotaPreflightEntry
+}
+
+// QuotaPreflight records a planned create against the specified service quota in the current Region.
+// It returns the quota's value and usage and the number of creates planned against the quota so far, including this one.
+// The quota's value and usage are read with find the first time the quota is checked and reused for the rest of the plan.
+func (c *AWSClient) QuotaPreflight(ctx context.Context, serviceCode, quotaCode string, find func(context.Context) (*QuotaUsage, error)) (*QuotaUsage, int, error) {
+	state := &c.quotaPreflightState
+	k := quotaKey{region: c.Region(ctx), serviceCode: serviceCode, quotaCode: quotaCode}
+
+	state.lock.Lock()
+	if state.entries == nil {
+		state.entries = make(map[quotaKey]*quotaPreflightEntry)
+	}
+	e, ok := state.entries[k]
+	if !ok {
+		e = &quotaPreflightEntry{}
+		state.entries[k] = e
+	}
+	e.planned++
+	planned := e.planned
+	state.lock.Unlock()
+
+	e.once.Do(func() {
+		e.usage, e.err = find(ctx)
+	})
+
+	return e.usage, planned, e.err
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestQuotaUsageExceeded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		usage    QuotaUsage
+		planned  int
+		expected bool
+	}{
+		"no usage": {
+			usage:    QuotaUsage{Usage: 0, Value: 5},
+			planned:  1,
+			expected: false,
+		},
+		"below quota": {
+			usage:    QuotaUsage{Usage: 3, Value: 5},
+			planned:  1,
+			expected: false,
+		},
+		"reaches quota": {
+			usage:    QuotaUsage{Usage: 4, Value: 5},
+			planned:  1,
+			expected: false,
+		},
+		"at quota": {
+			usage:    QuotaUsage{Usage: 5, Value: 5},
+			planned:  1,
+			expected: true,
+		},
+		"multiple planned": {
+			usage:    QuotaUsage{Usage: 3, Value: 5},
+			planned:  3,
+			expected: true,
+		},
+		"zero quota": {
+			usage:    QuotaUsage{Usage: 0, Value: 0},
+			planned:  1,
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.usage.Exceeded(testCase.planned), testCase.expected; got != want {
+				t.Errorf("Exceeded(%d) = %t, want %t", testCase.planned, got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientQuotaPreflight(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := &AWSClient{
+		awsConfig: &aws.Config{Region: "us-west-2"}, //lintignore:AWSAT003
+	}
+
+	var calls int
+	find := func(context.Context) (*QuotaUsage, error) {
+		calls++
+		return &QuotaUsage{Usage: 3, Value: 5}, nil
+	}
+
+	for i := 1; i <= 3; i++ {
+		usage, planned, err := c.QuotaPreflight(ctx, "vpc", "L-F678F1CE", find)
+		if err != nil {
+			t.Fatalf("QuotaPreflight: %s", err)
+		}
+		if got, want := planned, i; got != want {
+			t.Errorf("planned = %d, want %d", got, want)
+		}
+		if got, want := usage.Exceeded(planned), i > 2; got != want {
+			t.Errorf("Exceeded(%d) = %t, want %t", planned, got, want)
+		}
+	}
+
+	if got, want := calls, 1; got != want {
+		t.Errorf("find called %d times, want %d", got, want)
+	}
+
+	// Other quotas are counted separately and errors are cached.
+	var errCalls int
+	findErr := func(context.Context) (*QuotaUsage, error) {
+		errCalls++
+		return nil, errors.New("denied")
+	}
+
+	for range 2 {
+		if _, planned, err := c.QuotaPreflight(ctx, "ec2", "L-0263D0A3", findErr); err == nil {
+			t.Error("QuotaPreflight: expected error")
+		} else if planned > 2 {
+			t.Errorf("planned = %d, want at most 2", planned)
+		}
+	}
+
+	if got, want := errCalls, 1; got != want {
+		t.Errorf("find called %d times, want %d", got, want)
+	}
+}
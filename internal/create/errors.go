@@ -10,6 +10,7 @@ import (
 
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -61,10 +62,12 @@ func ProblemStandardMessage(service, action, resource, id string, gotError error
 	return fmt.Sprintf("%s %s %s (%s): %s", action, hf, resource, id, gotError)
 }
 
+// AddError adds an error diagnostic with a standardized problem message.
+// The diagnostic's detail carries the error's machine-readable trailer.
 func AddError(d *fwdiag.Diagnostics, service, action, resource, id string, gotError error) {
 	d.AddError(
 		ProblemStandardMessage(service, action, resource, id, nil),
-		gotError.Error(),
+		errs.WithTrailer(gotError.Error(), gotError),
 	)
 }
 
@@ -85,6 +88,7 @@ func diagError(service, action, resource, id string, gotError error) diag.Diagno
 	return diag.Diagnostic{
 		Severity: diag.Error,
 		Summary:  ProblemStandardMessage(service, action, resource, id, gotError),
+		Detail:   errs.WithTrailer("", gotError),
 	}
 }
 
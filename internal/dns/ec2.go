@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+)
+
+// EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the specified AWS Region.
+func EC2RegionalPrivateDNSSuffix(region string) string {
+	if region == endpoints.UsEast1RegionID {
+		return "ec2.internal"
+	}
+
+	return fmt.Sprintf("%s.compute.internal", region)
+}
+
+// EC2RegionalPublicDNSSuffix returns the EC2 public DNS suffix for the specified AWS Region.
+// The suffix does not include the partition's DNS suffix.
+func EC2RegionalPublicDNSSuffix(region string) string {
+	if region == endpoints.UsEast1RegionID {
+		return "compute-1"
+	}
+
+	return fmt.Sprintf("%s.compute", region)
+}
+
+// EC2PrivateDNSNameForIP returns a EC2 private DNS name in the specified AWS Region.
+func EC2PrivateDNSNameForIP(region, ip string) string {
+	return fmt.Sprintf("ip-%s.%s", convertIPToDashIP(ip), EC2RegionalPrivateDNSSuffix(region))
+}
+
+// EC2PublicDNSNamePrefixForIP returns a EC2 public DNS name in the specified AWS Region,
+// without the partition's DNS suffix.
+func EC2PublicDNSNamePrefixForIP(region, ip string) string {
+	return fmt.Sprintf("ec2-%s.%s", convertIPToDashIP(ip), EC2RegionalPublicDNSSuffix(region))
+}
+
+func convertIPToDashIP(ip string) string {
+	return strings.Replace(ip, ".", "-", -1)
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"testing"
+)
+
+func TestEC2PrivateDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		region   string
+		ip       string
+		expected string
+	}{
+		{
+			name:     "us-east-1",
+			region:   "us-east-1",
+			ip:       "10.20.30.40",
+			expected: "ip-10-20-30-40.ec2.internal",
+		},
+		{
+			name:     "us-west-2",
+			region:   "us-west-2",
+			ip:       "10.20.30.40",
+			expected: "ip-10-20-30-40.us-west-2.compute.internal",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := EC2PrivateDNSNameForIP(testCase.region, testCase.ip), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestEC2PublicDNSNamePrefixForIP(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		region   string
+		ip       string
+		expected string
+	}{
+		{
+			name:     "us-east-1",
+			region:   "us-east-1",
+			ip:       "10.20.30.40",
+			expected: "ec2-10-20-30-40.compute-1",
+		},
+		{
+			name:     "cn-north-1",
+			region:   "cn-north-1",
+			ip:       "10.20.30.40",
+			expected: "ec2-10-20-30-40.cn-north-1.compute",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := EC2PublicDNSNamePrefixForIP(testCase.region, testCase.ip), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
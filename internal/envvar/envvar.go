@@ -78,6 +78,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For acceptance tests, a directory to which the AWS API calls made by each test are written
+	// These API call logs are used to generate least-privilege IAM policies
+	AccAPICallLogDir = "TF_ACC_API_CALL_LOG_DIR"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -0,0 +1,304 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	smithy "github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// DenyContext identifies the type of policy that caused an access denied error.
+type DenyContext string
+
+const (
+	DenyContextUnknown                           DenyContext = ""
+	DenyContextExplicitDeny                      DenyContext = "explicit deny"
+	DenyContextIdentityPolicyExplicitDeny        DenyContext = "explicit deny in an identity-based policy"
+	DenyContextIdentityPolicyNoAllow             DenyContext = "no identity-based policy allows"
+	DenyContextPermissionsBoundaryExplicitDeny   DenyContext = "explicit deny in a permissions boundary"
+	DenyContextPermissionsBoundaryNoAllow        DenyContext = "no permissions boundary allows"
+	DenyContextResourceControlPolicyExplicitDeny DenyContext = "explicit deny in a resource control policy"
+	DenyContextResourcePolicyExplicitDeny        DenyContext = "explicit deny in a resource-based policy"
+	DenyContextResourcePolicyNoAllow             DenyContext = "no resource-based policy allows"
+	DenyContextServiceControlPolicyExplicitDeny  DenyContext = "explicit deny in a service control policy"
+	DenyContextServiceControlPolicyNoAllow       DenyContext = "no service control policy allows"
+	DenyContextSessionPolicyExplicitDeny         DenyContext = "explicit deny in a session policy"
+	DenyContextSessionPolicyNoAllow              DenyContext = "no session policy allows"
+	DenyContextVPCEndpointPolicyExplicitDeny     DenyContext = "explicit deny in a VPC endpoint policy"
+	DenyContextVPCEndpointPolicyNoAllow          DenyContext = "no VPC endpoint policy allows"
+)
+
+// sentence returns a sentence describing how access was denied in this context.
+func (c DenyContext) sentence() string {
+	if v := string(c); strings.HasPrefix(v, "no ") {
+		return "Access was denied because " + v + " the action."
+	}
+
+	return "Access was denied by an " + string(c) + "."
+}
+
+// explanation returns a short explanation of how to resolve an access denied error in this context.
+func (c DenyContext) explanation() string {
+	switch c {
+	case DenyContextServiceControlPolicyExplicitDeny, DenyContextServiceControlPolicyNoAllow:
+		return "Service control policies (SCPs) are managed in AWS Organizations and can only be changed from the organization's management account or a delegated administrator. Adding the action to the principal's own IAM policies will not resolve this error."
+	case DenyContextResourceControlPolicyExplicitDeny:
+		return "Resource control policies (RCPs) are managed in AWS Organizations and can only be changed from the organization's management account or a delegated administrator."
+	case DenyContextPermissionsBoundaryExplicitDeny, DenyContextPermissionsBoundaryNoAllow:
+		return "The principal's permissions boundary limits its maximum permissions. The action must be allowed by both the permissions boundary and an identity-based policy."
+	case DenyContextSessionPolicyExplicitDeny, DenyContextSessionPolicyNoAllow:
+		return "A session policy passed when the role was assumed limits the session's permissions. Review the policy passed to sts:AssumeRole (for example, the provider's assume_role.policy or assume_role.policy_arns)."
+	case DenyContextResourcePolicyExplicitDeny, DenyContextResourcePolicyNoAllow:
+		return "Review the resource-based policy attached to the resource."
+	case DenyContextVPCEndpointPolicyExplicitDeny, DenyContextVPCEndpointPolicyNoAllow:
+		return "Review the policy attached to the VPC endpoint used to reach the service."
+	case DenyContextIdentityPolicyExplicitDeny, DenyContextExplicitDeny:
+		return "An explicit deny overrides any allow. Remove or narrow the Deny statement that matches the action."
+	case DenyContextIdentityPolicyNoAllow:
+		return "Add the action to an identity-based policy attached to the principal."
+	}
+
+	return ""
+}
+
+// AuthorizationMessageDecoder decodes an encoded authorization failure message,
+// typically by calling sts:DecodeAuthorizationMessage.
+type AuthorizationMessageDecoder func(ctx context.Context, encodedMessage string) (string, error)
+
+// AccessDeniedError is an access denied error enriched with the IAM action,
+// resource and principal involved and the policy context of the denial.
+type AccessDeniedError struct {
+	Action         string // IAM action, e.g. "ec2:RunInstances"
+	ActionInferred bool   // Whether Action was inferred from the operation name rather than reported by AWS
+	DecodedMessage string // Decoded authorization failure message, when available
+	DenyContext    DenyContext
+	EncodedMessage string // Encoded authorization failure message, when available
+	Info           ErrorInfo
+	Principal      string // ARN of the principal, when available
+	Resource       string // ARN of the resource, when available
+
+	err error
+}
+
+var (
+	accessDeniedPrincipalActionRegexp = regexp.MustCompile(`(?:User|Principal): (\S+) is not authorized to perform: ([\w-]+:[\w*-]+)(?: on resource: (\S+?))?(?:[.,]?\s|[.,]?$)`)
+	accessDeniedDenyContextRegexp     = regexp.MustCompile(`(?i)(with an explicit deny in an? |because no )(identity-based policy|permissions boundary|resource control policy|resource-based policy|service control policy|session policy|VPC endpoint policy)`)
+	encodedAuthorizationMessageRegexp = regexp.MustCompile(`Encoded authorization failure message: (\S+)`)
+)
+
+// NewAccessDeniedError returns an AccessDeniedError for the specified error if it is an access denied error.
+// service and operation are the Smithy service ID and operation name of the failed AWS API call;
+// if empty they are taken from the error, if it is a *smithy.OperationError.
+func NewAccessDeniedError(service, operation string, err error) (*AccessDeniedError, bool) {
+	info := Classify(err)
+	if info.Code != CodeAccessDenied {
+		return nil, false
+	}
+
+	if service != "" {
+		info.Service = service
+	}
+	if operation != "" {
+		info.Operation = operation
+	}
+
+	e := &AccessDeniedError{
+		Info: info,
+		err:  err,
+	}
+
+	message := err.Error()
+	if apiErr, ok := errors.AsType[smithy.APIError](err); ok {
+		message = apiErr.ErrorMessage()
+	}
+
+	if m := accessDeniedPrincipalActionRegexp.FindStringSubmatch(message); m != nil {
+		e.Principal, e.Action, e.Resource = m[1], m[2], m[3]
+	}
+	if m := accessDeniedDenyContextRegexp.FindStringSubmatch(message); m != nil {
+		e.DenyContext = newDenyContext(m[1], m[2])
+	}
+	if m := encodedAuthorizationMessageRegexp.FindStringSubmatch(message); m != nil {
+		e.EncodedMessage = m[1]
+	}
+
+	if e.Action == "" && info.Service != "" && info.Operation != "" {
+		if action, inferred, ok := names.IAMAction(info.Service, info.Operation); ok {
+			e.Action, e.ActionInferred = action, inferred
+		}
+	}
+
+	return e, true
+}
+
+func newDenyContext(how, policyType string) DenyContext {
+	explicit := strings.HasPrefix(strings.ToLower(how), "with")
+
+	switch strings.ToLower(policyType) {
+	case "identity-based policy":
+		if explicit {
+			return DenyContextIdentityPolicyExplicitDeny
+		}
+		return DenyContextIdentityPolicyNoAllow
+	case "permissions boundary":
+		if explicit {
+			return DenyContextPermissionsBoundaryExplicitDeny
+		}
+		return DenyContextPermissionsBoundaryNoAllow
+	case "resource control policy":
+		return DenyContextResourceControlPolicyExplicitDeny
+	case "resource-based policy":
+		if explicit {
+			return DenyContextResourcePolicyExplicitDeny
+		}
+		return DenyContextResourcePolicyNoAllow
+	case "service control policy":
+		if explicit {
+			return DenyContextServiceControlPolicyExplicitDeny
+		}
+		return DenyContextServiceControlPolicyNoAllow
+	case "session policy":
+		if explicit {
+			return DenyContextSessionPolicyExplicitDeny
+		}
+		return DenyContextSessionPolicyNoAllow
+	case "vpc endpoint policy":
+		if explicit {
+			return DenyContextVPCEndpointPolicyExplicitDeny
+		}
+		return DenyContextVPCEndpointPolicyNoAllow
+	}
+
+	if explicit {
+		return DenyContextExplicitDeny
+	}
+
+	return DenyContextUnknown
+}
+
+func (e *AccessDeniedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *AccessDeniedError) Unwrap() error {
+	return e.err
+}
+
+func (e *AccessDeniedError) DiagnosticCode() Code {
+	return CodeAccessDenied
+}
+
+// decodedAuthorizationMessage is the subset of the sts:DecodeAuthorizationMessage
+// response's DecodedMessage document used to enrich an AccessDeniedError.
+type decodedAuthorizationMessage struct {
+	Allowed      bool `json:"allowed"`
+	ExplicitDeny bool `json:"explicitDeny"`
+	Context      struct {
+		Action    string `json:"action"`
+		Principal struct {
+			ARN string `json:"arn"`
+		} `json:"principal"`
+		Resource string `json:"resource"`
+	} `json:"context"`
+}
+
+// Decode decodes the error's encoded authorization failure message, if any,
+// and fills in any details not present in the original error message.
+func (e *AccessDeniedError) Decode(ctx context.Context, decode AuthorizationMessageDecoder) error {
+	if e.EncodedMessage == "" || e.DecodedMessage != "" {
+		return nil
+	}
+
+	decoded, err := decode(ctx, e.EncodedMessage)
+	if err != nil {
+		return err
+	}
+
+	e.DecodedMessage = decoded
+
+	var v decodedAuthorizationMessage
+	if err := json.Unmarshal([]byte(decoded), &v); err != nil {
+		return fmt.Errorf("parsing decoded authorization message: %w", err)
+	}
+
+	if v.Context.Action != "" {
+		e.Action, e.ActionInferred = v.Context.Action, false
+	}
+	if e.Principal == "" {
+		e.Principal = v.Context.Principal.ARN
+	}
+	if e.Resource == "" {
+		e.Resource = v.Context.Resource
+	}
+	if v.ExplicitDeny && e.DenyContext == DenyContextUnknown {
+		e.DenyContext = DenyContextExplicitDeny
+	}
+
+	return nil
+}
+
+// Summary returns a short summary of the error suitable for use as a diagnostic's summary.
+func (e *AccessDeniedError) Summary() string {
+	switch {
+	case e.Action == "":
+		return "AWS access denied"
+	case e.ActionInferred:
+		return "AWS access denied for " + e.Info.Service + " " + e.Info.Operation
+	}
+
+	return "AWS access denied for " + e.Action
+}
+
+// Detail returns an explanation of the error suitable for use as a diagnostic's detail.
+// The detail ends with the diagnostic trailer.
+func (e *AccessDeniedError) Detail() string {
+	var sb strings.Builder
+
+	principal := "The caller"
+	if e.Principal != "" {
+		principal = "The principal " + e.Principal
+	}
+	action := "the failed operation"
+	switch {
+	case e.ActionInferred:
+		action = fmt.Sprintf("the %s %s operation (probably the IAM action %s, inferred from the operation name)", e.Info.Service, e.Info.Operation, e.Action)
+	case e.Action != "":
+		action = "the IAM action " + e.Action
+	}
+	fmt.Fprintf(&sb, "%s is not allowed to perform %s", principal, action)
+	if e.Resource != "" {
+		fmt.Fprintf(&sb, " on resource %s", e.Resource)
+	}
+	sb.WriteString(".")
+
+	if e.DenyContext != DenyContextUnknown {
+		fmt.Fprintf(&sb, "\n\n%s", e.DenyContext.sentence())
+		if v := e.DenyContext.explanation(); v != "" {
+			sb.WriteString(" ")
+			sb.WriteString(v)
+		}
+	}
+
+	switch {
+	case e.DecodedMessage != "":
+		fmt.Fprintf(&sb, "\n\nDecoded authorization failure message:\n%s", e.DecodedMessage)
+	case e.EncodedMessage != "":
+		sb.WriteString("\n\nThe error includes an encoded authorization failure message. Allow sts:DecodeAuthorizationMessage to have it decoded here.")
+	}
+
+	fmt.Fprintf(&sb, "\n\n%s", e.Info.Trailer())
+
+	return sb.String()
+}
+
+var (
+	_ Coder = (*AccessDeniedError)(nil)
+)
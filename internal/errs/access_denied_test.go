@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestNewAccessDeniedError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		service, operation string
+		err                error
+		wantOK             bool
+		wantAction         string
+		wantActionInferred bool
+		wantDenyContext    errs.DenyContext
+		wantEncoded        string
+		wantPrincipal      string
+		wantResource       string
+	}{
+		"not access denied": {
+			err: errs.APIError("ResourceNotFoundException", "not found"),
+		},
+		"service control policy": {
+			err:             errs.APIError("AccessDeniedException", "User: arn:aws:sts::123456789012:assumed-role/Deploy/session is not authorized to perform: kms:CreateKey on resource: * with an explicit deny in a service control policy"),
+			wantOK:          true,
+			wantAction:      "kms:CreateKey",
+			wantDenyContext: errs.DenyContextServiceControlPolicyExplicitDeny,
+			wantPrincipal:   "arn:aws:sts::123456789012:assumed-role/Deploy/session",
+			wantResource:    "*",
+		},
+		"permissions boundary": {
+			err:             errs.APIError("AccessDenied", "User: arn:aws:iam::123456789012:user/test is not authorized to perform: iam:CreateRole on resource: arn:aws:iam::123456789012:role/test because no permissions boundary allows the iam:CreateRole action"),
+			wantOK:          true,
+			wantAction:      "iam:CreateRole",
+			wantDenyContext: errs.DenyContextPermissionsBoundaryNoAllow,
+			wantPrincipal:   "arn:aws:iam::123456789012:user/test",
+			wantResource:    "arn:aws:iam::123456789012:role/test",
+		},
+		"encoded message": {
+			err:             errs.APIError("UnauthorizedOperation", "You are not authorized to perform this operation. User: arn:aws:iam::123456789012:user/test is not authorized to perform: ec2:RunInstances on resource: arn:aws:ec2:us-west-2:123456789012:instance/* because no identity-based policy allows the ec2:RunInstances action. Encoded authorization failure message: abc-DEF_123"),
+			wantOK:          true,
+			wantAction:      "ec2:RunInstances",
+			wantDenyContext: errs.DenyContextIdentityPolicyNoAllow,
+			wantEncoded:     "abc-DEF_123",
+			wantPrincipal:   "arn:aws:iam::123456789012:user/test",
+			wantResource:    "arn:aws:ec2:us-west-2:123456789012:instance/*",
+		},
+		"action inferred from service and operation": {
+			service:            "Elastic Load Balancing v2",
+			operation:          "CreateLoadBalancer",
+			err:                errs.APIError("AccessDenied", "Access Denied"),
+			wantOK:             true,
+			wantAction:         "elasticloadbalancing:CreateLoadBalancer",
+			wantActionInferred: true,
+		},
+		"action mapped from service and operation": {
+			service:    "S3",
+			operation:  "HeadObject",
+			err:        errs.APIError("AccessDenied", "Access Denied"),
+			wantOK:     true,
+			wantAction: "s3:GetObject",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.NewAccessDeniedError(testCase.service, testCase.operation, testCase.err)
+			if ok != testCase.wantOK {
+				t.Fatalf("ok = %t, want %t", ok, testCase.wantOK)
+			}
+			if !ok {
+				return
+			}
+
+			if got, want := got.Action, testCase.wantAction; got != want {
+				t.Errorf("Action = %q, want %q", got, want)
+			}
+			if got, want := got.ActionInferred, testCase.wantActionInferred; got != want {
+				t.Errorf("ActionInferred = %t, want %t", got, want)
+			}
+			if got, want := got.DenyContext, testCase.wantDenyContext; got != want {
+				t.Errorf("DenyContext = %q, want %q", got, want)
+			}
+			if got, want := got.EncodedMessage, testCase.wantEncoded; got != want {
+				t.Errorf("EncodedMessage = %q, want %q", got, want)
+			}
+			if got, want := got.Principal, testCase.wantPrincipal; got != want {
+				t.Errorf("Principal = %q, want %q", got, want)
+			}
+			if got, want := got.Resource, testCase.wantResource; got != want {
+				t.Errorf("Resource = %q, want %q", got, want)
+			}
+			if !errors.Is(got, testCase.err) {
+				t.Error("AccessDeniedError does not wrap the original error")
+			}
+			if got, want := errs.Classify(got).Code, errs.CodeAccessDenied; got != want {
+				t.Errorf("Classify().Code = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccessDeniedErrorDecode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	e, ok := errs.NewAccessDeniedError("EC2", "RunInstances", errs.APIError("UnauthorizedOperation", "You are not authorized to perform this operation. Encoded authorization failure message: encoded"))
+	if !ok {
+		t.Fatal("not an access denied error")
+	}
+
+	if got, want := e.Detail(), "Allow sts:DecodeAuthorizationMessage"; !strings.Contains(got, want) {
+		t.Errorf("Detail() = %q, want it to contain %q", got, want)
+	}
+
+	decoded := `{"allowed":false,"explicitDeny":true,"context":{"principal":{"id":"AIDA","arn":"arn:aws:iam::123456789012:user/test"},"action":"ec2:RunInstances","resource":"arn:aws:ec2:us-west-2:123456789012:instance/*"}}`
+	err := e.Decode(ctx, func(_ context.Context, encodedMessage string) (string, error) {
+		if encodedMessage != "encoded" {
+			t.Errorf("encodedMessage = %q, want encoded", encodedMessage)
+		}
+		return decoded, nil
+	})
+	if err != nil {
+		t.Fatalf("Decode: %s", err)
+	}
+
+	if got, want := e.Principal, "arn:aws:iam::123456789012:user/test"; got != want {
+		t.Errorf("Principal = %q, want %q", got, want)
+	}
+	if got, want := e.Resource, "arn:aws:ec2:us-west-2:123456789012:instance/*"; got != want {
+		t.Errorf("Resource = %q, want %q", got, want)
+	}
+	if got, want := e.DenyContext, errs.DenyContextExplicitDeny; got != want {
+		t.Errorf("DenyContext = %q, want %q", got, want)
+	}
+
+	if got, want := e.Summary(), "AWS access denied for ec2:RunInstances"; got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+
+	detail := e.Detail()
+	for _, want := range []string{
+		"The principal arn:aws:iam::123456789012:user/test is not allowed to perform the IAM action ec2:RunInstances on resource arn:aws:ec2:us-west-2:123456789012:instance/*.",
+		"Access was denied by an explicit deny.",
+		decoded,
+	} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("Detail() = %q, want it to contain %q", detail, want)
+		}
+	}
+
+	info, ok := errs.ParseTrailer(detail)
+	if !ok {
+		t.Fatalf("ParseTrailer(%q) failed", detail)
+	}
+	if got, want := info.Code, errs.CodeAccessDenied; got != want {
+		t.Errorf("trailer code = %q, want %q", got, want)
+	}
+	if got, want := info.Operation, "RunInstances"; got != want {
+		t.Errorf("trailer operation = %q, want %q", got, want)
+	}
+
+	// A decoder error leaves the error unchanged.
+	e, _ = errs.NewAccessDeniedError("", "", errs.APIError("UnauthorizedOperation", "Encoded authorization failure message: encoded"))
+	if err := e.Decode(ctx, func(context.Context, string) (string, error) { return "", errors.New("denied") }); err == nil {
+		t.Error("Decode: expected error")
+	}
+	if e.DecodedMessage != "" {
+		t.Errorf("DecodedMessage = %q, want empty", e.DecodedMessage)
+	}
+}
+
+func TestAccessDeniedErrorInferredAction(t *testing.T) {
+	t.Parallel()
+
+	e, ok := errs.NewAccessDeniedError("Elastic Load Balancing v2", "CreateLoadBalancer", errs.APIError("AccessDenied", "Access Denied"))
+	if !ok {
+		t.Fatal("not an access denied error")
+	}
+
+	if got, want := e.Summary(), "AWS access denied for Elastic Load Balancing v2 CreateLoadBalancer"; got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+	if got, want := e.Detail(), "The caller is not allowed to perform the Elastic Load Balancing v2 CreateLoadBalancer operation (probably the IAM action elasticloadbalancing:CreateLoadBalancer, inferred from the operation name)."; !strings.Contains(got, want) {
+		t.Errorf("Detail() = %q, want it to contain %q", got, want)
+	}
+}
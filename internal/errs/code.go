@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Code is a stable, machine-readable classification of a provider error.
+type Code string
+
+const (
+	CodeAccessDenied  Code = "AWS.AccessDenied"
+	CodeError         Code = "AWS.Error" // Any other error
+	CodeNotFound      Code = "AWS.NotFound"
+	CodeQuotaExceeded Code = "AWS.QuotaExceeded"
+	CodeThrottled     Code = "AWS.Throttled"
+	CodeWaitTimeout   Code = "AWS.WaitTimeout"
+)
+
+// Coder is implemented by errors that classify themselves.
+type Coder interface {
+	error
+	DiagnosticCode() Code
+}
+
+// ErrorInfo holds the machine-readable details of a provider error.
+type ErrorInfo struct {
+	Code         Code
+	AWSErrorCode string // e.g. "ResourceNotFoundException"
+	Operation    string // e.g. "DescribeInstances"
+	RequestID    string
+	Service      string // Smithy service ID, e.g. "EC2"
+}
+
+var (
+	accessDeniedErrorCodes = []string{
+		"AccessDenied",
+		"AccessDeniedException",
+		"AuthFailure",
+		"AuthorizationError",
+		"AuthorizationErrorException",
+		"Forbidden",
+		"ForbiddenException",
+		"NotAuthorized",
+		"UnauthorizedException",
+		"UnauthorizedOperation",
+	}
+	// From github.com/aws/aws-sdk-go-v2/aws/retry.DefaultThrottleErrorCodes, less "LimitExceededException" which usually indicates an exceeded quota.
+	throttledErrorCodes = []string{
+		"BandwidthLimitExceeded",
+		"EC2ThrottledException",
+		"PriorRequestNotComplete",
+		"ProvisionedThroughputExceededException",
+		"RequestLimitExceeded",
+		"RequestThrottled",
+		"RequestThrottledException",
+		"SlowDown",
+		"ThrottledException",
+		"Throttling",
+		"ThrottlingException",
+		"TooManyRequestsException",
+		"TransactionInProgressException",
+	}
+)
+
+// Classify returns the machine-readable details of the specified error.
+func Classify(err error) ErrorInfo {
+	var info ErrorInfo
+
+	if err == nil {
+		return info
+	}
+
+	if apiErr, ok := errors.AsType[smithy.APIError](err); ok {
+		info.AWSErrorCode = apiErr.ErrorCode()
+	}
+	if opErr, ok := errors.AsType[*smithy.OperationError](err); ok {
+		info.Operation = opErr.Operation()
+		info.Service = opErr.Service()
+	}
+	var statusCode int
+	if respErr, ok := errors.AsType[*awshttp.ResponseError](err); ok {
+		info.RequestID = respErr.ServiceRequestID()
+		statusCode = respErr.HTTPStatusCode()
+	}
+
+	info.Code = classify(err, info.AWSErrorCode, statusCode)
+
+	return info
+}
+
+func classify(err error, code string, statusCode int) Code {
+	if coder, ok := errors.AsType[Coder](err); ok {
+		return coder.DiagnosticCode()
+	}
+
+	switch {
+	case code != "" && slices.Contains(throttledErrorCodes, code):
+		return CodeThrottled
+	case code != "" && slices.Contains(accessDeniedErrorCodes, code):
+		return CodeAccessDenied
+	case strings.Contains(code, "QuotaExceeded"), strings.HasSuffix(code, "LimitExceeded"), strings.HasSuffix(code, "LimitExceededException"):
+		return CodeQuotaExceeded
+	case strings.Contains(code, "NotFound"), strings.HasPrefix(code, "NoSuch"):
+		return CodeNotFound
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return CodeThrottled
+	case http.StatusForbidden:
+		return CodeAccessDenied
+	case http.StatusNotFound:
+		return CodeNotFound
+	}
+
+	if IsA[*sdkretry.NotFoundError](err) {
+		return CodeNotFound
+	}
+	if IsA[*sdkretry.TimeoutError](err) || errors.Is(err, context.DeadlineExceeded) {
+		return CodeWaitTimeout
+	}
+
+	return CodeError
+}
+
+const (
+	trailerPrefix = "[diagnostic "
+	trailerSuffix = "]"
+)
+
+// Trailer returns the machine-readable details as a single line that can be appended to a diagnostic's detail, e.g.
+//
+//	[diagnostic code="AWS.NotFound" aws_error_code="ResourceNotFoundException" operation="GetThing" request_id="..." service="Example"]
+//
+// Empty values are omitted.
+func (info ErrorInfo) Trailer() string {
+	var sb strings.Builder
+
+	sb.WriteString(trailerPrefix)
+	for _, kv := range info.keyvals() {
+		if kv[1] == "" {
+			continue
+		}
+		if sb.Len() > len(trailerPrefix) {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(kv[0])
+		sb.WriteString("=")
+		sb.WriteString(strconv.Quote(kv[1]))
+	}
+	sb.WriteString(trailerSuffix)
+
+	return sb.String()
+}
+
+func (info ErrorInfo) keyvals() [][2]string {
+	return [][2]string{
+		{"code", string(info.Code)},
+		{"aws_error_code", info.AWSErrorCode},
+		{"operation", info.Operation},
+		{"request_id", info.RequestID},
+		{"service", info.Service},
+	}
+}
+
+// WithTrailer returns the specified diagnostic detail with the trailer for the specified error appended.
+// If err is nil or detail already has a trailer, detail is returned unchanged.
+func WithTrailer(detail string, err error) string {
+	if err == nil {
+		return detail
+	}
+
+	if _, ok := ParseTrailer(detail); ok {
+		return detail
+	}
+
+	trailer := Classify(err).Trailer()
+	if detail == "" {
+		return trailer
+	}
+
+	return detail + "\n\n" + trailer
+}
+
+// ParseTrailer returns the machine-readable details from a diagnostic detail's trailer.
+func ParseTrailer(detail string) (ErrorInfo, bool) {
+	var info ErrorInfo
+
+	line := detail
+	if i := strings.LastIndex(detail, "\n"); i >= 0 {
+		line = detail[i+1:]
+	}
+
+	line, ok := strings.CutPrefix(line, trailerPrefix)
+	if !ok {
+		return info, false
+	}
+	line, ok = strings.CutSuffix(line, trailerSuffix)
+	if !ok {
+		return info, false
+	}
+
+	for line != "" {
+		key, rest, ok := strings.Cut(line, "=")
+		if !ok {
+			return info, false
+		}
+		value, err := strconv.QuotedPrefix(rest)
+		if err != nil {
+			return info, false
+		}
+		line = strings.TrimPrefix(rest[len(value):], " ")
+		value, _ = strconv.Unquote(value)
+
+		switch key {
+		case "code":
+			info.Code = Code(value)
+		case "aws_error_code":
+			info.AWSErrorCode = value
+		case "operation":
+			info.Operation = value
+		case "request_id":
+			info.RequestID = value
+		case "service":
+			info.Service = value
+		}
+	}
+
+	return info, info.Code != ""
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/google/go-cmp/cmp"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func newOperationError(statusCode int, code string) error {
+	return &smithy.OperationError{
+		ServiceID:     "EC2",
+		OperationName: "DescribeInstances",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: statusCode}},
+				Err:      &smithy.GenericAPIError{Code: code, Message: "message"},
+			},
+			RequestID: "f4ce4a32-4fd3-4f63-a4e3-1e0a8c2b1e7a",
+		},
+	}
+}
+
+type testCodeError struct{}
+
+func (testCodeError) Error() string {
+	return "wait timed out"
+}
+
+func (testCodeError) DiagnosticCode() errs.Code {
+	return errs.CodeWaitTimeout
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err  error
+		want errs.ErrorInfo
+	}{
+		"nil": {},
+		"not found": {
+			err: newOperationError(http.StatusBadRequest, "InvalidInstanceID.NotFound"),
+			want: errs.ErrorInfo{
+				Code:         errs.CodeNotFound,
+				AWSErrorCode: "InvalidInstanceID.NotFound",
+				Operation:    "DescribeInstances",
+				RequestID:    "f4ce4a32-4fd3-4f63-a4e3-1e0a8c2b1e7a",
+				Service:      "EC2",
+			},
+		},
+		"no such": {
+			err:  errs.APIError("NoSuchBucket", "message"),
+			want: errs.ErrorInfo{Code: errs.CodeNotFound, AWSErrorCode: "NoSuchBucket"},
+		},
+		"throttled": {
+			err: fmt.Errorf("creating thing: %w", newOperationError(http.StatusBadRequest, "RequestLimitExceeded")),
+			want: errs.ErrorInfo{
+				Code:         errs.CodeThrottled,
+				AWSErrorCode: "RequestLimitExceeded",
+				Operation:    "DescribeInstances",
+				RequestID:    "f4ce4a32-4fd3-4f63-a4e3-1e0a8c2b1e7a",
+				Service:      "EC2",
+			},
+		},
+		"access denied": {
+			err:  errs.APIError("UnauthorizedOperation", "message"),
+			want: errs.ErrorInfo{Code: errs.CodeAccessDenied, AWSErrorCode: "UnauthorizedOperation"},
+		},
+		"access denied status code": {
+			err: newOperationError(http.StatusForbidden, "SomethingElse"),
+			want: errs.ErrorInfo{
+				Code:         errs.CodeAccessDenied,
+				AWSErrorCode: "SomethingElse",
+				Operation:    "DescribeInstances",
+				RequestID:    "f4ce4a32-4fd3-4f63-a4e3-1e0a8c2b1e7a",
+				Service:      "EC2",
+			},
+		},
+		"quota exceeded": {
+			err:  errs.APIError("VpcLimitExceeded", "message"),
+			want: errs.ErrorInfo{Code: errs.CodeQuotaExceeded, AWSErrorCode: "VpcLimitExceeded"},
+		},
+		"service quota exceeded": {
+			err:  errs.APIError("ServiceQuotaExceededException", "message"),
+			want: errs.ErrorInfo{Code: errs.CodeQuotaExceeded, AWSErrorCode: "ServiceQuotaExceededException"},
+		},
+		"Plugin SDK not found": {
+			err:  &sdkretry.NotFoundError{},
+			want: errs.ErrorInfo{Code: errs.CodeNotFound},
+		},
+		"Plugin SDK timeout": {
+			err:  &sdkretry.TimeoutError{},
+			want: errs.ErrorInfo{Code: errs.CodeWaitTimeout},
+		},
+		"deadline exceeded": {
+			err:  context.DeadlineExceeded,
+			want: errs.ErrorInfo{Code: errs.CodeWaitTimeout},
+		},
+		"coder": {
+			err:  fmt.Errorf("waiting: %w", testCodeError{}),
+			want: errs.ErrorInfo{Code: errs.CodeWaitTimeout},
+		},
+		"other": {
+			err:  errors.New("boom"),
+			want: errs.ErrorInfo{Code: errs.CodeError},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(errs.Classify(testCase.err), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTrailer(t *testing.T) {
+	t.Parallel()
+
+	err := newOperationError(http.StatusNotFound, "ResourceNotFoundException")
+
+	got := errs.WithTrailer("reading thing", err)
+	want := `reading thing
+
+[diagnostic code="AWS.NotFound" aws_error_code="ResourceNotFoundException" operation="DescribeInstances" request_id="f4ce4a32-4fd3-4f63-a4e3-1e0a8c2b1e7a" service="EC2"]`
+	if got != want {
+		t.Errorf("WithTrailer = %q, want %q", got, want)
+	}
+
+	if again := errs.WithTrailer(got, err); again != got {
+		t.Errorf("WithTrailer added a second trailer: %q", again)
+	}
+
+	info, ok := errs.ParseTrailer(got)
+	if !ok {
+		t.Fatalf("ParseTrailer(%q) failed", got)
+	}
+	if diff := cmp.Diff(info, errs.Classify(err)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := errs.WithTrailer("", errs.APIError("Throttling", `quoted "message"`)), `[diagnostic code="AWS.Throttled" aws_error_code="Throttling"]`; got != want {
+		t.Errorf("WithTrailer = %q, want %q", got, want)
+	}
+
+	if got := errs.WithTrailer("detail", nil); got != "detail" {
+		t.Errorf("WithTrailer = %q, want detail", got)
+	}
+}
+
+func TestParseTrailer(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		detail string
+		want   errs.ErrorInfo
+		wantOK bool
+	}{
+		"empty": {},
+		"no trailer": {
+			detail: "something went wrong",
+		},
+		"trailer only": {
+			detail: `[diagnostic code="AWS.QuotaExceeded" service="Elastic Load Balancing v2"]`,
+			want:   errs.ErrorInfo{Code: errs.CodeQuotaExceeded, Service: "Elastic Load Balancing v2"},
+			wantOK: true,
+		},
+		"not last line": {
+			detail: "[diagnostic code=\"AWS.NotFound\"]\nmore",
+		},
+		"malformed": {
+			detail: `[diagnostic code=AWS.NotFound]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.ParseTrailer(testCase.detail)
+			if ok != testCase.wantOK {
+				t.Fatalf("ParseTrailer ok = %t, want %t", ok, testCase.wantOK)
+			}
+			if diff := cmp.Diff(got, testCase.want); ok && diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -44,21 +45,21 @@ func DiagnosticString(d diag.Diagnostic) string {
 func NewCreatingResourceIDErrorDiagnostic(err error) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		"Creating Resource ID",
-		err.Error(),
+		errs.WithTrailer(err.Error(), err),
 	)
 }
 
 func NewParsingResourceIDErrorDiagnostic(err error) diag.Diagnostic {
 	return diag.NewErrorDiagnostic(
 		"Parsing Resource ID",
-		err.Error(),
+		errs.WithTrailer(err.Error(), err),
 	)
 }
 
 func NewResourceNotFoundWarningDiagnostic(err error) diag.Diagnostic {
 	return diag.NewWarningDiagnostic(
 		"AWS resource not found during refresh",
-		"Automatically removing from Terraform State instead of returning the error, which may trigger resource recreation. Original error: "+err.Error(),
+		errs.WithTrailer("Automatically removing from Terraform State instead of returning the error, which may trigger resource recreation. Original error: "+err.Error(), err),
 	)
 }
 
@@ -67,7 +68,7 @@ func NewListResultErrorDiagnostic(err error) list.ListResult {
 		Diagnostics: diag.Diagnostics{
 			diag.NewErrorDiagnostic(
 				"Error Listing Remote Resources",
-				err.Error(),
+				errs.WithTrailer(err.Error(), err),
 			),
 		},
 	}
@@ -5,8 +5,10 @@ package sdkdiag
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
@@ -17,15 +19,40 @@ func AppendWarningf(diags diag.Diagnostics, format string, a ...any) diag.Diagno
 	})
 }
 
+// AppendErrorf appends an error diagnostic with a formatted summary.
+// If any of the arguments is an error, the diagnostic's detail carries the error's machine-readable trailer.
 func AppendErrorf(diags diag.Diagnostics, format string, a ...any) diag.Diagnostics {
-	return append(diags, diag.Errorf(format, a...)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
+	return append(diags, withTrailer(diag.Errorf(format, a...), lastError(a))...) // nosemgrep:ci.semgrep.pluginsdk.avoid-diag_Errorf
 }
 
+// AppendFromErr appends an error diagnostic whose detail carries the error's machine-readable trailer.
 func AppendFromErr(diags diag.Diagnostics, err error) diag.Diagnostics {
 	if err == nil {
 		return diags
 	}
-	return append(diags, diag.FromErr(err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+	return append(diags, withTrailer(diag.FromErr(err), err)...) // nosemgrep:ci.semgrep.pluginsdk.avoid-append-diag_FromErr
+}
+
+func withTrailer(diags diag.Diagnostics, err error) diag.Diagnostics {
+	if err == nil {
+		return diags
+	}
+
+	for i := range diags {
+		diags[i].Detail = errs.WithTrailer(diags[i].Detail, err)
+	}
+
+	return diags
+}
+
+func lastError(a []any) error {
+	for _, v := range slices.Backward(a) {
+		if err, ok := v.(error); ok {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func WrapDiagsf(orig diag.Diagnostics, format string, a ...any) diag.Diagnostics {
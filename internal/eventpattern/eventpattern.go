@@ -0,0 +1,542 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+// Event patterns are evaluated locally following the EventBridge content filtering rules.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html
+// and https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-create-pattern-operators.html.
+
+const (
+	eventPatternOperatorAnythingBut      = "anything-but"
+	eventPatternOperatorCIDR             = "cidr"
+	eventPatternOperatorEqualsIgnoreCase = "equals-ignore-case"
+	eventPatternOperatorExists           = "exists"
+	eventPatternOperatorNumeric          = "numeric"
+	eventPatternOperatorOr               = "$or"
+	eventPatternOperatorPrefix           = "prefix"
+	eventPatternOperatorSuffix           = "suffix"
+	eventPatternOperatorWildcard         = "wildcard"
+)
+
+// eventPattern is a parsed event pattern object.
+// All fields must match and, if present, at least one of the $or alternatives must match.
+type eventPattern struct {
+	fields map[string]eventPatternField
+	or     []*eventPattern
+}
+
+// eventPatternField is either a nested pattern object or a list of matchers for a leaf value.
+type eventPatternField struct {
+	nested   *eventPattern
+	matchers []eventPatternMatcher
+}
+
+type eventPatternMatcher struct {
+	// exists is set for the "exists" operator, which matches on the presence of a field rather than on its value.
+	exists *bool
+	match  func(any) bool
+}
+
+// Validate returns an error if the specified event pattern is not valid.
+func Validate(pattern string) error {
+	_, err := parseEventPattern(pattern)
+
+	return err
+}
+
+// Match returns whether the specified event matches the specified event pattern.
+func Match(pattern, event string) (bool, error) {
+	p, err := parseEventPattern(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	var v any
+	if err := tfjson.DecodeFromString(event, &v); err != nil {
+		return false, fmt.Errorf("decoding event: %w", err)
+	}
+
+	e, ok := v.(map[string]any)
+	if !ok {
+		return false, errors.New("event must be a JSON object")
+	}
+
+	return p.match(e), nil
+}
+
+func parseEventPattern(pattern string) (*eventPattern, error) {
+	var v any
+	if err := tfjson.DecodeFromString(pattern, &v); err != nil {
+		return nil, fmt.Errorf("decoding event pattern: %w", err)
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("event pattern must be a JSON object")
+	}
+
+	return parseEventPatternObject(m, "")
+}
+
+func parseEventPatternObject(m map[string]any, path string) (*eventPattern, error) {
+	p := &eventPattern{
+		fields: make(map[string]eventPatternField, len(m)),
+	}
+
+	for k, v := range m {
+		fieldPath := path + "/" + k
+
+		if k == eventPatternOperatorOr {
+			alternatives, ok := v.([]any)
+			if !ok || len(alternatives) < 2 {
+				return nil, fmt.Errorf("%s: must be an array of at least 2 pattern objects", fieldPath)
+			}
+
+			for i, alternative := range alternatives {
+				m, ok := alternative.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("%s/%d: must be a pattern object", fieldPath, i)
+				}
+
+				or, err := parseEventPatternObject(m, fmt.Sprintf("%s/%d", fieldPath, i))
+				if err != nil {
+					return nil, err
+				}
+
+				p.or = append(p.or, or)
+			}
+
+			continue
+		}
+
+		switch v := v.(type) {
+		case map[string]any:
+			nested, err := parseEventPatternObject(v, fieldPath)
+			if err != nil {
+				return nil, err
+			}
+
+			p.fields[k] = eventPatternField{nested: nested}
+		case []any:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s: must not be an empty array", fieldPath)
+			}
+
+			matchers := make([]eventPatternMatcher, 0, len(v))
+			for i, v := range v {
+				matcher, err := parseEventPatternMatcher(v, fmt.Sprintf("%s/%d", fieldPath, i))
+				if err != nil {
+					return nil, err
+				}
+
+				matchers = append(matchers, matcher)
+			}
+
+			p.fields[k] = eventPatternField{matchers: matchers}
+		default:
+			return nil, fmt.Errorf("%s: must be an object or an array", fieldPath)
+		}
+	}
+
+	return p, nil
+}
+
+func parseEventPatternMatcher(v any, path string) (eventPatternMatcher, error) {
+	switch v := v.(type) {
+	case nil, bool, float64, string:
+		return eventPatternMatcher{match: func(value any) bool { return value == v }}, nil
+	case map[string]any:
+		if len(v) != 1 {
+			return eventPatternMatcher{}, fmt.Errorf("%s: must contain exactly one operator", path)
+		}
+
+		for operator, operand := range v {
+			path := path + "/" + operator
+
+			switch operator {
+			case eventPatternOperatorAnythingBut:
+				match, err := parseEventPatternAnythingBut(operand, path)
+				if err != nil {
+					return eventPatternMatcher{}, err
+				}
+
+				return eventPatternMatcher{match: match}, nil
+			case eventPatternOperatorCIDR:
+				s, ok := operand.(string)
+				if !ok {
+					return eventPatternMatcher{}, fmt.Errorf("%s: must be a string", path)
+				}
+
+				prefix, err := netip.ParsePrefix(s)
+				if err != nil {
+					return eventPatternMatcher{}, fmt.Errorf("%s: %w", path, err)
+				}
+
+				return eventPatternMatcher{match: func(value any) bool {
+					s, ok := value.(string)
+					if !ok {
+						return false
+					}
+					addr, err := netip.ParseAddr(s)
+					return err == nil && prefix.Contains(addr)
+				}}, nil
+			case eventPatternOperatorEqualsIgnoreCase:
+				s, ok := operand.(string)
+				if !ok {
+					return eventPatternMatcher{}, fmt.Errorf("%s: must be a string", path)
+				}
+
+				return eventPatternMatcher{match: matchEventPatternEqualsIgnoreCase(s)}, nil
+			case eventPatternOperatorExists:
+				b, ok := operand.(bool)
+				if !ok {
+					return eventPatternMatcher{}, fmt.Errorf("%s: must be a boolean", path)
+				}
+
+				return eventPatternMatcher{exists: &b}, nil
+			case eventPatternOperatorNumeric:
+				match, err := parseEventPatternNumeric(operand, path)
+				if err != nil {
+					return eventPatternMatcher{}, err
+				}
+
+				return eventPatternMatcher{match: match}, nil
+			case eventPatternOperatorPrefix, eventPatternOperatorSuffix:
+				match, err := parseEventPatternPrefixOrSuffix(operator, operand, path)
+				if err != nil {
+					return eventPatternMatcher{}, err
+				}
+
+				return eventPatternMatcher{match: match}, nil
+			case eventPatternOperatorWildcard:
+				s, ok := operand.(string)
+				if !ok {
+					return eventPatternMatcher{}, fmt.Errorf("%s: must be a string", path)
+				}
+
+				match, err := parseEventPatternWildcard(s, path)
+				if err != nil {
+					return eventPatternMatcher{}, err
+				}
+
+				return eventPatternMatcher{match: match}, nil
+			default:
+				return eventPatternMatcher{}, fmt.Errorf("%s: unsupported operator", path)
+			}
+		}
+	}
+
+	return eventPatternMatcher{}, fmt.Errorf("%s: must be a string, number, boolean, null or operator object", path)
+}
+
+func matchEventPatternEqualsIgnoreCase(s string) func(any) bool {
+	return func(value any) bool {
+		v, ok := value.(string)
+		return ok && strings.EqualFold(v, s)
+	}
+}
+
+// parseEventPatternPrefixOrSuffix parses a "prefix" or "suffix" operand, which is either a string or an "equals-ignore-case" object.
+func parseEventPatternPrefixOrSuffix(operator string, operand any, path string) (func(any) bool, error) {
+	hasAffix := strings.HasPrefix
+	if operator == eventPatternOperatorSuffix {
+		hasAffix = strings.HasSuffix
+	}
+
+	var ignoreCase bool
+	if m, ok := operand.(map[string]any); ok && len(m) == 1 {
+		if v, ok := m[eventPatternOperatorEqualsIgnoreCase]; ok {
+			operand, ignoreCase = v, true
+		}
+	}
+
+	s, ok := operand.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a string or an %q object", path, eventPatternOperatorEqualsIgnoreCase)
+	}
+
+	if ignoreCase {
+		s = strings.ToLower(s)
+	}
+
+	return func(value any) bool {
+		v, ok := value.(string)
+		if !ok {
+			return false
+		}
+		if ignoreCase {
+			v = strings.ToLower(v)
+		}
+		return hasAffix(v, s)
+	}, nil
+}
+
+// parseEventPatternAnythingBut parses an "anything-but" operand, which is a literal, an array of literals or a
+// "prefix", "suffix", "equals-ignore-case" or "wildcard" object.
+func parseEventPatternAnythingBut(operand any, path string) (func(any) bool, error) {
+	var match func(any) bool
+
+	switch v := operand.(type) {
+	case bool, float64, string:
+		match = func(value any) bool { return value == v }
+	case []any:
+		if len(v) == 0 {
+			return nil, fmt.Errorf("%s: must not be an empty array", path)
+		}
+		for i, v := range v {
+			switch v.(type) {
+			case float64, string:
+			default:
+				return nil, fmt.Errorf("%s/%d: must be a string or number", path, i)
+			}
+		}
+		match = func(value any) bool { return slices.Contains(v, value) }
+	case map[string]any:
+		if len(v) != 1 {
+			return nil, fmt.Errorf("%s: must contain exactly one operator", path)
+		}
+
+		for operator, operand := range v {
+			path := path + "/" + operator
+
+			switch operator {
+			case eventPatternOperatorPrefix, eventPatternOperatorSuffix:
+				if _, ok := operand.(string); !ok {
+					return nil, fmt.Errorf("%s: must be a string", path)
+				}
+
+				var err error
+				if match, err = parseEventPatternPrefixOrSuffix(operator, operand, path); err != nil {
+					return nil, err
+				}
+			case eventPatternOperatorEqualsIgnoreCase, eventPatternOperatorWildcard:
+				var matches []func(any) bool
+				operands, ok := operand.([]any)
+				if !ok {
+					operands = []any{operand}
+				}
+				if len(operands) == 0 {
+					return nil, fmt.Errorf("%s: must not be an empty array", path)
+				}
+
+				for _, operand := range operands {
+					s, ok := operand.(string)
+					if !ok {
+						return nil, fmt.Errorf("%s: must be a string or an array of strings", path)
+					}
+
+					if operator == eventPatternOperatorEqualsIgnoreCase {
+						matches = append(matches, matchEventPatternEqualsIgnoreCase(s))
+					} else {
+						m, err := parseEventPatternWildcard(s, path)
+						if err != nil {
+							return nil, err
+						}
+						matches = append(matches, m)
+					}
+				}
+
+				match = func(value any) bool {
+					return slices.ContainsFunc(matches, func(f func(any) bool) bool { return f(value) })
+				}
+			default:
+				return nil, fmt.Errorf("%s: unsupported operator", path)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%s: must be a string, number, boolean, array or operator object", path)
+	}
+
+	return func(value any) bool {
+		// "anything-but" only matches values of the same kind as its operand, never null.
+		return value != nil && !match(value)
+	}, nil
+}
+
+// parseEventPatternNumeric parses a "numeric" operand, an array of one or two comparison operator and value pairs.
+func parseEventPatternNumeric(operand any, path string) (func(any) bool, error) {
+	v, ok := operand.([]any)
+	if !ok || (len(v) != 2 && len(v) != 4) {
+		return nil, fmt.Errorf("%s: must be an array of one or two comparisons", path)
+	}
+
+	var comparisons []func(float64) bool
+	for i := 0; i < len(v); i += 2 {
+		operator, ok := v[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("%s/%d: must be a comparison operator", path, i)
+		}
+
+		n, ok := v[i+1].(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s/%d: must be a number", path, i+1)
+		}
+
+		var comparison func(float64) bool
+		switch operator {
+		case "<":
+			comparison = func(x float64) bool { return x < n }
+		case "<=":
+			comparison = func(x float64) bool { return x <= n }
+		case "=":
+			if len(v) != 2 {
+				return nil, fmt.Errorf("%s/%d: %q cannot be combined with another comparison", path, i, operator)
+			}
+			comparison = func(x float64) bool { return x == n }
+		case ">":
+			comparison = func(x float64) bool { return x > n }
+		case ">=":
+			comparison = func(x float64) bool { return x >= n }
+		default:
+			return nil, fmt.Errorf("%s/%d: unsupported comparison operator %q", path, i, operator)
+		}
+
+		comparisons = append(comparisons, comparison)
+	}
+
+	if len(v) == 4 {
+		lower, upper := v[0].(string), v[2].(string)
+		if !strings.HasPrefix(lower, ">") || !strings.HasPrefix(upper, "<") {
+			return nil, fmt.Errorf("%s: a range must be a lower bound followed by an upper bound", path)
+		}
+		if v[1].(float64) >= v[3].(float64) {
+			return nil, fmt.Errorf("%s: the lower bound must be less than the upper bound", path)
+		}
+	}
+
+	return func(value any) bool {
+		x, ok := value.(float64)
+		if !ok {
+			return false
+		}
+		for _, comparison := range comparisons {
+			if !comparison(x) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// parseEventPatternWildcard parses a "wildcard" operand, in which "*" matches any sequence of characters and "\*" matches a literal "*".
+func parseEventPatternWildcard(s string, path string) (func(any) bool, error) {
+	var (
+		parts   []string
+		current strings.Builder
+	)
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) && (s[i+1] == '*' || s[i+1] == '\\') {
+				i++
+				current.WriteByte(s[i])
+			} else {
+				return nil, fmt.Errorf(`%s: invalid escape sequence in %q`, path, s)
+			}
+		case '*':
+			if i > 0 && s[i-1] == '*' {
+				return nil, fmt.Errorf("%s: consecutive wildcard characters are not allowed in %q", path, s)
+			}
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(c)
+		}
+	}
+	parts = append(parts, current.String())
+
+	return func(value any) bool {
+		v, ok := value.(string)
+		if !ok {
+			return false
+		}
+
+		return matchWildcardParts(v, parts)
+	}, nil
+}
+
+// matchWildcardParts returns whether s consists of the specified literal parts separated by arbitrary strings.
+func matchWildcardParts(s string, parts []string) bool {
+	if len(parts) == 1 {
+		return s == parts[0]
+	}
+
+	first, last := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(s, first) {
+		return false
+	}
+	s = s[len(first):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+
+	return strings.HasSuffix(s, last)
+}
+
+func (p *eventPattern) match(event map[string]any) bool {
+	for k, field := range p.fields {
+		value, present := event[k]
+		if !field.match(value, present) {
+			return false
+		}
+	}
+
+	if len(p.or) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(p.or, func(or *eventPattern) bool { return or.match(event) })
+}
+
+func (f eventPatternField) match(value any, present bool) bool {
+	if f.nested != nil {
+		switch v := value.(type) {
+		case map[string]any:
+			return f.nested.match(v)
+		case []any:
+			// Each object in an array is matched separately.
+			return slices.ContainsFunc(v, func(v any) bool {
+				m, ok := v.(map[string]any)
+				return ok && f.nested.match(m)
+			})
+		default:
+			// A pattern for a missing object can still match, for example with "exists": false.
+			return f.nested.match(nil)
+		}
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+
+	return slices.ContainsFunc(f.matchers, func(m eventPatternMatcher) bool {
+		if m.exists != nil {
+			if _, ok := value.(map[string]any); ok {
+				// "exists" only applies to leaf values.
+				return false
+			}
+
+			return present == *m.exists
+		}
+
+		return present && slices.ContainsFunc(values, m.match)
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern     string
+		expectError bool
+	}{
+		"invalid JSON": {
+			pattern:     `{"source": [`,
+			expectError: true,
+		},
+		"not an object": {
+			pattern:     `["aws.ec2"]`,
+			expectError: true,
+		},
+		"literals": {
+			pattern: `{"source": ["aws.ec2"], "detail": {"count": [5], "enabled": [true], "reason": [null]}}`,
+		},
+		"leaf not an array": {
+			pattern:     `{"source": "aws.ec2"}`,
+			expectError: true,
+		},
+		"empty array": {
+			pattern:     `{"source": []}`,
+			expectError: true,
+		},
+		"operators": {
+			pattern: `{
+  "source": [{"prefix": "aws."}, {"suffix": {"equals-ignore-case": ".EC2"}}],
+  "detail": {
+    "state": [{"anything-but": ["stopped", "terminated"]}],
+    "instance-id": [{"anything-but": {"prefix": "i-0"}}],
+    "type": [{"anything-but": {"wildcard": ["t2.*", "t3.*"]}}],
+    "count": [{"numeric": [">", 0, "<=", 5]}],
+    "ip": [{"cidr": "10.0.0.0/24"}],
+    "name": [{"equals-ignore-case": "Example"}],
+    "path": [{"wildcard": "dir/*.png"}],
+    "error": [{"exists": false}]
+  }
+}`,
+		},
+		"unsupported operator": {
+			pattern:     `{"source": [{"contains": "ec2"}]}`,
+			expectError: true,
+		},
+		"multiple operators": {
+			pattern:     `{"source": [{"prefix": "aws.", "suffix": "ec2"}]}`,
+			expectError: true,
+		},
+		"prefix not a string": {
+			pattern:     `{"source": [{"prefix": 1}]}`,
+			expectError: true,
+		},
+		"exists not a boolean": {
+			pattern:     `{"source": [{"exists": "true"}]}`,
+			expectError: true,
+		},
+		"numeric unsupported comparison": {
+			pattern:     `{"count": [{"numeric": ["!=", 0]}]}`,
+			expectError: true,
+		},
+		"numeric invalid range": {
+			pattern:     `{"count": [{"numeric": [">", 5, "<", 0]}]}`,
+			expectError: true,
+		},
+		"invalid CIDR": {
+			pattern:     `{"ip": [{"cidr": "10.0.0.0/33"}]}`,
+			expectError: true,
+		},
+		"consecutive wildcards": {
+			pattern:     `{"path": [{"wildcard": "dir/**"}]}`,
+			expectError: true,
+		},
+		"or": {
+			pattern: `{"source": ["aws.ec2"], "$or": [{"detail-type": ["a"]}, {"detail": {"state": ["running"]}}]}`,
+		},
+		"or with one alternative": {
+			pattern:     `{"$or": [{"source": ["aws.ec2"]}]}`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := eventpattern.Validate(testCase.pattern)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateEventPattern(%s) err %t, want %t: %v", testCase.pattern, got, want, err)
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	const event = `{
+  "version": "0",
+  "id": "6a7e8feb-b491-4cf7-a9f1-bf3703467718",
+  "detail-type": "EC2 Instance State-change Notification",
+  "source": "aws.ec2",
+  "account": "111122223333",
+  "time": "2017-12-22T18:43:48Z",
+  "region": "us-west-1",
+  "resources": ["arn:aws:ec2:us-west-1:123456789012:instance/i-1234567890abcdef0"],
+  "detail": {
+    "instance-id": "i-1234567890abcdef0",
+    "state": "running",
+    "count": 3,
+    "ip": "10.0.0.12",
+    "tags": ["prod", "web"],
+    "file": "dir/image.png",
+    "volumes": [{"id": "vol-1", "size": 8}, {"id": "vol-2", "size": 100}]
+  }
+}`
+
+	testCases := map[string]struct {
+		pattern string
+		want    bool
+	}{
+		"literal match":                    {pattern: `{"source": ["aws.ec2"]}`, want: true},
+		"literal no match":                 {pattern: `{"source": ["aws.s3"]}`, want: false},
+		"multiple fields":                  {pattern: `{"source": ["aws.ec2"], "detail": {"state": ["running", "pending"]}}`, want: true},
+		"number literal":                   {pattern: `{"detail": {"count": [3]}}`, want: true},
+		"number does not match string":     {pattern: `{"detail": {"count": ["3"]}}`, want: false},
+		"array value":                      {pattern: `{"detail": {"tags": ["web"]}}`, want: true},
+		"missing field":                    {pattern: `{"detail": {"reason": ["x"]}}`, want: false},
+		"prefix":                           {pattern: `{"region": [{"prefix": "us-"}]}`, want: true},
+		"prefix ignore case":               {pattern: `{"region": [{"prefix": {"equals-ignore-case": "US-"}}]}`, want: true},
+		"suffix":                           {pattern: `{"detail": {"file": [{"suffix": ".jpg"}]}}`, want: false},
+		"anything-but":                     {pattern: `{"detail": {"state": [{"anything-but": "stopped"}]}}`, want: true},
+		"anything-but list":                {pattern: `{"detail": {"state": [{"anything-but": ["running", "stopped"]}]}}`, want: false},
+		"anything-but prefix":              {pattern: `{"detail": {"instance-id": [{"anything-but": {"prefix": "i-0"}}]}}`, want: true},
+		"anything-but missing field":       {pattern: `{"detail": {"reason": [{"anything-but": "x"}]}}`, want: false},
+		"numeric range":                    {pattern: `{"detail": {"count": [{"numeric": [">", 0, "<=", 3]}]}}`, want: true},
+		"numeric no match":                 {pattern: `{"detail": {"count": [{"numeric": [">=", 5]}]}}`, want: false},
+		"cidr":                             {pattern: `{"detail": {"ip": [{"cidr": "10.0.0.0/24"}]}}`, want: true},
+		"cidr no match":                    {pattern: `{"detail": {"ip": [{"cidr": "10.0.1.0/24"}]}}`, want: false},
+		"equals-ignore-case":               {pattern: `{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`, want: true},
+		"wildcard":                         {pattern: `{"detail": {"file": [{"wildcard": "dir/*.png"}]}}`, want: true},
+		"wildcard no match":                {pattern: `{"detail": {"file": [{"wildcard": "*/*.jpg"}]}}`, want: false},
+		"exists":                           {pattern: `{"detail": {"state": [{"exists": true}]}}`, want: true},
+		"exists false":                     {pattern: `{"detail": {"reason": [{"exists": false}]}}`, want: true},
+		"exists false present":             {pattern: `{"detail": {"state": [{"exists": false}]}}`, want: false},
+		"exists on object":                 {pattern: `{"detail": [{"exists": true}]}`, want: false},
+		"array of objects":                 {pattern: `{"detail": {"volumes": {"size": [{"numeric": [">", 50]}]}}}`, want: true},
+		"or first alternative":             {pattern: `{"$or": [{"source": ["aws.ec2"]}, {"source": ["aws.s3"]}]}`, want: true},
+		"or no alternative":                {pattern: `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["stopped"]}}]}`, want: false},
+		"or with other fields":             {pattern: `{"source": ["aws.s3"], "$or": [{"region": ["us-west-1"]}, {"account": ["111122223333"]}]}`, want: false},
+		"nested pattern for missing field": {pattern: `{"other": {"reason": [{"exists": false}]}}`, want: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := eventpattern.Match(testCase.pattern, event)
+			if err != nil {
+				t.Fatalf("MatchEventPattern(%s): %s", testCase.pattern, err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("MatchEventPattern(%s) = %t, want %t", testCase.pattern, got, testCase.want)
+			}
+		})
+	}
+}
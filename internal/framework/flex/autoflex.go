@@ -21,6 +21,17 @@ const (
 	mapBlockKeyFieldName = "MapBlockKey"
 )
 
+// fieldSkipReason is the reason that AutoFlex skips a struct field.
+type fieldSkipReason string
+
+const (
+	fieldSkipReasonIgnoredFieldName fieldSkipReason = "ignored field name"
+	fieldSkipReasonIgnoredTag       fieldSkipReason = `autoflex:"-"`
+	fieldSkipReasonMapBlockKey      fieldSkipReason = "map block key"
+	fieldSkipReasonNoExpand         fieldSkipReason = "noexpand"
+	fieldSkipReasonNoFlatten        fieldSkipReason = "noflatten"
+)
+
 // Expand  = TF -->  AWS
 // Flatten = AWS --> TF
 
@@ -66,9 +77,14 @@ var (
 type fuzzyFieldFinder struct {
 	prefixRecursionDepth int
 	suffixRecursionDepth int
+	// matchedBy describes how the last field found was matched, e.g. "case-insensitive".
+	// It's empty for an exact match.
+	matchedBy string
 }
 
 func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string, typeFrom reflect.Type, typeTo reflect.Type, opts AutoFlexOptions) (reflect.StructField, bool) { //nolint:unparam
+	fff.matchedBy = ""
+
 	// first precedence is exact match (case sensitive)
 	if fieldTo, ok := typeTo.FieldByName(fieldNameFrom); ok {
 		return fieldTo, true
@@ -88,6 +104,7 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 		}
 		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
 			// probably could assume validity here since reflect gave the field name
+			fff.matchedBy = "case-insensitive"
 			return fieldTo, true
 		}
 	}
@@ -96,6 +113,7 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 	fieldNameTo := plural.Plural(fieldNameFrom)
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
 		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok {
+			fff.matchedBy = "plural"
 			return fieldTo, true
 		}
 	}
@@ -103,6 +121,7 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 	fieldNameTo = plural.Singular(fieldNameFrom)
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(fieldNameTo, typeFrom) {
 		if fieldTo, ok := typeTo.FieldByName(fieldNameTo); ok {
+			fff.matchedBy = "singular"
 			return fieldTo, true
 		}
 	}
@@ -116,11 +135,13 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 			if trimmed, ok := strings.CutPrefix(fieldNameFrom, v); ok {
 				if fieldTo, ok := fff.findField(ctx, trimmed, typeFrom, typeTo, opts); ok {
 					fff.prefixRecursionDepth--
+					fff.matchedWith(fmt.Sprintf("prefix %q", v))
 					return fieldTo, true
 				}
 			} else {
 				if fieldTo, ok := fff.findField(ctx, v+fieldNameFrom, typeFrom, typeTo, opts); ok {
 					fff.prefixRecursionDepth--
+					fff.matchedWith(fmt.Sprintf("prefix %q", v))
 					return fieldTo, true
 				}
 			}
@@ -137,10 +158,16 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 			if before, ok := strings.CutSuffix(fieldNameFrom, v); ok {
 				fieldTo, ok := fff.findField(ctx, before, typeFrom, typeTo, opts)
 				fff.suffixRecursionDepth--
+				if ok {
+					fff.matchedWith(fmt.Sprintf("suffix %q", v))
+				}
 				return fieldTo, ok
 			}
 			fieldTo, ok := fff.findField(ctx, fieldNameFrom+v, typeFrom, typeTo, opts)
 			fff.suffixRecursionDepth--
+			if ok {
+				fff.matchedWith(fmt.Sprintf("suffix %q", v))
+			}
 			return fieldTo, ok
 		}
 	}
@@ -149,6 +176,15 @@ func (fff *fuzzyFieldFinder) findField(ctx context.Context, fieldNameFrom string
 	return reflect.StructField{}, false
 }
 
+// matchedWith records a field name mutation used to find the last field.
+func (fff *fuzzyFieldFinder) matchedWith(mutation string) {
+	if fff.matchedBy == "" {
+		fff.matchedBy = mutation
+	} else {
+		fff.matchedBy = mutation + ", " + fff.matchedBy
+	}
+}
+
 func fieldExistsInStruct(field string, structType reflect.Type) bool {
 	_, ok := structType.FieldByName(field)
 	return ok
@@ -575,6 +575,11 @@ func expandString(ctx context.Context, _ *autoExpander, vFrom basetypes.StringVa
 				return diags
 			}
 
+			if v == nil {
+				tflog.SubsystemTrace(ctx, subsystemName, "Expanding null value")
+				return diags
+			}
+
 			vTo.Set(reflect.ValueOf(v))
 			return diags
 		}
@@ -1421,6 +1426,12 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	}
 
 	if valTo.Kind() == reflect.Interface {
+		if fromUnion, ok := valFrom.Interface().(SmithyUnion); ok {
+			tflog.SubsystemInfo(ctx, subsystemName, "Source implements flex.SmithyUnion")
+			diags.Append(expandSmithyUnion(ctx, expander, sourcePath, valFrom, fromUnion, targetPath, valTo)...)
+			return diags
+		}
+
 		tflog.SubsystemError(ctx, subsystemName, "Expanding to incompatible interface")
 		// TODO: Should continue failing silently for now
 		// diags.Append(diagExpandingIncompatibleTypes(reflect.TypeOf(vFrom), vTo.Type()))
@@ -1441,7 +1452,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 	for fromField := range expandSourceFields(ctx, typeFrom, expander.Options) {
 		fromFieldName := fromField.Name
 		_, fromFieldOpts := autoflexTags(fromField)
-		if fromFieldOpts.NoExpand() {
+		if expandStructSourceFieldSkipReason(fromField) == fieldSkipReasonNoExpand {
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping noexpand source field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
@@ -1507,23 +1518,14 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
-			fieldName := field.Name
-			if opts.isIgnoredField(fieldName) {
+			switch expandSourceFieldSkipReason(field, opts) {
+			case fieldSkipReasonIgnoredFieldName, fieldSkipReasonIgnoredTag:
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
+					logAttrKeySourceFieldname: field.Name,
 				})
 				continue
-			}
 
-			fromNameOverride, _ := autoflexTags(field)
-			if fromNameOverride == "-" {
-				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
-				})
-				continue
-			}
-
-			if fieldName == mapBlockKeyFieldName {
+			case fieldSkipReasonMapBlockKey:
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping map block key", map[string]any{
 					logAttrKeySourceFieldname: mapBlockKeyFieldName,
 				})
@@ -1537,6 +1539,33 @@ func expandSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOpti
 	}
 }
 
+// expandSourceFieldSkipReason returns why Expand never reads the specified source field, or "" if it may.
+func expandSourceFieldSkipReason(field reflect.StructField, opts AutoFlexOptions) fieldSkipReason {
+	if opts.isIgnoredField(field.Name) {
+		return fieldSkipReasonIgnoredFieldName
+	}
+
+	if nameOverride, _ := autoflexTags(field); nameOverride == "-" {
+		return fieldSkipReasonIgnoredTag
+	}
+
+	if field.Name == mapBlockKeyFieldName {
+		return fieldSkipReasonMapBlockKey
+	}
+
+	return ""
+}
+
+// expandStructSourceFieldSkipReason returns why expandStruct skips the specified source field
+// yielded by expandSourceFields, or "" if it doesn't.
+func expandStructSourceFieldSkipReason(field reflect.StructField) fieldSkipReason {
+	if _, fieldOpts := autoflexTags(field); fieldOpts.NoExpand() {
+		return fieldSkipReasonNoExpand
+	}
+
+	return ""
+}
+
 // mapBlockKey takes a struct and extracts the value of the `key`
 func mapBlockKey(ctx context.Context, from any) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -0,0 +1,387 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// FieldMappingKind describes how AutoFlex maps a single struct field.
+type FieldMappingKind string
+
+const (
+	// FieldMappingMatched is a field with an exact, case-sensitive name match.
+	FieldMappingMatched FieldMappingKind = "matched"
+	// FieldMappingNormalized is a field matched after name normalization,
+	// e.g. case-insensitive, singular/plural or prefix/suffix matching.
+	FieldMappingNormalized FieldMappingKind = "normalized"
+	// FieldMappingIgnored is a field that AutoFlex deliberately skips.
+	FieldMappingIgnored FieldMappingKind = "ignored"
+	// FieldMappingUnmatchedTerraform is a Terraform model field with no AWS API counterpart.
+	FieldMappingUnmatchedTerraform FieldMappingKind = "unmatched-terraform"
+	// FieldMappingUnmatchedAWS is an AWS API field with no Terraform model counterpart.
+	FieldMappingUnmatchedAWS FieldMappingKind = "unmatched-aws"
+)
+
+// FieldMapping describes how AutoFlex maps a single struct field.
+type FieldMapping struct {
+	Kind FieldMappingKind
+	// Path is the path to the field, e.g. "Configuration.Size".
+	// Terraform model field names are used when the field has a Terraform counterpart.
+	Path           string
+	TerraformField string
+	AWSField       string
+	// Conversion is the Terraform and AWS API field types, e.g. "types.String <-> *string".
+	Conversion string
+	// Reason explains normalized and ignored mappings.
+	Reason string
+}
+
+// FieldMappingReport is the result of analyzing the AutoFlex field mappings
+// between a Terraform model type and an AWS API type.
+type FieldMappingReport struct {
+	Direction     string // "expand" or "flatten"
+	TerraformType string
+	AWSType       string
+	Mappings      []FieldMapping
+}
+
+// Unmatched returns the mappings of the specified kinds.
+func (r *FieldMappingReport) Unmatched(kinds ...FieldMappingKind) []FieldMapping {
+	if len(kinds) == 0 {
+		kinds = []FieldMappingKind{FieldMappingUnmatchedTerraform, FieldMappingUnmatchedAWS}
+	}
+
+	return slices.DeleteFunc(slices.Clone(r.Mappings), func(m FieldMapping) bool {
+		return !slices.Contains(kinds, m.Kind)
+	})
+}
+
+// UnmatchedAWSFields returns the AWS API fields that AutoFlex silently skips.
+func (r *FieldMappingReport) UnmatchedAWSFields() []FieldMapping {
+	return r.Unmatched(FieldMappingUnmatchedAWS)
+}
+
+// String returns the report as a table.
+func (r *FieldMappingReport) String() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "AutoFlex %s: %s <-> %s\n", r.Direction, r.TerraformType, r.AWSType)
+
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tPATH\tTERRAFORM\tAWS\tCONVERSION\tREASON")
+	for _, m := range r.Mappings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", m.Kind, m.Path, dashIfEmpty(m.TerraformField), dashIfEmpty(m.AWSField), dashIfEmpty(m.Conversion), dashIfEmpty(m.Reason))
+	}
+	w.Flush()
+
+	return sb.String()
+}
+
+// ExplainExpand reports how Expand maps the fields of tfObject to apiObject.
+// Only type information is used; the values of tfObject and apiObject are not read.
+func ExplainExpand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) (*FieldMappingReport, diag.Diagnostics) {
+	return explain(ctx, "expand", tfObject, apiObject, optFns)
+}
+
+// ExplainFlatten reports how Flatten maps the fields of apiObject to tfObject.
+// Only type information is used; the values of apiObject and tfObject are not read.
+func ExplainFlatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOptionsFunc) (*FieldMappingReport, diag.Diagnostics) {
+	return explain(ctx, "flatten", tfObject, apiObject, optFns)
+}
+
+func explain(ctx context.Context, direction string, tfObject, apiObject any, optFns []AutoFlexOptionsFunc) (*FieldMappingReport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfType, awsType := structType(reflect.TypeOf(tfObject)), structType(reflect.TypeOf(apiObject))
+	if tfType == nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("Terraform model type %T is not a struct", tfObject))
+	}
+	if awsType == nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("AWS API type %T is not a struct", apiObject))
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	opts := AutoFlexOptions{
+		ignoredFieldNames: DefaultIgnoredFieldNames,
+	}
+	for _, optFn := range optFns {
+		optFn(&opts)
+	}
+
+	e := &fieldMappingExplainer{
+		expand: direction == "expand",
+		opts:   opts,
+		report: &FieldMappingReport{
+			Direction:     direction,
+			TerraformType: explainTypeName(tfType),
+			AWSType:       explainTypeName(awsType),
+		},
+		seen: make(map[[2]reflect.Type]bool),
+	}
+	e.explainStruct(ctx, "", tfType, awsType)
+
+	return e.report, diags
+}
+
+// fieldMappingExplainer mirrors the field matching in expandStruct and flattenStruct.
+type fieldMappingExplainer struct {
+	expand bool
+	opts   AutoFlexOptions
+	report *FieldMappingReport
+	seen   map[[2]reflect.Type]bool
+}
+
+func (e *fieldMappingExplainer) add(m FieldMapping) {
+	e.report.Mappings = append(e.report.Mappings, m)
+}
+
+func (e *fieldMappingExplainer) explainStruct(ctx context.Context, prefix string, tfType, awsType reflect.Type) {
+	key := [2]reflect.Type{tfType, awsType}
+	if e.seen[key] {
+		return
+	}
+	e.seen[key] = true
+
+	typeFrom, typeTo := tfType, awsType
+	if !e.expand {
+		typeFrom, typeTo = awsType, tfType
+	}
+
+	matchedTo := make(map[string]bool)
+
+	for fromField := range tfreflect.ExportedStructFields(typeFrom) {
+		if fromField.Anonymous {
+			continue
+		}
+
+		fromFieldName := fromField.Name
+		path := joinFieldPath(prefix, fromFieldName)
+
+		if reason := e.sourceFieldSkipReason(fromField); reason != "" {
+			e.add(e.sideMapping(FieldMappingIgnored, path, fromField, !e.expand, string(reason)))
+			continue
+		}
+
+		finder := &fuzzyFieldFinder{}
+		toField, ok := finder.findField(ctx, fromFieldName, typeFrom, typeTo, e.opts)
+		if !ok {
+			kind := FieldMappingUnmatchedTerraform
+			if !e.expand {
+				kind = FieldMappingUnmatchedAWS
+			}
+			e.add(e.sideMapping(kind, path, fromField, !e.expand, ""))
+			continue
+		}
+		matchedTo[toField.Name] = true
+
+		tfField, awsField := fromField, toField
+		if !e.expand {
+			tfField, awsField = toField, fromField
+		}
+		path = joinFieldPath(prefix, tfField.Name)
+
+		if !e.expand {
+			if reason := flattenTargetFieldSkipReason(toField); reason != "" {
+				e.add(FieldMapping{
+					Kind:           FieldMappingIgnored,
+					Path:           path,
+					TerraformField: tfField.Name,
+					AWSField:       awsField.Name,
+					Reason:         string(reason),
+				})
+				continue
+			}
+		}
+
+		m := FieldMapping{
+			Kind:           FieldMappingMatched,
+			Path:           path,
+			TerraformField: tfField.Name,
+			AWSField:       awsField.Name,
+			Conversion:     explainTypeName(tfField.Type) + " <-> " + explainTypeName(awsField.Type),
+		}
+		if finder.matchedBy != "" {
+			m.Kind = FieldMappingNormalized
+			m.Reason = finder.matchedBy
+		}
+
+		tfNested, awsNested := nestedObjectType(ctx, tfField.Type), nestedStructType(awsField.Type)
+		if awsNested != nil && potentialXMLWrapperStruct(awsNested) {
+			if v := m.Reason; v != "" {
+				m.Reason = v + ", XML wrapper"
+			} else {
+				m.Reason = "XML wrapper"
+			}
+			if items, ok := awsNested.FieldByName(getXMLWrapperSliceFieldName(awsNested)); ok {
+				awsNested = nestedStructType(items.Type)
+			}
+		}
+		e.add(m)
+
+		if tfNested != nil && awsNested != nil {
+			e.explainStruct(ctx, path, tfNested, awsNested)
+		}
+	}
+
+	for toField := range tfreflect.ExportedStructFields(typeTo) {
+		if toField.Anonymous || matchedTo[toField.Name] {
+			continue
+		}
+
+		path := joinFieldPath(prefix, toField.Name)
+
+		if reason := e.targetFieldSkipReason(toField); reason != "" {
+			e.add(e.sideMapping(FieldMappingIgnored, path, toField, e.expand, string(reason)))
+			continue
+		}
+
+		kind := FieldMappingUnmatchedAWS
+		if !e.expand {
+			kind = FieldMappingUnmatchedTerraform
+		}
+		e.add(e.sideMapping(kind, path, toField, e.expand, ""))
+	}
+}
+
+// sourceFieldSkipReason returns why AutoFlex skips the source field, or "" if it doesn't.
+func (e *fieldMappingExplainer) sourceFieldSkipReason(field reflect.StructField) fieldSkipReason {
+	if e.expand {
+		if reason := expandSourceFieldSkipReason(field, e.opts); reason != "" {
+			return reason
+		}
+
+		return expandStructSourceFieldSkipReason(field)
+	}
+
+	if reason := flattenSourceFieldSkipReason(field, e.opts); reason != "" {
+		return reason
+	}
+
+	// AWS API output structs' middleware metadata is never flattened.
+	if field.Name == "ResultMetadata" {
+		return "response metadata"
+	}
+
+	return ""
+}
+
+// targetFieldSkipReason returns why AutoFlex never sets the unmatched target field, or "" if it would if matched.
+func (e *fieldMappingExplainer) targetFieldSkipReason(field reflect.StructField) fieldSkipReason {
+	// fuzzyFieldFinder only matches ignored target field names exactly.
+	if e.opts.isIgnoredField(field.Name) {
+		return fieldSkipReasonIgnoredFieldName
+	}
+
+	if e.expand {
+		return ""
+	}
+
+	// The map block key is set from the map key, see setMapBlockKey.
+	if field.Name == mapBlockKeyFieldName {
+		return fieldSkipReasonMapBlockKey
+	}
+
+	return flattenTargetFieldSkipReason(field)
+}
+
+func (e *fieldMappingExplainer) sideMapping(kind FieldMappingKind, path string, field reflect.StructField, isAWS bool, reason string) FieldMapping {
+	m := FieldMapping{
+		Kind:       kind,
+		Path:       path,
+		Conversion: explainTypeName(field.Type),
+		Reason:     reason,
+	}
+	if isAWS {
+		m.AWSField = field.Name
+	} else {
+		m.TerraformField = field.Name
+	}
+
+	return m
+}
+
+// nestedObjectType returns the Go struct type of a Terraform nested object value type, or nil.
+func nestedObjectType(ctx context.Context, t reflect.Type) reflect.Type {
+	v, ok := reflect.Zero(t).Interface().(fwtypes.NestedObjectValue)
+	if !ok {
+		return nil
+	}
+
+	typ, ok := v.Type(ctx).(fwtypes.NestedObjectType)
+	if !ok {
+		return nil
+	}
+
+	ptr, diags := typ.NewObjectPtr(ctx)
+	if diags.HasError() {
+		return nil
+	}
+
+	return structType(reflect.TypeOf(ptr))
+}
+
+// nestedStructType returns the struct type of an AWS API struct, pointer, slice or map value type, or nil.
+func nestedStructType(t reflect.Type) reflect.Type {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map:
+		t = t.Elem()
+	}
+
+	t = structType(t)
+	if t == reflect.TypeFor[time.Time]() {
+		return nil
+	}
+
+	return t
+}
+
+func structType(t reflect.Type) reflect.Type {
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return t
+}
+
+func joinFieldPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+// explainTypeName returns the type name with package paths removed,
+// e.g. "types.ListNestedObjectValueOf[flex.tfSingleStringField]".
+func explainTypeName(t reflect.Type) string {
+	return regexache.MustCompile(`[\w.-]+/`).ReplaceAllString(t.String(), "")
+}
+
+func dashIfEmpty(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex's field mapping explanations.
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type tfExplainModel struct {
+	Name          types.String                                          `tfsdk:"name"`
+	ClientID      types.String                                          `tfsdk:"client_id"`
+	Configuration fwtypes.ListNestedObjectValueOf[tfExplainNestedModel] `tfsdk:"configuration"`
+	Filter        fwtypes.ListOfString                                  `tfsdk:"filter"`
+	Internal      types.String                                          `tfsdk:"internal" autoflex:"-"`
+	Computed      types.String                                          `tfsdk:"computed" autoflex:",noexpand"`
+	Tags          types.Map                                             `tfsdk:"tags"`
+	Extra         types.Bool                                            `tfsdk:"extra"`
+}
+
+type tfExplainNestedModel struct {
+	Size types.Int64 `tfsdk:"size"`
+}
+
+type awsExplainInput struct {
+	Name          *string
+	ClientId      *string
+	Configuration *awsExplainNested
+	Filters       []string
+	Computed      *string
+	Tags          map[string]string
+	NewField      *int32
+}
+
+type awsExplainNested struct {
+	Size    *int32
+	Encrypt *bool
+}
+
+type awsExplainOutput struct {
+	Name           *string
+	ClientId       *string
+	Configuration  *awsExplainNested
+	Filters        []string
+	Computed       *string
+	Tags           map[string]string
+	ResultMetadata struct{}
+}
+
+func TestExplainExpand(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	report, diags := ExplainExpand(ctx, &tfExplainModel{}, &awsExplainInput{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := []FieldMapping{
+		{Kind: FieldMappingMatched, Path: "Name", TerraformField: "Name", AWSField: "Name", Conversion: "basetypes.StringValue <-> *string"},
+		{Kind: FieldMappingNormalized, Path: "ClientID", TerraformField: "ClientID", AWSField: "ClientId", Conversion: "basetypes.StringValue <-> *string", Reason: "case-insensitive"},
+		{Kind: FieldMappingMatched, Path: "Configuration", TerraformField: "Configuration", AWSField: "Configuration", Conversion: "types.ListNestedObjectValueOf[flex.tfExplainNestedModel] <-> *flex.awsExplainNested"},
+		{Kind: FieldMappingMatched, Path: "Configuration.Size", TerraformField: "Size", AWSField: "Size", Conversion: "basetypes.Int64Value <-> *int32"},
+		{Kind: FieldMappingUnmatchedAWS, Path: "Configuration.Encrypt", AWSField: "Encrypt", Conversion: "*bool"},
+		{Kind: FieldMappingNormalized, Path: "Filter", TerraformField: "Filter", AWSField: "Filters", Conversion: "types.ListValueOf[basetypes.StringValue] <-> []string", Reason: "plural"},
+		{Kind: FieldMappingIgnored, Path: "Internal", TerraformField: "Internal", Conversion: "basetypes.StringValue", Reason: `autoflex:"-"`},
+		{Kind: FieldMappingIgnored, Path: "Computed", TerraformField: "Computed", Conversion: "basetypes.StringValue", Reason: "noexpand"},
+		{Kind: FieldMappingIgnored, Path: "Tags", TerraformField: "Tags", Conversion: "basetypes.MapValue", Reason: "ignored field name"},
+		{Kind: FieldMappingUnmatchedTerraform, Path: "Extra", TerraformField: "Extra", Conversion: "basetypes.BoolValue"},
+		{Kind: FieldMappingUnmatchedAWS, Path: "Computed", AWSField: "Computed", Conversion: "*string"},
+		{Kind: FieldMappingIgnored, Path: "Tags", AWSField: "Tags", Conversion: "map[string]string", Reason: "ignored field name"},
+		{Kind: FieldMappingUnmatchedAWS, Path: "NewField", AWSField: "NewField", Conversion: "*int32"},
+	}
+
+	if diff := cmp.Diff(report.Mappings, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := len(report.UnmatchedAWSFields()), 3; got != want {
+		t.Errorf("UnmatchedAWSFields: got %d, want %d", got, want)
+	}
+	if got, want := len(report.Unmatched()), 4; got != want {
+		t.Errorf("Unmatched: got %d, want %d", got, want)
+	}
+}
+
+func TestExplainFlatten(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	report, diags := ExplainFlatten(ctx, &awsExplainOutput{}, &tfExplainModel{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got := make(map[string]FieldMappingKind)
+	for _, m := range report.Mappings {
+		got[string(m.Kind)+":"+m.Path] = m.Kind
+	}
+
+	for _, want := range []string{
+		"matched:Name",
+		"normalized:ClientID",
+		"matched:Configuration.Size",
+		"unmatched-aws:Configuration.Encrypt",
+		"normalized:Filter",
+		"matched:Computed",
+		"ignored:Tags",
+		"ignored:ResultMetadata",
+		"ignored:Internal",
+		"unmatched-terraform:Extra",
+	} {
+		if _, ok := got[want]; !ok {
+			t.Errorf("report does not contain %q:\n%s", want, report)
+		}
+	}
+}
+
+func TestExplainString(t *testing.T) {
+	t.Parallel()
+
+	report, diags := ExplainExpand(context.Background(), &tfExplainNestedModel{}, &awsExplainNested{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for _, want := range []string{
+		"AutoFlex expand: flex.tfExplainNestedModel <-> flex.awsExplainNested",
+		"KIND",
+		"unmatched-aws",
+		"Encrypt",
+	} {
+		if got := report.String(); !strings.Contains(got, want) {
+			t.Errorf("String() does not contain %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestExplainNotStruct(t *testing.T) {
+	t.Parallel()
+
+	_, diags := ExplainExpand(context.Background(), "", &awsExplainInput{})
+	if !diags.HasError() {
+		t.Error("expected error")
+	}
+}
+
+func TestCheckExpandFieldMappings(t *testing.T) {
+	t.Parallel()
+
+	CheckExpandFieldMappings(t, &tfExplainModel{}, &awsExplainInput{},
+		WithIgnoredFieldNamesAppend("Computed"),
+		WithIgnoredFieldNamesAppend("NewField"),
+		WithIgnoredFieldNamesAppend("Encrypt"),
+	)
+}
@@ -210,7 +210,7 @@ func flattenConvert(ctx context.Context, flattener *autoFlattener, sourcePath pa
 		return diags
 
 	case reflect.Interface:
-		diags.Append(flattenInterface(ctx, flattener, vFrom, tTo, vTo, fieldOpts)...)
+		diags.Append(flattenInterface(ctx, flattener, sourcePath, vFrom, targetPath, tTo, vTo, fieldOpts)...)
 		return diags
 	}
 
@@ -629,7 +629,7 @@ func flattenPointer(ctx context.Context, flattener *autoFlattener, sourcePath pa
 	return diags
 }
 
-func flattenInterface(ctx context.Context, flattener *autoFlattener, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
+func flattenInterface(ctx context.Context, flattener *autoFlattener, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo attr.Type, vTo reflect.Value, _ fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch tTo := tTo.(type) {
@@ -670,7 +670,7 @@ func flattenInterface(ctx context.Context, flattener *autoFlattener, vFrom refle
 		//
 		// interface -> types.List(OfObject) or types.Object.
 		//
-		diags.Append(flattenInterfaceToNestedObject(ctx, flattener, vFrom, tTo, vTo)...)
+		diags.Append(flattenInterfaceToNestedObject(ctx, flattener, sourcePath, vFrom, targetPath, tTo, vTo)...)
 		return diags
 	}
 
@@ -1356,7 +1356,7 @@ func flattenStructToNestedObject(ctx context.Context, flattener *autoFlattener,
 }
 
 // flattenInterfaceToNestedObject copies an AWS API interface value to a compatible Plugin Framework NestedObjectValue value.
-func flattenInterfaceToNestedObject(ctx context.Context, _ *autoFlattener, vFrom reflect.Value, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+func flattenInterfaceToNestedObject(ctx context.Context, flattener *autoFlattener, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if vFrom.IsNil() {
@@ -1378,6 +1378,22 @@ func flattenInterfaceToNestedObject(ctx context.Context, _ *autoFlattener, vFrom
 	}
 
 	toFlattener, ok := to.(Flattener)
+	if !ok && isSmithyUnionValue(vFrom) {
+		tflog.SubsystemInfo(ctx, subsystemName, "Source is a Smithy union")
+		diags.Append(flattenSmithyUnion(ctx, flattener, sourcePath, vFrom, targetPath, to)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		val, d := tTo.ValueFromObjectPtr(ctx, to)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
 	if !ok {
 		val, d := tTo.NullValue(ctx)
 		diags.Append(d...)
@@ -1689,7 +1705,11 @@ func flattenSliceOfStructToNestedObjectCollection(ctx context.Context, flattener
 			return diags
 		}
 
-		diags.Append(flattenStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
+		if _, ok := target.(Flattener); !ok && isSmithyUnionValue(vFrom.Index(i)) {
+			diags.Append(flattenSmithyUnion(ctx, flattener, sourcePath, vFrom.Index(i), targetPath, target)...)
+		} else {
+			diags.Append(flattenStruct(ctx, sourcePath, vFrom.Index(i).Interface(), targetPath, target, flattener)...)
+		}
 		if diags.HasError() {
 			return diags
 		}
@@ -2213,16 +2233,17 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			continue
 		}
 		toFieldName := toField.Name
-		toNameOverride, toFieldOpts := autoflexTags(toField)
+		_, toFieldOpts := autoflexTags(toField)
 		toFieldVal := valTo.FieldByIndex(toField.Index)
-		if toNameOverride == "-" {
+		switch flattenTargetFieldSkipReason(toField) {
+		case fieldSkipReasonIgnoredTag:
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored target field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 				logAttrKeyTargetFieldname: toFieldName,
 			})
 			continue
-		}
-		if toFieldOpts.NoFlatten() {
+
+		case fieldSkipReasonNoFlatten:
 			tflog.SubsystemTrace(ctx, subsystemName, "Skipping noflatten target field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 				logAttrKeyTargetFieldname: toFieldName,
@@ -2533,10 +2554,9 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOptions) iter.Seq[reflect.StructField] {
 	return func(yield func(reflect.StructField) bool) {
 		for field := range tfreflect.ExportedStructFields(typ) {
-			fieldName := field.Name
-			if opts.isIgnoredField(fieldName) {
+			if flattenSourceFieldSkipReason(field, opts) != "" {
 				tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored source field", map[string]any{
-					logAttrKeySourceFieldname: fieldName,
+					logAttrKeySourceFieldname: field.Name,
 				})
 				continue
 			}
@@ -2548,6 +2568,29 @@ func flattenSourceFields(ctx context.Context, typ reflect.Type, opts AutoFlexOpt
 	}
 }
 
+// flattenSourceFieldSkipReason returns why Flatten never reads the specified source field, or "" if it may.
+func flattenSourceFieldSkipReason(field reflect.StructField, opts AutoFlexOptions) fieldSkipReason {
+	if opts.isIgnoredField(field.Name) {
+		return fieldSkipReasonIgnoredFieldName
+	}
+
+	return ""
+}
+
+// flattenTargetFieldSkipReason returns why Flatten never sets the specified target field, or "" if it may.
+func flattenTargetFieldSkipReason(field reflect.StructField) fieldSkipReason {
+	nameOverride, fieldOpts := autoflexTags(field)
+	if nameOverride == "-" {
+		return fieldSkipReasonIgnoredTag
+	}
+
+	if fieldOpts.NoFlatten() {
+		return fieldSkipReasonNoFlatten
+	}
+
+	return ""
+}
+
 // setMapBlockKey takes a struct and assigns the value of the `key`
 func setMapBlockKey(ctx context.Context, to any, key reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
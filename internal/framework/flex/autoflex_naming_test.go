@@ -342,6 +342,23 @@ func TestFindFieldFuzzy_Combinations(t *testing.T) {
 	}
 }
 
+func TestFindFieldFuzzy_NoMatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	flexer := newAutoExpander([]AutoFlexOptionsFunc{
+		WithFieldNameSuffix("Input"),
+	})
+
+	finder := &fuzzyFieldFinder{}
+	if _, found := finder.findField(ctx, "ExecutionConfigInput", reflect.TypeFor[struct{ ExecutionConfigInput string }](), reflect.TypeFor[struct{ Other string }](), flexer.Options); found {
+		t.Fatalf("expected not to find field, but found==true")
+	}
+	if finder.matchedBy != "" {
+		t.Errorf("expected no match description, got %q", finder.matchedBy)
+	}
+}
+
 func TestExpandFieldNamePrefix(t *testing.T) {
 	t.Parallel()
 
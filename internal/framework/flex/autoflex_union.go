@@ -0,0 +1,214 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	tfreflect "github.com/hashicorp/terraform-provider-aws/internal/reflect"
+)
+
+// Smithy union (tagged union) types are generated by the AWS SDK for Go v2 as an interface, e.g.
+//
+//	type Configuration interface {
+//		isConfiguration()
+//	}
+//
+// with one struct type per union member, named for the union and the member and holding the member's value, e.g.
+//
+//	type ConfigurationMemberCognitoUserPoolConfiguration struct {
+//		Value CognitoUserPoolConfiguration
+//	}
+//
+// AutoFlex maps a union to a Terraform nested object with one field per union member,
+// named for the member, at most one of which is set.
+
+const (
+	unionMemberInfix          = "Member"
+	unionMemberValueFieldName = "Value"
+	unknownUnionMemberName    = "UnknownUnionMember"
+)
+
+// SmithyUnion is implemented by Terraform models of Smithy union types.
+// It is only needed for expanding; flattening determines the union member from the AWS API value.
+type SmithyUnion interface {
+	// UnionMembers returns a pointer to a zero value of each union member type,
+	// e.g. &awstypes.ConfigurationMemberCognitoUserPoolConfiguration{}.
+	// Members of several union types with the same members, e.g. Configuration and UpdateConfiguration, may be returned.
+	UnionMembers() []any
+}
+
+// expandSmithyUnion copies a Plugin Framework nested object value to a compatible AWS API union value.
+func expandSmithyUnion(ctx context.Context, expander *autoExpander, sourcePath path.Path, valFrom reflect.Value, fromUnion SmithyUnion, targetPath path.Path, valTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	unionType := valTo.Type()
+	var memberField *reflect.StructField
+
+	for field := range expandSourceFields(ctx, valFrom.Type(), expander.Options) {
+		v, ok := valFrom.FieldByIndex(field.Index).Interface().(attr.Value)
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		if memberField != nil {
+			tflog.SubsystemError(ctx, subsystemName, "Multiple union members set", map[string]any{
+				logAttrKeySourceFieldname: field.Name,
+			})
+			diags.Append(diagExpandingMultipleUnionMembers(valFrom.Type(), memberField.Name, field.Name))
+			return diags
+		}
+		memberField = &field
+	}
+
+	if memberField == nil {
+		tflog.SubsystemTrace(ctx, subsystemName, "No union member set")
+		return diags
+	}
+
+	for _, member := range fromUnion.UnionMembers() {
+		tMember := reflect.TypeOf(member)
+		if tMember.Kind() != reflect.Pointer || !tMember.Implements(unionType) {
+			continue
+		}
+
+		if name, ok := unionMemberName(unionType, tMember.Elem()); !ok || !strings.EqualFold(name, memberField.Name) {
+			continue
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeySourceFieldname: memberField.Name,
+			logAttrKeyTargetType:      fullTypeName(tMember),
+		})
+
+		to := reflect.New(tMember.Elem())
+		diags.Append(expandConvert(ctx, expander, sourcePath.AtName(memberField.Name), valFrom.FieldByIndex(memberField.Index), targetPath.AtName(unionMemberValueFieldName), to.Elem().FieldByName(unionMemberValueFieldName), fieldOpts{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		valTo.Set(to)
+		return diags
+	}
+
+	tflog.SubsystemError(ctx, subsystemName, "No corresponding union member", map[string]any{
+		logAttrKeySourceFieldname: memberField.Name,
+	})
+	diags.Append(diagExpandingNoUnionMember(valFrom.Type(), memberField.Name, unionType))
+
+	return diags
+}
+
+// flattenSmithyUnion copies an AWS API union value to the compatible field of a Plugin Framework nested object.
+// vFrom is a non-nil interface value and to is a pointer to the nested object's Go struct.
+func flattenSmithyUnion(ctx context.Context, flattener *autoFlattener, sourcePath path.Path, vFrom reflect.Value, targetPath path.Path, to any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	unionType := vFrom.Type()
+	vMember := vFrom.Elem()
+	if vMember.Kind() == reflect.Pointer {
+		vMember = vMember.Elem()
+	}
+
+	ctx = tflog.SubsystemSetField(ctx, subsystemName, logAttrKeySourceType, fullTypeName(vMember.Type()))
+
+	if vMember.Type().Name() == unknownUnionMemberName {
+		HandleFlattenUnknownUnionMember(ctx, vMember.FieldByName("Tag").String(), &diags)
+		return diags
+	}
+
+	name, ok := unionMemberName(unionType, vMember.Type())
+	if !ok {
+		tflog.SubsystemError(ctx, subsystemName, "Source is not a union member")
+		diags.Append(DiagFlatteningIncompatibleTypes(vMember.Type(), reflect.TypeOf(to)))
+		return diags
+	}
+
+	valTo := reflect.ValueOf(to).Elem()
+	for field := range tfreflect.ExportedStructFields(valTo.Type()) {
+		if !strings.EqualFold(field.Name, name) {
+			continue
+		}
+
+		if nameOverride, fieldOpts := autoflexTags(field); nameOverride == "-" || fieldOpts.NoFlatten() {
+			tflog.SubsystemTrace(ctx, subsystemName, "Skipping ignored union member", map[string]any{
+				logAttrKeyTargetFieldname: field.Name,
+			})
+			return diags
+		}
+
+		tflog.SubsystemTrace(ctx, subsystemName, "Matched union member", map[string]any{
+			logAttrKeyTargetFieldname: field.Name,
+		})
+
+		diags.Append(flattenConvert(ctx, flattener, sourcePath.AtName(unionMemberValueFieldName), vMember.FieldByName(unionMemberValueFieldName), targetPath.AtName(field.Name), valTo.FieldByIndex(field.Index), fieldOpts{})...)
+		return diags
+	}
+
+	tflog.SubsystemDebug(ctx, subsystemName, "No corresponding union member field", map[string]any{
+		logAttrKeySourceFieldname: name,
+	})
+
+	return diags
+}
+
+// isSmithyUnionValue returns whether v is an AWS API union value.
+func isSmithyUnionValue(v reflect.Value) bool {
+	if v.Kind() != reflect.Interface || v.IsNil() {
+		return false
+	}
+
+	vMember := v.Elem()
+	if vMember.Kind() == reflect.Pointer {
+		vMember = vMember.Elem()
+	}
+	if vMember.Kind() != reflect.Struct {
+		return false
+	}
+
+	if vMember.Type().Name() == unknownUnionMemberName {
+		return true
+	}
+
+	_, ok := unionMemberName(v.Type(), vMember.Type())
+	return ok && vMember.FieldByName(unionMemberValueFieldName).IsValid()
+}
+
+// unionMemberName returns the member name of a union member type,
+// e.g. "CognitoUserPoolConfiguration" for ConfigurationMemberCognitoUserPoolConfiguration.
+func unionMemberName(unionType, memberType reflect.Type) (string, bool) {
+	name, ok := strings.CutPrefix(memberType.Name(), unionType.Name()+unionMemberInfix)
+	if !ok || name == "" {
+		return "", false
+	}
+
+	return name, true
+}
+
+func diagExpandingMultipleUnionMembers(sourceType reflect.Type, fieldName1, fieldName2 string) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q has more than one union member set (%q and %q).", fullTypeName(sourceType), fieldName1, fieldName2),
+	)
+}
+
+func diagExpandingNoUnionMember(sourceType reflect.Type, fieldName string, unionType reflect.Type) diag.ErrorDiagnostic {
+	return diag.NewErrorDiagnostic(
+		"Incompatible Types",
+		"An unexpected error occurred while expanding configuration. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source type %q field %q has no corresponding member of union %q.", fullTypeName(sourceType), fieldName, fullTypeName(unionType)),
+	)
+}
@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+// Tests AutoFlex's handling of Smithy union types.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type awsUnion interface {
+	isAWSUnion()
+}
+
+type awsUnionMemberNested struct {
+	Value awsUnionNested
+}
+
+func (*awsUnionMemberNested) isAWSUnion() {}
+
+type awsUnionMemberName struct {
+	Value string
+}
+
+func (*awsUnionMemberName) isAWSUnion() {}
+
+type UnknownUnionMember struct {
+	Tag   string
+	Value []byte
+}
+
+func (*UnknownUnionMember) isAWSUnion() {}
+
+type awsUnionNested struct {
+	Size *int32
+}
+
+type awsUnionField struct {
+	Config awsUnion
+}
+
+type awsUnionSlice struct {
+	Configs []awsUnion
+}
+
+type tfUnionModel struct {
+	Nested fwtypes.ListNestedObjectValueOf[tfUnionNestedModel] `tfsdk:"nested"`
+	Name   types.String                                        `tfsdk:"name"`
+}
+
+func (tfUnionModel) UnionMembers() []any {
+	return []any{
+		&awsUnionMemberNested{},
+		&awsUnionMemberName{},
+	}
+}
+
+type tfUnionNestedModel struct {
+	Size types.Int64 `tfsdk:"size"`
+}
+
+type tfUnionField struct {
+	Config fwtypes.ListNestedObjectValueOf[tfUnionModel] `tfsdk:"config"`
+}
+
+type tfUnionSlice struct {
+	Configs fwtypes.ListNestedObjectValueOf[tfUnionModel] `tfsdk:"configs"`
+}
+
+func TestExpandUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nested member": {
+			Source: &tfUnionField{
+				Config: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionModel{
+					Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionNestedModel{
+						Size: types.Int64Value(42),
+					}),
+					Name: types.StringNull(),
+				}),
+			},
+			Target: &awsUnionField{},
+			WantTarget: &awsUnionField{
+				Config: &awsUnionMemberNested{
+					Value: awsUnionNested{Size: aws.Int32(42)},
+				},
+			},
+		},
+		"string member": {
+			Source: &tfUnionField{
+				Config: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionModel{
+					Nested: fwtypes.NewListNestedObjectValueOfNull[tfUnionNestedModel](ctx),
+					Name:   types.StringValue("a"),
+				}),
+			},
+			Target: &awsUnionField{},
+			WantTarget: &awsUnionField{
+				Config: &awsUnionMemberName{Value: "a"},
+			},
+		},
+		"no member": {
+			Source: &tfUnionField{
+				Config: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionModel{
+					Nested: fwtypes.NewListNestedObjectValueOfNull[tfUnionNestedModel](ctx),
+					Name:   types.StringNull(),
+				}),
+			},
+			Target:     &awsUnionField{},
+			WantTarget: &awsUnionField{},
+		},
+		"multiple members": {
+			Source: &tfUnionField{
+				Config: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionModel{
+					Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionNestedModel{
+						Size: types.Int64Value(42),
+					}),
+					Name: types.StringValue("a"),
+				}),
+			},
+			Target: &awsUnionField{},
+			ExpectedDiags: diag.Diagnostics{
+				diagExpandingMultipleUnionMembers(reflect.TypeFor[tfUnionModel](), "Nested", "Name"),
+			},
+		},
+		"slice": {
+			Source: &tfUnionSlice{
+				Configs: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnionModel{
+					{
+						Nested: fwtypes.NewListNestedObjectValueOfNull[tfUnionNestedModel](ctx),
+						Name:   types.StringValue("a"),
+					},
+					{
+						Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionNestedModel{
+							Size: types.Int64Value(42),
+						}),
+						Name: types.StringNull(),
+					},
+				}),
+			},
+			Target: &awsUnionSlice{},
+			WantTarget: &awsUnionSlice{
+				Configs: []awsUnion{
+					&awsUnionMemberName{Value: "a"},
+					&awsUnionMemberNested{
+						Value: awsUnionNested{Size: aws.Int32(42)},
+					},
+				},
+			},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases, runChecks{})
+}
+
+func TestFlattenUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := autoFlexTestCases{
+		"nested member": {
+			Source: &awsUnionField{
+				Config: &awsUnionMemberNested{
+					Value: awsUnionNested{Size: aws.Int32(42)},
+				},
+			},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Config: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionModel{
+					Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionNestedModel{
+						Size: types.Int64Value(42),
+					}),
+					Name: types.StringNull(),
+				}),
+			},
+		},
+		"string member": {
+			Source: &awsUnionField{
+				Config: &awsUnionMemberName{Value: "a"},
+			},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Config: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionModel{
+					Nested: fwtypes.NewListNestedObjectValueOfNull[tfUnionNestedModel](ctx),
+					Name:   types.StringValue("a"),
+				}),
+			},
+		},
+		"nil": {
+			Source: &awsUnionField{},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Config: fwtypes.NewListNestedObjectValueOfNull[tfUnionModel](ctx),
+			},
+		},
+		"unknown member": {
+			Source: &awsUnionField{
+				Config: &UnknownUnionMember{Tag: "NewMember"},
+			},
+			Target: &tfUnionField{},
+			WantTarget: &tfUnionField{
+				Config: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionModel{
+					Nested: fwtypes.NewListNestedObjectValueOfNull[tfUnionNestedModel](ctx),
+					Name:   types.StringNull(),
+				}),
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diagFlatteningUnknownUnionMember("NewMember"),
+			},
+		},
+		"slice": {
+			Source: &awsUnionSlice{
+				Configs: []awsUnion{
+					&awsUnionMemberName{Value: "a"},
+					&awsUnionMemberNested{
+						Value: awsUnionNested{Size: aws.Int32(42)},
+					},
+				},
+			},
+			Target: &tfUnionSlice{},
+			WantTarget: &tfUnionSlice{
+				Configs: fwtypes.NewListNestedObjectValueOfSliceMust(ctx, []*tfUnionModel{
+					{
+						Nested: fwtypes.NewListNestedObjectValueOfNull[tfUnionNestedModel](ctx),
+						Name:   types.StringValue("a"),
+					},
+					{
+						Nested: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfUnionNestedModel{
+							Size: types.Int64Value(42),
+						}),
+						Name: types.StringNull(),
+					},
+				}),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases, runChecks{})
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// CheckExpandFieldMappings fails the test if Expand would not set every field of apiObject from tfObject.
+// Fields that are deliberately not set should be listed using AutoFlex options, e.g. WithIgnoredFieldNamesAppend.
+func CheckExpandFieldMappings(t testing.TB, tfObject, apiObject any, optFns ...AutoFlexOptionsFunc) {
+	t.Helper()
+
+	report, diags := ExplainExpand(context.Background(), tfObject, apiObject, optFns...)
+	checkFieldMappings(t, report, diags)
+}
+
+// CheckFlattenFieldMappings fails the test if Flatten would not read every field of apiObject into tfObject.
+// Fields that are deliberately not read should be listed using AutoFlex options, e.g. WithIgnoredFieldNamesAppend.
+func CheckFlattenFieldMappings(t testing.TB, apiObject, tfObject any, optFns ...AutoFlexOptionsFunc) {
+	t.Helper()
+
+	report, diags := ExplainFlatten(context.Background(), apiObject, tfObject, optFns...)
+	checkFieldMappings(t, report, diags)
+}
+
+func checkFieldMappings(t testing.TB, report *FieldMappingReport, diags diag.Diagnostics) {
+	t.Helper()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if unmatched := report.UnmatchedAWSFields(); len(unmatched) > 0 {
+		for _, m := range unmatched {
+			t.Errorf("AWS API field %s (%s) has no Terraform counterpart", m.Path, m.Conversion)
+		}
+		t.Logf("%s", report)
+	}
+}
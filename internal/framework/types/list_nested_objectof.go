@@ -35,7 +35,7 @@ func NewListNestedObjectTypeOf[T any](ctx context.Context, f ...NestedObjectOfOp
 	opts := newNestedObjectOfOptions(f...)
 
 	return listNestedObjectTypeOf[T]{
-		ListType:             basetypes.ListType{ElemType: NewObjectTypeOf[T](ctx)},
+		ListType:             basetypes.ListType{ElemType: NewObjectTypeOf(ctx, f...)},
 		semanticEqualityFunc: opts.SemanticEqualityFunc,
 	}
 }
@@ -64,13 +64,8 @@ func (t listNestedObjectTypeOf[T]) ValueFromList(ctx context.Context, in basetyp
 		return NewListNestedObjectValueOfUnknown[T](ctx), diags
 	}
 
-	typ, d := newObjectTypeOf[T](ctx)
-	diags.Append(d...)
-	if diags.HasError() {
-		return NewListNestedObjectValueOfUnknown[T](ctx), diags
-	}
-
-	v, d := basetypes.NewListValue(typ, in.Elements())
+	// Use this type's element type, which may have overridden attribute types.
+	v, d := basetypes.NewListValue(t.ElemType, in.Elements())
 	diags.Append(d...)
 	if diags.HasError() {
 		return NewListNestedObjectValueOfUnknown[T](ctx), diags
@@ -191,6 +186,14 @@ func (v ListNestedObjectValueOf[T]) ListSemanticEquals(ctx context.Context, newV
 }
 
 func (v ListNestedObjectValueOf[T]) Type(ctx context.Context) attr.Type {
+	// Keep the element type, which may have overridden attribute types, of the value.
+	if elemType := v.ElementType(ctx); elemType != nil {
+		return listNestedObjectTypeOf[T]{
+			ListType:             basetypes.ListType{ElemType: elemType},
+			semanticEqualityFunc: v.semanticEqualityFunc,
+		}
+	}
+
 	return NewListNestedObjectTypeOf[T](ctx)
 }
 
@@ -3,9 +3,14 @@
 
 package types
 
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+)
+
 type NestedObjectOfOptionsFunc[T any] func(*nestedObjectOfOptions[T])
 
 type nestedObjectOfOptions[T any] struct {
+	AttributeTypes       map[string]attr.Type
 	SemanticEqualityFunc semanticEqualityFunc[T]
 }
 
@@ -15,6 +20,18 @@ func WithSemanticEqualityFunc[T any](f semanticEqualityFunc[T]) NestedObjectOfOp
 	}
 }
 
+// WithAttributeType overrides the attribute type derived from the field of T with the specified `tfsdk` tag.
+// This is required for attribute types that carry state not present in the zero value of the field's type,
+// e.g. the Smithy document factory function of a SmithyJSONType.
+func WithAttributeType[T any](name string, attrType attr.Type) NestedObjectOfOptionsFunc[T] {
+	return func(o *nestedObjectOfOptions[T]) {
+		if o.AttributeTypes == nil {
+			o.AttributeTypes = make(map[string]attr.Type)
+		}
+		o.AttributeTypes[name] = attrType
+	}
+}
+
 func newNestedObjectOfOptions[T any](optFns ...NestedObjectOfOptionsFunc[T]) nestedObjectOfOptions[T] {
 	var opts nestedObjectOfOptions[T]
 	for _, fn := range optFns {
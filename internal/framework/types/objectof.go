@@ -6,6 +6,7 @@ package types
 import (
 	"context"
 	"fmt"
+	"maps"
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -29,8 +30,9 @@ type objectTypeOf[T any] struct {
 	basetypes.ObjectType
 }
 
-func newObjectTypeOf[T any](ctx context.Context) (objectTypeOf[T], diag.Diagnostics) {
+func newObjectTypeOf[T any](ctx context.Context, f ...NestedObjectOfOptionsFunc[T]) (objectTypeOf[T], diag.Diagnostics) {
 	var diags diag.Diagnostics
+	opts := newNestedObjectOfOptions(f...)
 
 	m, d := AttributeTypes[T](ctx)
 	diags.Append(d...)
@@ -38,11 +40,13 @@ func newObjectTypeOf[T any](ctx context.Context) (objectTypeOf[T], diag.Diagnost
 		return objectTypeOf[T]{}, diags
 	}
 
+	maps.Copy(m, opts.AttributeTypes)
+
 	return objectTypeOf[T]{basetypes.ObjectType{AttrTypes: m}}, diags
 }
 
-func NewObjectTypeOf[T any](ctx context.Context) objectTypeOf[T] {
-	return fwdiag.Must(newObjectTypeOf[T](ctx))
+func NewObjectTypeOf[T any](ctx context.Context, f ...NestedObjectOfOptionsFunc[T]) objectTypeOf[T] {
+	return fwdiag.Must(newObjectTypeOf(ctx, f...))
 }
 
 func (t objectTypeOf[T]) Equal(o attr.Type) bool {
@@ -68,13 +72,8 @@ func (t objectTypeOf[T]) ValueFromObject(ctx context.Context, in basetypes.Objec
 		return NewObjectValueOfUnknown[T](ctx), diags
 	}
 
-	m, d := AttributeTypes[T](ctx)
-	diags.Append(d...)
-	if diags.HasError() {
-		return NewObjectValueOfUnknown[T](ctx), diags
-	}
-
-	v, d := basetypes.NewObjectValue(m, in.Attributes())
+	// Use this type's attribute types, which may have been overridden, so that values converted to T keep them.
+	v, d := basetypes.NewObjectValue(t.AttrTypes, in.Attributes())
 	diags.Append(d...)
 	if diags.HasError() {
 		return NewObjectValueOfUnknown[T](ctx), diags
@@ -199,6 +198,11 @@ func (v ObjectValueOf[T]) Equal(o attr.Value) bool {
 }
 
 func (v ObjectValueOf[T]) Type(ctx context.Context) attr.Type {
+	// Keep any attribute types overridden when the value was created.
+	if m := v.AttributeTypes(ctx); len(m) > 0 {
+		return objectTypeOf[T]{basetypes.ObjectType{AttrTypes: m}}
+	}
+
 	return NewObjectTypeOf[T](ctx)
 }
 
@@ -32,7 +32,7 @@ func NewSetNestedObjectTypeOf[T any](ctx context.Context, options ...NestedObjec
 	opts := newNestedObjectOfOptions(options...)
 
 	return setNestedObjectTypeOf[T]{
-		SetType:              basetypes.SetType{ElemType: NewObjectTypeOf[T](ctx)},
+		SetType:              basetypes.SetType{ElemType: NewObjectTypeOf(ctx, options...)},
 		semanticEqualityFunc: opts.SemanticEqualityFunc,
 	}
 }
@@ -62,13 +62,8 @@ func (t setNestedObjectTypeOf[T]) ValueFromSet(ctx context.Context, in basetypes
 		return NewSetNestedObjectValueOfUnknown[T](ctx), diags
 	}
 
-	typ, d := newObjectTypeOf[T](ctx)
-	diags.Append(d...)
-	if diags.HasError() {
-		return NewSetNestedObjectValueOfUnknown[T](ctx), diags
-	}
-
-	v, d := basetypes.NewSetValue(typ, in.Elements())
+	// Use this type's element type, which may have overridden attribute types.
+	v, d := basetypes.NewSetValue(t.ElemType, in.Elements())
 	diags.Append(d...)
 	if diags.HasError() {
 		return NewSetNestedObjectValueOfUnknown[T](ctx), diags
@@ -183,6 +178,14 @@ func (v SetNestedObjectValueOf[T]) SetSemanticEquals(ctx context.Context, newVal
 }
 
 func (v SetNestedObjectValueOf[T]) Type(ctx context.Context) attr.Type {
+	// Keep the element type, which may have overridden attribute types, of the value.
+	if elemType := v.ElementType(ctx); elemType != nil {
+		return setNestedObjectTypeOf[T]{
+			SetType:              basetypes.SetType{ElemType: elemType},
+			semanticEqualityFunc: v.semanticEqualityFunc,
+		}
+	}
+
 	return NewSetNestedObjectTypeOf[T](ctx)
 }
 
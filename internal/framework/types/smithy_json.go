@@ -113,7 +113,16 @@ func (v SmithyJSON[T]) ToSmithyObjectDocument(ctx context.Context) (any, diag.Di
 func (v SmithyJSON[T]) ToSmithyDocument(context.Context) (T, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	if v.IsNull() || v.IsUnknown() || v.f == nil {
+	if v.IsNull() || v.IsUnknown() {
+		return inttypes.Zero[T](), diags
+	}
+
+	if v.f == nil {
+		diags.AddError(
+			"Smithy Document Conversion Error",
+			"A Smithy document value has no document factory, so it cannot be converted to a Smithy document. "+
+				"Please report this to the provider developers.",
+		)
 		return inttypes.Zero[T](), diags
 	}
 
@@ -146,6 +146,10 @@ func TestSmithyJSONValueInterface(t *testing.T) {
 			val:         fwtypes.NewSmithyJSONValue("not ok", newTestJSONDocument), // lintignore:AWSAT003,AWSAT005
 			expectError: true,
 		},
+		"no document factory": {
+			val:         fwtypes.NewSmithyJSONValue[tfsmithy.JSONStringer](`{"test": "value"}`, nil), // lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -169,3 +173,54 @@ func TestSmithyJSONValueInterface(t *testing.T) {
 		})
 	}
 }
+
+type smithyJSONNestedObject struct {
+	Document fwtypes.SmithyJSON[tfsmithy.JSONStringer] `tfsdk:"document"`
+}
+
+func TestSmithyJSONValueNestedObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	// The Smithy document factory function is carried by the nested object's attribute type.
+	typ := fwtypes.NewListNestedObjectTypeOf(ctx, fwtypes.WithAttributeType[smithyJSONNestedObject]("document", fwtypes.NewSmithyJSONType(ctx, newTestJSONDocument)))
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"document": tftypes.String,
+		},
+	}
+	in := tftypes.NewValue(tftypes.List{ElementType: objectType}, []tftypes.Value{
+		tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"document": tftypes.NewValue(tftypes.String, `{"test": "value"}`), // lintignore:AWSAT003,AWSAT005
+		}),
+	})
+	val, err := typ.ValueFromTerraform(ctx, in)
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	// The value's type keeps the overridden attribute type.
+	val, err = val.Type(ctx).ValueFromTerraform(ctx, in)
+	if err != nil {
+		t.Fatalf("got unexpected error: %s", err)
+	}
+
+	ptr, diags := val.(fwtypes.ListNestedObjectValueOf[smithyJSONNestedObject]).ToPtr(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got, diags := ptr.Document.ToSmithyDocument(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := &testJSONDocument{
+		Value: map[string]any{
+			"test": "value",
+		},
+	}
+	if diff := cmp.Diff(got, tfsmithy.JSONStringer(want)); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
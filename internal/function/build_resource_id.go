@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = buildResourceIDFunction{}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &buildResourceIDFunction{}
+}
+
+type buildResourceIDFunction struct{}
+
+func (f buildResourceIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_resource_id"
+}
+
+func (f buildResourceIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "build_resource_id Function",
+		MarkdownDescription: "Builds an ARN from its constituent parts and service-specific resource components",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "partition",
+				MarkdownDescription: "Partition in which the resource is located",
+			},
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service namespace",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+			function.StringParameter{
+				Name:                "account_id",
+				MarkdownDescription: "AWS account identifier",
+			},
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "Service-specific resource type",
+			},
+			function.MapParameter{
+				Name:                "components",
+				ElementType:         types.StringType,
+				MarkdownDescription: "Service-specific resource components",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f buildResourceIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id resourceID
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id.partition, &id.service, &id.region, &id.accountID, &id.resourceType, &id.components))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := buildResourceID(id)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
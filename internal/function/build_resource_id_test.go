@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestBuildResourceIDFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIDFunctionConfig("lambda", "us-west-2", "function", `{ function_name = "example", qualifier = "live" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:lambda:us-west-2:444455556666:function:example:live"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildResourceIDFunction_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testBuildResourceIDFunctionConfig_roundTrip("arn:aws:logs:us-west-2:444455556666:log-group:/aws/lambda/example:log-stream:example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws:logs:us-west-2:444455556666:log-group:/aws/lambda/example:log-stream:example"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildResourceIDFunction_missingComponent(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testBuildResourceIDFunctionConfig("lambda", "us-west-2", "function", `{ qualifier = "live" }`),
+				ExpectError: regexache.MustCompile(`missing required components for lambda resource type "function":\s+function_name`),
+			},
+		},
+	})
+}
+
+func testBuildResourceIDFunctionConfig(service, region, resourceType, components string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::build_resource_id("aws", %[1]q, %[2]q, "444455556666", %[3]q, %[4]s)
+}
+`, service, region, resourceType, components)
+}
+
+func testBuildResourceIDFunctionConfig_roundTrip(arn string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::parse_resource_id(%[1]q)
+}
+
+output "test" {
+  value = provider::aws::build_resource_id(local.test.partition, local.test.service, local.test.region, local.test.account_id, local.test.resource_type, local.test.components)
+}
+`, arn)
+}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+// documentToJSON returns a JSON or YAML document as compact JSON.
+// Documents are validated as the provider validates JSON or YAML templates, see verify.NormalizeJSONOrYAMLString.
+// JSON documents are not decoded, so numbers keep their original precision.
+func documentToJSON(s string) (string, error) {
+	if s = strings.ReplaceAll(s, "\r\n", "\n"); looksLikeJSONDocument(s) {
+		if _, err := verify.NormalizeJSONOrYAMLString(s); err != nil {
+			return "", fmt.Errorf("decoding JSON: %w", err)
+		}
+
+		v, err := tfjson.CompactString(s)
+		if err != nil {
+			return "", fmt.Errorf("decoding JSON: %w", err)
+		}
+
+		return v, nil
+	}
+
+	s, err := verify.NormalizeJSONOrYAMLString(s)
+	if err != nil {
+		return "", fmt.Errorf("decoding YAML: %w", err)
+	}
+
+	var v any
+	if err := tfyaml.DecodeFromString(s, &v); err != nil {
+		return "", fmt.Errorf("decoding YAML: %w", err)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("decoding YAML: %w", err)
+	}
+
+	return string(b), nil
+}
+
+func looksLikeJSONDocument(s string) bool {
+	s = strings.TrimSpace(s)
+	return strings.HasPrefix(s, "{") || strings.HasPrefix(s, "[")
+}
+
+// normalizeDocumentJSON returns a JSON document in compact form with object keys sorted.
+// Numbers are not converted, so they keep their original precision.
+func normalizeDocumentJSON(s string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+var _ function.Function = documentEqualFunction{}
+
+func NewDocumentEqualFunction() function.Function {
+	return &documentEqualFunction{}
+}
+
+type documentEqualFunction struct{}
+
+func (f documentEqualFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "document_equal"
+}
+
+func (f documentEqualFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "document_equal Function",
+		MarkdownDescription: "Compares two JSON or YAML documents for semantic equality, ignoring formatting and object key order",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document1",
+				MarkdownDescription: "JSON or YAML document",
+			},
+			function.StringParameter{
+				Name:                "document2",
+				MarkdownDescription: "JSON or YAML document",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f documentEqualFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document1, document2 string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document1, &document2))
+	if resp.Error != nil {
+		return
+	}
+
+	v1, err := documentToJSON(document1)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	v2, err := documentToJSON(document2)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	// Documents are equal if the provider suppresses the difference between them as templates,
+	// or if they have the same JSON value, which also holds for documents of different formats.
+	equal := verify.SuppressEquivalentJSONOrYAMLDiffs("", document1, document2, nil) || tfjson.EqualStrings(v1, v2)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, equal))
+}
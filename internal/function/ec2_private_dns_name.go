@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+)
+
+var _ function.Function = ec2PrivateDNSNameFunction{}
+
+func NewEC2PrivateDNSNameFunction() function.Function {
+	return &ec2PrivateDNSNameFunction{}
+}
+
+type ec2PrivateDNSNameFunction struct{}
+
+func (f ec2PrivateDNSNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ec2_private_dns_name"
+}
+
+func (f ec2PrivateDNSNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "ec2_private_dns_name Function",
+		MarkdownDescription: "Returns the IP-based private DNS name of an EC2 instance with a private IPv4 address in a Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip",
+				MarkdownDescription: "Private IPv4 address",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ec2PrivateDNSNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ip, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if addr, err := netip.ParseAddr(ip); err != nil || !addr.Is4() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("%q is not a valid IPv4 address", ip)))
+		return
+	}
+
+	if region == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "region must not be empty"))
+		return
+	}
+
+	result := dns.EC2PrivateDNSNameForIP(region, ip)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
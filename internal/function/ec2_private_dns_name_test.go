@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEC2PrivateDNSNameFunction_usEast1(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2PrivateDNSNameFunctionConfig("10.0.1.23", "us-east-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ip-10-0-1-23.ec2.internal"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2PrivateDNSNameFunction_otherRegion(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEC2PrivateDNSNameFunctionConfig("10.0.1.23", "eu-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "ip-10-0-1-23.eu-west-1.compute.internal"),
+				),
+			},
+		},
+	})
+}
+
+func TestEC2PrivateDNSNameFunction_invalidIP(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEC2PrivateDNSNameFunctionConfig("2001:db8::1", "us-west-2"),
+				ExpectError: regexache.MustCompile(`"2001:db8::1" is not a valid IPv4 address`),
+			},
+		},
+	})
+}
+
+func testEC2PrivateDNSNameFunctionConfig(ip, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::ec2_private_dns_name(%[1]q, %[2]q)
+}
+`, ip, region)
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+)
+
+var _ function.Function = elbHostedZoneIDFunction{}
+
+func NewELBHostedZoneIDFunction() function.Function {
+	return &elbHostedZoneIDFunction{}
+}
+
+type elbHostedZoneIDFunction struct{}
+
+func (f elbHostedZoneIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "elb_hosted_zone_id"
+}
+
+func (f elbHostedZoneIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "elb_hosted_zone_id Function",
+		MarkdownDescription: "Returns the Route 53 hosted zone ID of Elastic Load Balancing (Classic and Application Load Balancers) in a Region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f elbHostedZoneIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	result, ok := dns.ELBHostedZoneIDPerRegionMap[region]
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("unsupported ELB Region (%s)", region)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
+)
+
+var _ function.Function = eventPatternMatchFunction{}
+
+func NewEventPatternMatchFunction() function.Function {
+	return &eventPatternMatchFunction{}
+}
+
+type eventPatternMatchFunction struct{}
+
+func (f eventPatternMatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "event_pattern_match"
+}
+
+func (f eventPatternMatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "event_pattern_match Function",
+		MarkdownDescription: "Tests whether an event matches an EventBridge event pattern",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "EventBridge event pattern",
+			},
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "Event, as JSON",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f eventPatternMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, event string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &event))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := eventpattern.Validate(pattern); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := eventpattern.Match(pattern, event)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEventPatternMatchFunction_match(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchFunctionConfig(`{"source": ["aws.ec2"], "detail": {"state": [{"prefix": "run"}]}}`, `{"source": "aws.ec2", "detail": {"state": "running"}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchFunctionConfig(`{"source": ["aws.ec2"], "detail": {"state": [{"prefix": "run"}]}}`, `{"source": "aws.ec2", "detail": {"state": "stopped"}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEventPatternMatchFunctionConfig(`{"source": "aws.ec2"}`, `{"source": "aws.ec2"}`),
+				ExpectError: regexache.MustCompile(`/source: must be an object or an array`),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchFunction_invalidEvent(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEventPatternMatchFunctionConfig(`{"source": ["aws.ec2"]}`, `["aws.ec2"]`),
+				ExpectError: regexache.MustCompile(`event must be a JSON object`),
+			},
+		},
+	})
+}
+
+func testEventPatternMatchFunctionConfig(pattern, event string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = tostring(provider::aws::event_pattern_match(%[1]q, %[2]q))
+}
+`, pattern, event)
+}
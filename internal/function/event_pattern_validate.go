@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
+)
+
+var _ function.Function = eventPatternValidateFunction{}
+
+func NewEventPatternValidateFunction() function.Function {
+	return &eventPatternValidateFunction{}
+}
+
+type eventPatternValidateFunction struct{}
+
+func (f eventPatternValidateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "event_pattern_validate"
+}
+
+func (f eventPatternValidateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "event_pattern_validate Function",
+		MarkdownDescription: "Validates an EventBridge event pattern, returning the pattern in normalized form",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "EventBridge event pattern",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f eventPatternValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := eventpattern.Validate(pattern); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := eventpattern.Normalize(pattern)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEventPatternValidateFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternValidateFunctionConfig(`{
+  "source": ["aws.ec2"],
+  "detail": {"state": [{"anything-but": "stopped"}]}
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"detail":{"state":[{"anything-but":"stopped"}]},"source":["aws.ec2"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternValidateFunction_unsupportedOperator(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEventPatternValidateFunctionConfig(`{"source": [{"contains": "ec2"}]}`),
+				ExpectError: regexache.MustCompile(`/source/0/contains: unsupported operator`),
+			},
+		},
+	})
+}
+
+func testEventPatternValidateFunctionConfig(pattern string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::event_pattern_validate(%[1]q)
+}
+`, pattern)
+}
@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+var _ function.Function = jsonMergePatchFunction{}
+
+func NewJSONMergePatchFunction() function.Function {
+	return &jsonMergePatchFunction{}
+}
+
+type jsonMergePatchFunction struct{}
+
+func (f jsonMergePatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_merge_patch"
+}
+
+func (f jsonMergePatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "json_merge_patch Function",
+		MarkdownDescription: "Applies an RFC 7386 JSON Merge Patch to a JSON or YAML document, returning compact JSON",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "JSON or YAML document",
+			},
+			function.StringParameter{
+				Name:                "patch",
+				MarkdownDescription: "JSON Merge Patch",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f jsonMergePatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document, patch string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document, &patch))
+	if resp.Error != nil {
+		return
+	}
+
+	v, err := documentToJSON(document)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	p, err := documentToJSON(patch)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	v, err = tfjson.MergePatchToString(v, p)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := normalizeDocumentJSON(v)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestJSONMergePatchFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testJSONMergePatchFunctionConfig(`{"a": "b", "c": {"d": "e", "f": "g"}}`, `{"a": "z", "c": {"f": null}}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"a":"z","c":{"d":"e"}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONMergePatchFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testJSONMergePatchFunctionConfig(`{}`, `{"a"}`),
+				ExpectError: regexache.MustCompile(`decoding JSON`),
+			},
+		},
+	})
+}
+
+func testJSONMergePatchFunctionConfig(document, patch string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::json_merge_patch(%[1]q, %[2]q)
+}
+`, document, patch)
+}
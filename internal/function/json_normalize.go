@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = jsonNormalizeFunction{}
+
+func NewJSONNormalizeFunction() function.Function {
+	return &jsonNormalizeFunction{}
+}
+
+type jsonNormalizeFunction struct{}
+
+func (f jsonNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_normalize"
+}
+
+func (f jsonNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "json_normalize Function",
+		MarkdownDescription: "Normalizes a JSON or YAML document to compact JSON with object keys sorted",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "JSON or YAML document",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f jsonNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document))
+	if resp.Error != nil {
+		return
+	}
+
+	v, err := documentToJSON(document)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	result, err := normalizeDocumentJSON(v)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
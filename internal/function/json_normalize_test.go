@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestJSONNormalizeFunction_json(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testJSONNormalizeFunctionConfig(`{
+  "b": [1, 2.5],
+  "a": {"y": true, "x": null}
+}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"a":{"x":null,"y":true},"b":[1,2.5]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONNormalizeFunction_yaml(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testJSONNormalizeFunctionConfig("b:\n  - 1\n  - 2.5\na:\n  x: null\n  y: true\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"a":{"x":null,"y":true},"b":[1,2.5]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONNormalizeFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testJSONNormalizeFunctionConfig(`{"a": }`),
+				ExpectError: regexache.MustCompile(`decoding JSON`),
+			},
+		},
+	})
+}
+
+func testJSONNormalizeFunctionConfig(document string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::json_normalize(%[1]q)
+}
+`, document)
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+)
+
+var _ function.Function = jsonPatchFunction{}
+
+func NewJSONPatchFunction() function.Function {
+	return &jsonPatchFunction{}
+}
+
+type jsonPatchFunction struct{}
+
+func (f jsonPatchFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_patch"
+}
+
+func (f jsonPatchFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "json_patch Function",
+		MarkdownDescription: "Applies an RFC 6902 JSON Patch to a JSON or YAML document, returning compact JSON",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "JSON or YAML document",
+			},
+			function.StringParameter{
+				Name:                "patch",
+				MarkdownDescription: "JSON Patch, an array of operations",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f jsonPatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document, patch string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document, &patch))
+	if resp.Error != nil {
+		return
+	}
+
+	v, err := documentToJSON(document)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	p, err := documentToJSON(patch)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	if !strings.HasPrefix(p, "[") {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, "patch must be an array of operations"))
+		return
+	}
+
+	v, err = tfjson.ApplyPatchToString(v, p)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := normalizeDocumentJSON(v)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
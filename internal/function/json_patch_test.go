@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestJSONPatchFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testJSONPatchFunctionConfig(`{"foo": ["bar", "baz"], "qux": 1}`, `[{"op": "add", "path": "/foo/1", "value": "new"}, {"op": "remove", "path": "/qux"}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"foo":["bar","new","baz"]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestJSONPatchFunction_testFailure(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testJSONPatchFunctionConfig(`{"foo": "bar"}`, `[{"op": "test", "path": "/foo", "value": "baz"}]`),
+				ExpectError: regexache.MustCompile(`testing value /foo failed`),
+			},
+		},
+	})
+}
+
+func TestJSONPatchFunction_notArray(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testJSONPatchFunctionConfig(`{"foo": "bar"}`, `{"op": "remove", "path": "/foo"}`),
+				ExpectError: regexache.MustCompile(`patch must be an array of operations`),
+			},
+		},
+	})
+}
+
+func testJSONPatchFunctionConfig(document, patch string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::json_patch(%[1]q, %[2]q)
+}
+`, document, patch)
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var parseResourceIDResultAttrTypes = map[string]attr.Type{
+	"partition":     types.StringType,
+	"service":       types.StringType,
+	"region":        types.StringType,
+	"account_id":    types.StringType,
+	"resource_type": types.StringType,
+	"components":    types.MapType{ElemType: types.StringType},
+}
+
+var _ function.Function = parseResourceIDFunction{}
+
+func NewParseResourceIDFunction() function.Function {
+	return &parseResourceIDFunction{}
+}
+
+type parseResourceIDFunction struct{}
+
+func (f parseResourceIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_resource_id"
+}
+
+func (f parseResourceIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "parse_resource_id Function",
+		MarkdownDescription: "Parses an ARN into its constituent parts, breaking the resource section into service-specific components",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseResourceIDResultAttrTypes,
+		},
+	}
+}
+
+func (f parseResourceIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	id, err := parseResourceID(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	components, d := types.MapValueFrom(ctx, types.StringType, id.components)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"partition":     types.StringValue(id.partition),
+		"service":       types.StringValue(id.service),
+		"region":        types.StringValue(id.region),
+		"account_id":    types.StringValue(id.accountID),
+		"resource_type": types.StringValue(id.resourceType),
+		"components":    components,
+	}
+
+	result, d := types.ObjectValue(parseResourceIDResultAttrTypes, value)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
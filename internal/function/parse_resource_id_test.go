@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestParseResourceIDFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testParseResourceIDFunctionConfig("arn:aws:iam::444455556666:role/service-role/example"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("resource_type", "role"),
+					resource.TestCheckOutput("path", "/service-role/"),
+					resource.TestCheckOutput("role_name", "example"),
+				),
+			},
+		},
+	})
+}
+
+func TestParseResourceIDFunction_unsupported(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testParseResourceIDFunctionConfig("arn:aws:iam::444455556666:example"),
+				ExpectError: regexache.MustCompile(`unsupported iam resource "example"`),
+			},
+		},
+	})
+}
+
+func TestParseResourceIDFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testParseResourceIDFunctionConfig("invalid"),
+				ExpectError: regexache.MustCompile("arn: invalid prefix"),
+			},
+		},
+	})
+}
+
+func testParseResourceIDFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  test = provider::aws::parse_resource_id(%[1]q)
+}
+
+output "resource_type" {
+  value = local.test.resource_type
+}
+
+output "path" {
+  value = local.test.components["path"]
+}
+
+output "role_name" {
+  value = local.test.components["role_name"]
+}
+`, arg)
+}
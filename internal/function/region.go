@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"errors"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// partitionForRegion returns the partition for the specified Region.
+// Regions that are not in any known partition are in the standard partition.
+func partitionForRegion(region string) (endpoints.Partition, error) {
+	if region == "" {
+		return endpoints.Partition{}, errors.New("region must not be empty")
+	}
+
+	return names.PartitionForRegion(region), nil
+}
+
+// dnsSuffixForRegion returns the DNS suffix of the partition for the specified Region.
+func dnsSuffixForRegion(region string) (string, error) {
+	partition, err := partitionForRegion(region)
+	if err != nil {
+		return "", err
+	}
+
+	dnsSuffix := partition.DNSSuffix()
+	if dnsSuffix == "" {
+		dnsSuffix = "amazonaws.com"
+	}
+
+	return dnsSuffix, nil
+}
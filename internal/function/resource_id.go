@@ -0,0 +1,341 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// resourceIDFormat describes how the resource section of an ARN is composed for one resource type of a service.
+type resourceIDFormat struct {
+	service      string
+	resourceType string
+	// pattern matches the resource section of an ARN. Each named capture group is a component.
+	pattern *regexp.Regexp
+	// template builds the resource section from components, using the same "{name}" placeholders as the @ArnFormat annotation.
+	// A section in square brackets is omitted when none of its components are set.
+	template string
+	// defaults are used for components that are not set when building a resource section.
+	defaults map[string]string
+}
+
+func (f resourceIDFormat) components() []string {
+	return slices.DeleteFunc(slices.Clone(f.pattern.SubexpNames()), func(s string) bool { return s == "" })
+}
+
+// parse returns the components of the specified resource section, or false if the resource section does not match the format.
+func (f resourceIDFormat) parse(resource string) (map[string]string, bool) {
+	match := f.pattern.FindStringSubmatch(resource)
+	if match == nil {
+		return nil, false
+	}
+
+	components := make(map[string]string)
+	for i, name := range f.pattern.SubexpNames() {
+		if name != "" && match[i] != "" {
+			components[name] = match[i]
+		}
+	}
+
+	return components, true
+}
+
+// build returns the resource section composed from the specified components.
+func (f resourceIDFormat) build(components map[string]string) (string, error) {
+	known := f.components()
+	for name := range components {
+		if !slices.Contains(known, name) {
+			return "", fmt.Errorf("unsupported component %q for %s resource type %q, expected one of: %s", name, f.service, f.resourceType, strings.Join(known, ", "))
+		}
+	}
+
+	values := maps.Clone(f.defaults)
+	if values == nil {
+		values = make(map[string]string)
+	}
+	maps.Copy(values, components)
+
+	var sb strings.Builder
+	var optional strings.Builder
+	var inOptional, optionalSet bool
+	var missing []string
+
+	for template := f.template; template != ""; {
+		switch c := template[0]; c {
+		case '[':
+			inOptional, optionalSet = true, false
+			optional.Reset()
+			template = template[1:]
+		case ']':
+			if optionalSet {
+				sb.WriteString(optional.String())
+			}
+			inOptional = false
+			template = template[1:]
+		case '{':
+			end := strings.IndexByte(template, '}')
+			name := template[1:end]
+			template = template[end+1:]
+
+			v, ok := values[name]
+			switch {
+			case inOptional:
+				optional.WriteString(v)
+				optionalSet = optionalSet || ok
+			case !ok || v == "":
+				missing = append(missing, name)
+			default:
+				sb.WriteString(v)
+			}
+		default:
+			if inOptional {
+				optional.WriteByte(c)
+			} else {
+				sb.WriteByte(c)
+			}
+			template = template[1:]
+		}
+	}
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing required components for %s resource type %q: %s", f.service, f.resourceType, strings.Join(missing, ", "))
+	}
+
+	resource := sb.String()
+	if _, ok := f.parse(resource); !ok {
+		return "", fmt.Errorf("invalid components for %s resource type %q: %q", f.service, f.resourceType, resource)
+	}
+
+	return resource, nil
+}
+
+// resourceIDFormats lists the supported resource section formats by service, most specific first.
+var resourceIDFormats = sortResourceIDFormats(slices.Concat(annotatedResourceIDFormats, unannotatedResourceIDFormats))
+
+// unannotatedResourceIDFormats lists the formats of ARNs that are not described by an @ArnFormat annotation.
+// These are ARNs of things other than resource types, ARNs that cannot be exactly composed from a resource type's attribute values
+// and optional forms of annotated ARNs. The formats of all other ARNs are generated into annotatedResourceIDFormats.
+var unannotatedResourceIDFormats = []resourceIDFormat{
+	newResourceIDFormat("dynamodb", "index", "table/{table_name}/index/{index_name}"),
+	newResourceIDFormat("dynamodb", "stream", "table/{table_name}/stream/{stream_label}"),
+	newResourceIDFormat("ec2", "", "{resource_type}/{resource_id}"), // e.g. instance/i-1234567890abcdef0 or vpc/vpc-1234567890abcdef0.
+	newResourceIDFormat("ecs", "service", "service/[{cluster_name}/]{service_name}"),
+	newResourceIDFormat("ecs", "task", "task/[{cluster_name}/]{task_id}"),
+	newResourceIDFormat("elasticloadbalancing", "listener", "listener/{load_balancer_type}/{load_balancer_name}/{load_balancer_id}/{listener_id}"),
+	newResourceIDFormat("elasticloadbalancing", "loadbalancer", "loadbalancer/[{load_balancer_type}/]{load_balancer_name}[/{load_balancer_id}]"),
+	newResourceIDFormat("elasticloadbalancing", "targetgroup", "targetgroup/{target_group_name}/{target_group_id}"),
+	newResourceIDFormat("events", "rule", "rule/[{event_bus_name}/]{rule_name}"),
+	newResourceIDFormat("lambda", "function", "function:{function_name}:{qualifier}"),
+	newResourceIDFormat("lambda", "layer", "layer:{layer_name}"),
+	newResourceIDFormat("logs", "log-group", "log-group:{name}:{wildcard}"),
+	newResourceIDFormat("s3", "bucket", "{bucket}"),
+	newResourceIDFormat("s3", "object", "{bucket}/{key}"),
+	newResourceIDFormat("secretsmanager", "secret", "secret:{secret_name}-{suffix}"), // Secrets Manager appends a hyphen and 6 random characters to the secret name.
+	newResourceIDFormat("sns", "subscription", "{topic_name}:{subscription_id}"),
+	newResourceIDFormat("states", "execution", "execution:{state_machine_name}:{execution_name}"),
+	newResourceIDFormat("states", "stateMachine", "stateMachine:{name}:{qualifier}"),
+	newResourceIDFormat("sts", "assumed-role", "assumed-role/{role_name}/{role_session_name}"),
+}
+
+// newResourceIDFormat returns the format described by the specified template.
+// Each "{name}" placeholder matches a component that runs up to the next "/" or ":" delimiter that follows it in the template,
+// or to the end of the resource section if no delimiter follows it.
+// A "{path}" placeholder matches an IAM path and defaults to "/".
+func newResourceIDFormat(service, resourceType, template string) resourceIDFormat {
+	var sb strings.Builder
+	sb.WriteString("^")
+
+	for i := 0; i < len(template); {
+		switch c := template[i]; c {
+		case '[':
+			sb.WriteString("(?:")
+			i++
+		case ']':
+			sb.WriteString(")?")
+			i++
+		case '{':
+			end := i + strings.IndexByte(template[i:], '}')
+			name := template[i+1 : end]
+			i = end + 1
+
+			var excluded string
+			for _, delimiter := range []string{"/", ":"} {
+				if strings.Contains(template[i:], delimiter) {
+					excluded += delimiter
+				}
+			}
+
+			switch {
+			case name == "path":
+				sb.WriteString(`(?P<path>/(?:[^/]+/)*)`)
+			case excluded == "":
+				fmt.Fprintf(&sb, `(?P<%s>.+)`, name)
+			default:
+				fmt.Fprintf(&sb, `(?P<%s>[^%s]+)`, name, excluded)
+			}
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+			i++
+		}
+	}
+
+	sb.WriteString("$")
+
+	f := resourceIDFormat{
+		service:      service,
+		resourceType: resourceType,
+		pattern:      regexache.MustCompile(sb.String()),
+		template:     template,
+	}
+	if strings.Contains(template, "{path}") {
+		f.defaults = map[string]string{"path": "/"}
+	}
+
+	return f
+}
+
+// specificity returns the number of literal characters in the format's template.
+func (f resourceIDFormat) specificity() int {
+	var n int
+	var inPlaceholder bool
+	for _, c := range f.template {
+		switch c {
+		case '{':
+			inPlaceholder = true
+		case '}':
+			inPlaceholder = false
+		case '[', ']':
+		default:
+			if !inPlaceholder {
+				n++
+			}
+		}
+	}
+
+	return n
+}
+
+// sortResourceIDFormats sorts formats by service, listing more specific formats before the formats they may overlap.
+func sortResourceIDFormats(formats []resourceIDFormat) []resourceIDFormat {
+	slices.SortStableFunc(formats, func(a, b resourceIDFormat) int {
+		return cmp.Or(
+			cmp.Compare(a.service, b.service),
+			cmp.Compare(b.specificity(), a.specificity()),
+		)
+	})
+
+	return formats
+}
+
+// resourceID is an ARN with its resource section broken into components.
+type resourceID struct {
+	partition    string
+	service      string
+	region       string
+	accountID    string
+	resourceType string
+	components   map[string]string
+}
+
+// parseResourceID parses an ARN using the resource section format of its service.
+func parseResourceID(s string) (resourceID, error) {
+	parts, err := arn.Parse(s)
+	if err != nil {
+		return resourceID{}, err
+	}
+
+	var found bool
+	for _, f := range resourceIDFormats {
+		if f.service != parts.Service {
+			continue
+		}
+		found = true
+
+		components, ok := f.parse(parts.Resource)
+		if !ok {
+			continue
+		}
+
+		resourceType := f.resourceType
+		if resourceType == "" {
+			resourceType = components["resource_type"]
+			delete(components, "resource_type")
+		}
+
+		return resourceID{
+			partition:    parts.Partition,
+			service:      parts.Service,
+			region:       parts.Region,
+			accountID:    parts.AccountID,
+			resourceType: resourceType,
+			components:   components,
+		}, nil
+	}
+
+	if !found {
+		return resourceID{}, fmt.Errorf("unsupported service %q", parts.Service)
+	}
+
+	return resourceID{}, fmt.Errorf("unsupported %s resource %q", parts.Service, parts.Resource)
+}
+
+// buildResourceID builds an ARN from a resource ID.
+func buildResourceID(id resourceID) (string, error) {
+	var (
+		errs  []error
+		found bool
+	)
+	for _, f := range resourceIDFormats {
+		if f.service != id.service {
+			continue
+		}
+		found = true
+
+		components := id.components
+		switch f.resourceType {
+		case "":
+			components = maps.Clone(components)
+			if components == nil {
+				components = make(map[string]string)
+			}
+			components["resource_type"] = id.resourceType
+		case id.resourceType:
+		default:
+			continue
+		}
+
+		resource, err := f.build(components)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		return arn.ARN{
+			Partition: id.partition,
+			Service:   id.service,
+			Region:    id.region,
+			AccountID: id.accountID,
+			Resource:  resource,
+		}.String(), nil
+	}
+
+	if !found {
+		return "", fmt.Errorf("unsupported service %q", id.service)
+	}
+
+	if len(errs) == 0 {
+		return "", fmt.Errorf("unsupported %s resource type %q", id.service, id.resourceType)
+	}
+
+	return "", errors.Join(errs...)
+}
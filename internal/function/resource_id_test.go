@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn          string
+		resourceType string
+		components   map[string]string
+		expectError  bool
+	}{
+		"invalid ARN": {
+			arn:         "invalid",
+			expectError: true,
+		},
+		"unsupported service": {
+			arn:         "arn:aws:example:us-west-2:123456789012:thing/example",
+			expectError: true,
+		},
+		"IAM role": {
+			arn:          "arn:aws:iam::123456789012:role/example",
+			resourceType: "role",
+			components:   map[string]string{"path": "/", "name": "example"},
+		},
+		"IAM role with path": {
+			arn:          "arn:aws:iam::123456789012:role/service-role/team/example",
+			resourceType: "role",
+			components:   map[string]string{"path": "/service-role/team/", "name": "example"},
+		},
+		"Lambda function": {
+			arn:          "arn:aws:lambda:us-west-2:123456789012:function:example",
+			resourceType: "function",
+			components:   map[string]string{"function_name": "example"},
+		},
+		"Lambda function with qualifier": {
+			arn:          "arn:aws:lambda:us-west-2:123456789012:function:example:live",
+			resourceType: "function",
+			components:   map[string]string{"function_name": "example", "qualifier": "live"},
+		},
+		"CloudWatch Logs log group": {
+			arn:          "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/example:*",
+			resourceType: "log-group",
+			components:   map[string]string{"name": "/aws/lambda/example", "wildcard": "*"},
+		},
+		"CloudWatch Logs log stream": {
+			arn:          "arn:aws:logs:us-west-2:123456789012:log-group:/aws/lambda/example:log-stream:2026/01/01",
+			resourceType: "log-stream",
+			components:   map[string]string{"log_group_name": "/aws/lambda/example", "name": "2026/01/01"},
+		},
+		"EC2 VPC": {
+			arn:          "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcdef0",
+			resourceType: "vpc",
+			components:   map[string]string{"resource_id": "vpc-0123456789abcdef0"},
+		},
+		"S3 object": {
+			arn:          "arn:aws:s3:::example/path/to/object.txt",
+			resourceType: "object",
+			components:   map[string]string{"bucket": "example", "key": "path/to/object.txt"},
+		},
+		"DynamoDB index": {
+			arn:          "arn:aws:dynamodb:us-west-2:123456789012:table/example/index/example-index",
+			resourceType: "index",
+			components:   map[string]string{"table_name": "example", "index_name": "example-index"},
+		},
+		"ELB application load balancer": {
+			arn:          "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/example/50dc6c495c0c9188",
+			resourceType: "loadbalancer",
+			components:   map[string]string{"load_balancer_type": "app", "load_balancer_name": "example", "load_balancer_id": "50dc6c495c0c9188"},
+		},
+		"ELB classic load balancer": {
+			arn:          "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/example",
+			resourceType: "loadbalancer",
+			components:   map[string]string{"load_balancer_name": "example"},
+		},
+		"Secrets Manager secret": {
+			arn:          "arn:aws:secretsmanager:us-west-2:123456789012:secret:example-secret-a1B2c3",
+			resourceType: "secret",
+			components:   map[string]string{"secret_name": "example-secret", "suffix": "a1B2c3"},
+		},
+		"Batch job definition": {
+			arn:          "arn:aws:batch:us-west-2:123456789012:job-definition/example:3",
+			resourceType: "job-definition",
+			components:   map[string]string{"name": "example", "revision": "3"},
+		},
+		"unsupported resource": {
+			arn:         "arn:aws:lambda:us-west-2:123456789012:event-source-mapping:example",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseResourceID(testCase.arn)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("parseResourceID(%q) err %t, want %t", testCase.arn, got, want)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := got.resourceType, testCase.resourceType; got != want {
+				t.Errorf("resource type = %q, want %q", got, want)
+			}
+			if diff := cmp.Diff(got.components, testCase.components); diff != "" {
+				t.Errorf("unexpected components diff (+wanted, -got): %s", diff)
+			}
+
+			// Building the parsed resource ID must return the original ARN.
+			arn, err := buildResourceID(got)
+			if err != nil {
+				t.Fatalf("buildResourceID: %s", err)
+			}
+			if arn != testCase.arn {
+				t.Errorf("buildResourceID = %q, want %q", arn, testCase.arn)
+			}
+		})
+	}
+}
+
+func TestBuildResourceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id          resourceID
+		expected    string
+		expectError bool
+	}{
+		"IAM role default path": {
+			id: resourceID{
+				partition:    "aws",
+				service:      "iam",
+				accountID:    "123456789012",
+				resourceType: "role",
+				components:   map[string]string{"name": "example"},
+			},
+			expected: "arn:aws:iam::123456789012:role/example",
+		},
+		"ECS service without cluster": {
+			id: resourceID{
+				partition:    "aws",
+				service:      "ecs",
+				region:       "us-west-2",
+				accountID:    "123456789012",
+				resourceType: "service",
+				components:   map[string]string{"service_name": "example"},
+			},
+			expected: "arn:aws:ecs:us-west-2:123456789012:service/example",
+		},
+		"missing component": {
+			id: resourceID{
+				partition:    "aws",
+				service:      "iam",
+				resourceType: "role",
+				components:   map[string]string{"path": "/example/"},
+			},
+			expectError: true,
+		},
+		"unsupported component": {
+			id: resourceID{
+				partition:    "aws",
+				service:      "iam",
+				resourceType: "role",
+				components:   map[string]string{"name": "example", "qualifier": "live"},
+			},
+			expectError: true,
+		},
+		"invalid component": {
+			id: resourceID{
+				partition:    "aws",
+				service:      "iam",
+				resourceType: "role",
+				components:   map[string]string{"path": "example", "name": "example"},
+			},
+			expectError: true,
+		},
+		"unsupported resource type": {
+			id: resourceID{
+				partition:    "aws",
+				service:      "lambda",
+				resourceType: "event-source-mapping",
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := buildResourceID(testCase.id)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("buildResourceID err %t, want %t: %v", got, want, err)
+			}
+			if err == nil && got != testCase.expected {
+				t.Errorf("buildResourceID = %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
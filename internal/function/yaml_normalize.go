@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	yaml "github.com/goccy/go-yaml"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+var _ function.Function = yamlNormalizeFunction{}
+
+func NewYAMLNormalizeFunction() function.Function {
+	return &yamlNormalizeFunction{}
+}
+
+type yamlNormalizeFunction struct{}
+
+func (f yamlNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "yaml_normalize"
+}
+
+func (f yamlNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "yaml_normalize Function",
+		MarkdownDescription: "Normalizes a JSON or YAML document to YAML with mapping keys sorted",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "JSON or YAML document",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f yamlNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document))
+	if resp.Error != nil {
+		return
+	}
+
+	s, err := documentToJSON(document)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	// JSON is YAML.
+	var v any
+	if err := tfyaml.DecodeFromString(s, &v); err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	result, err := yaml.Marshal(v)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(result)))
+}
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"maps"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+func main() {
+	const (
+		filename = `autoflex_checks_gen_test.go`
+	)
+	g := common.NewGenerator()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating AutoFlex field mapping checks for internal/service/%s", servicePackage)
+
+	// Look for AutoFlex check annotations.
+	// These annotations are implemented as comments on Terraform model struct types.
+	v := &visitor{
+		g: g,
+	}
+
+	for file, err := range common.ScanDirectory(".") {
+		if err != nil {
+			g.Fatalf("%s", err.Error())
+		}
+
+		v.packageName = file.PackageName()
+
+		v.processFile(file.File())
+
+		v.packageName = ""
+	}
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	if len(v.checks) == 0 {
+		g.Infof("No AutoFlex check annotations found")
+		return
+	}
+
+	slices.SortStableFunc(v.checks, func(a, b check) int {
+		return strings.Compare(a.ModelType, b.ModelType)
+	})
+
+	imports := make(map[string]common.GoImport)
+	for _, c := range v.checks {
+		imports[c.GoImport.Path] = c.GoImport
+	}
+
+	td := TemplateData{
+		PackageName: servicePackage,
+		Checks:      v.checks,
+	}
+	for _, k := range slices.Sorted(maps.Keys(imports)) {
+		td.GoImports = append(td.GoImports, imports[k])
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("autoflexchecks", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type TemplateData struct {
+	PackageName string
+	GoImports   []common.GoImport
+	Checks      []check
+}
+
+type check struct {
+	Name         string // Go test function name suffix, e.g. "RepositoryResourceModel_flattenRepository"
+	ModelType    string // Terraform model struct type
+	Direction    string // "Expand" or "Flatten"
+	AWSType      string // Qualified AWS API type, e.g. "awstypes.Repository"
+	GoImport     common.GoImport
+	IgnoreFields []string
+	Prefix       string
+	Suffix       string
+}
+
+//go:embed file.gtpl
+var tmpl string
+
+// Annotation processing.
+var (
+	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	packageName string
+
+	checks []check
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	for _, decl := range file.Decls {
+		if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.TYPE {
+			v.processGenDecl(genDecl)
+		}
+	}
+}
+
+// processGenDecl processes a single Go type declaration.
+// The type's comments are scanned for @AutoFlexCheck annotations.
+func (v *visitor) processGenDecl(genDecl *ast.GenDecl) {
+	for _, spec := range genDecl.Specs {
+		typeSpec, ok := spec.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+
+		doc := typeSpec.Doc
+		if doc == nil && len(genDecl.Specs) == 1 {
+			doc = genDecl.Doc
+		}
+		if doc == nil {
+			continue
+		}
+
+		typeName := typeSpec.Name.Name
+
+		for _, line := range doc.List {
+			m := annotation.FindStringSubmatch(line.Text)
+			if len(m) == 0 || m[1] != "AutoFlexCheck" {
+				continue
+			}
+
+			args, err := common.ParseArgs(m[3])
+			if err != nil {
+				v.errs = append(v.errs, fmt.Errorf("parsing annotation arguments in %s.%s: %w", v.packageName, typeName, err))
+				continue
+			}
+
+			c, err := parseCheck(typeName, args)
+			if err != nil {
+				v.errs = append(v.errs, fmt.Errorf("annotation \"@AutoFlexCheck\" on %s.%s: %w", v.packageName, typeName, err))
+				continue
+			}
+
+			v.checks = append(v.checks, c)
+		}
+	}
+}
+
+// parseCheck parses @AutoFlexCheck annotation arguments of the form
+// expand="<import path>;[<alias>;]<qualified type>" or flatten="...",
+// with optional ignore="<field>;<field>", prefix="<prefix>" and suffix="<suffix>".
+func parseCheck(typeName string, args common.Args) (check, error) {
+	c := check{
+		ModelType: typeName,
+	}
+
+	var spec string
+	for _, direction := range []string{"expand", "flatten"} {
+		if attr, ok := args.Keyword[direction]; ok {
+			if spec != "" {
+				return c, errors.New("only one of expand or flatten can be specified")
+			}
+			spec = attr
+			c.Direction = common.FirstUpper(direction)
+		}
+	}
+	if spec == "" {
+		return c, errors.New("one of expand or flatten is required")
+	}
+
+	awsType, goImport, err := common.ParseIdentifierSpec(spec)
+	if err != nil {
+		return c, err
+	}
+	if goImport == nil {
+		return c, fmt.Errorf("%s: import path is required", spec)
+	}
+
+	pkg, name, ok := strings.Cut(awsType, ".")
+	if !ok {
+		return c, fmt.Errorf("%s: type must be package qualified", spec)
+	}
+	if goImport.Alias == "" && pkg != path.Base(goImport.Path) {
+		goImport.Alias = pkg
+	}
+	c.AWSType = awsType
+	c.GoImport = *goImport
+	c.Name = fmt.Sprintf("%s_%s%s", common.FirstUpper(typeName), strings.ToLower(c.Direction), name)
+
+	if attr, ok := args.Keyword["ignore"]; ok {
+		c.IgnoreFields = strings.Split(attr, ";")
+	}
+	c.Prefix = args.Keyword["prefix"]
+	c.Suffix = args.Keyword["suffix"]
+
+	return c, nil
+}
@@ -0,0 +1,262 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	logDir = flag.String("LogDir", os.Getenv(envvar.AccAPICallLogDir), "directory containing API call logs")
+	output = flag.String("Output", "iam_permissions_manifest.json", "permissions manifest file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource string   `json:"Resource"`
+}
+
+func newPolicyDocument(actions []string) *policyDocument {
+	return &policyDocument{
+		Version: "2012-10-17",
+		Statement: []policyStatement{
+			{
+				Effect:   "Allow",
+				Action:   actions,
+				Resource: "*",
+			},
+		},
+	}
+}
+
+// permissions are the IAM actions required by a resource or data source, by handler.
+type permissions struct {
+	Create []string        `json:"create,omitempty"`
+	Read   []string        `json:"read,omitempty"`
+	Update []string        `json:"update,omitempty"`
+	Delete []string        `json:"delete,omitempty"`
+	Failed []string        `json:"failed,omitempty"` // Actions only recorded in calls that were denied access. Not included in Policy.
+	Policy *policyDocument `json:"policy"`
+}
+
+const manifestDescription = "IAM actions recorded during acceptance tests. " +
+	"Policies narrow actions only: every statement allows all resources (\"Resource\": \"*\"). " +
+	"Actions only recorded in calls that were denied access are listed under \"failed\" and are not included in policies."
+
+type manifest struct {
+	Description string                  `json:"description"`
+	Provider    *permissions            `json:"provider,omitempty"` // Actions made outside any resource or data source handler, e.g. during provider configuration.
+	Resources   map[string]*permissions `json:"resources"`
+	DataSources map[string]*permissions `json:"data_sources"`
+}
+
+type actionSet map[string]struct{}
+
+func (s actionSet) sorted() []string {
+	return slices.Sorted(maps.Keys(s))
+}
+
+// handlerActions are the IAM actions recorded for a resource or data source, by handler.
+type handlerActions map[string]actionSet
+
+func (h handlerActions) add(handler, action string) {
+	if h[handler] == nil {
+		h[handler] = make(actionSet)
+	}
+	h[handler][action] = struct{}{}
+}
+
+// typeActions are the IAM actions recorded for a resource or data source.
+type typeActions struct {
+	succeeded handlerActions
+	failed    actionSet
+}
+
+func newTypeActions() *typeActions {
+	return &typeActions{
+		succeeded: make(handlerActions),
+		failed:    make(actionSet),
+	}
+}
+
+// add records action for entry. Calls that failed for reasons other than authorization, such as
+// a NotFound error during Read, still show that the action is needed.
+func (t *typeActions) add(entry apicall.LogEntry, action string) {
+	if isAccessDeniedErrorCode(entry.ErrorCode) {
+		t.failed[action] = struct{}{}
+		return
+	}
+	t.succeeded.add(entry.Handler, action)
+}
+
+func (t *typeActions) permissions() *permissions {
+	all := make(actionSet)
+	for _, actions := range t.succeeded {
+		maps.Copy(all, actions)
+	}
+
+	return &permissions{
+		Create: t.succeeded[apicall.HandlerCreate].sorted(),
+		Read:   t.succeeded[apicall.HandlerRead].sorted(),
+		Update: t.succeeded[apicall.HandlerUpdate].sorted(),
+		Delete: t.succeeded[apicall.HandlerDelete].sorted(),
+		Failed: failedOnly(t.failed, all),
+		Policy: newPolicyDocument(all.sorted()),
+	}
+}
+
+// isAccessDeniedErrorCode returns whether code is an AWS error code indicating that the call was not authorized.
+func isAccessDeniedErrorCode(code string) bool {
+	switch {
+	case code == "":
+		return false
+	case strings.HasPrefix(code, "AccessDenied"), strings.Contains(code, "NotAuthorized"), strings.HasPrefix(code, "Unauthorized"):
+		return true
+	}
+
+	return slices.Contains([]string{
+		"AuthFailure",
+		"AuthorizationError",
+		"AuthorizationErrorException",
+		"Forbidden",
+		"ForbiddenException",
+	}, code)
+}
+
+// failedOnly returns the actions in failed that are not in succeeded.
+func failedOnly(failed, succeeded actionSet) []string {
+	return slices.DeleteFunc(failed.sorted(), func(action string) bool {
+		_, ok := succeeded[action]
+		return ok
+	})
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *logDir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	g.Infof("Generating IAM permissions manifest %s from API call logs in %s", *output, *logDir)
+
+	files, err := filepath.Glob(filepath.Join(*logDir, "*.jsonl"))
+	if err != nil {
+		g.Fatalf("listing API call logs: %s", err)
+	}
+
+	provider := newTypeActions()
+	resources := make(map[string]*typeActions)
+	dataSources := make(map[string]*typeActions)
+	unknownServices := make(map[string]struct{})
+
+	for _, file := range files {
+		entries, err := readLog(file)
+		if err != nil {
+			g.Fatalf("reading API call log (%s): %s", file, err)
+		}
+
+		for _, entry := range entries {
+			action, _, ok := names.IAMAction(entry.Service, entry.Operation)
+			if !ok {
+				unknownServices[entry.Service] = struct{}{}
+				continue
+			}
+
+			switch {
+			case entry.TypeName == "":
+				provider.add(entry, action)
+			case entry.DataSource:
+				if dataSources[entry.TypeName] == nil {
+					dataSources[entry.TypeName] = newTypeActions()
+				}
+				dataSources[entry.TypeName].add(entry, action)
+			default:
+				if resources[entry.TypeName] == nil {
+					resources[entry.TypeName] = newTypeActions()
+				}
+				resources[entry.TypeName].add(entry, action)
+			}
+		}
+	}
+
+	for _, service := range slices.Sorted(maps.Keys(unknownServices)) {
+		g.Warnf("No IAM action prefix for service ID %q", service)
+	}
+
+	m := manifest{
+		Description: manifestDescription,
+		Resources:   make(map[string]*permissions, len(resources)),
+		DataSources: make(map[string]*permissions, len(dataSources)),
+	}
+	if len(provider.succeeded) > 0 || len(provider.failed) > 0 {
+		m.Provider = provider.permissions()
+	}
+	for typeName, actions := range resources {
+		m.Resources[typeName] = actions.permissions()
+	}
+	for typeName, actions := range dataSources {
+		m.DataSources[typeName] = actions.permissions()
+	}
+
+	body, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		g.Fatalf("encoding permissions manifest: %s", err)
+	}
+
+	d := g.NewUnformattedFileDestination(*output)
+
+	if err := d.BufferBytes(append(body, '\n')); err != nil {
+		g.Fatalf("generating file (%s): %s", *output, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", *output, err)
+	}
+
+	g.Infof("Found %d resources and %d data sources in %d API call logs", len(resources), len(dataSources), len(files))
+}
+
+func readLog(filename string) ([]apicall.LogEntry, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return apicall.ReadLog(f)
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	"cmp"
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+var (
+	servicePackageRoot = flag.String("ServicePackageRoot", "", "path to service package root directory")
+)
+
+func main() {
+	filename := `resource_id_formats_gen.go`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
+	g := common.NewGenerator()
+
+	packageName := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating %s/%s", packageName, filename)
+
+	data, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	td := TemplateData{
+		PackageName: packageName,
+	}
+
+	for _, l := range data {
+		// See internal/generate/namesconsts/main.go.
+		p := l.ProviderPackage()
+
+		dir := fmt.Sprintf("%s/%s", *servicePackageRoot, p)
+
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+
+		v := &visitor{
+			service: l.ARNNamespace(),
+		}
+
+		for file, err := range common.ScanDirectory(dir) {
+			if err != nil {
+				g.Fatalf("%s", err.Error())
+			}
+
+			v.packageName = file.PackageName()
+
+			ast.Walk(v, file.File())
+
+			v.packageName = ""
+		}
+
+		if err := errors.Join(v.errs...); err != nil {
+			g.Fatalf("%s", err.Error())
+		}
+
+		td.Formats = append(td.Formats, v.formats...)
+	}
+
+	slices.SortStableFunc(td.Formats, func(a, b FormatDatum) int {
+		return cmp.Or(
+			cmp.Compare(a.Service, b.Service),
+			cmp.Compare(a.ResourceType, b.ResourceType),
+			cmp.Compare(a.Template, b.Template),
+		)
+	})
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("resourceidformats", tmpl, td); err != nil {
+		g.Fatalf("error generating resource ID formats: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type FormatDatum struct {
+	Service      string // ARN service namespace, e.g. "elasticloadbalancing"
+	ResourceType string
+	Template     string
+	TypeName     string
+}
+
+type TemplateData struct {
+	PackageName string
+	Formats     []FormatDatum
+}
+
+//go:embed file.tmpl
+var tmpl string
+
+// Annotation processing.
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+)
+
+type visitor struct {
+	errs    []error
+	formats []FormatDatum
+
+	packageName string
+	service     string
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for an @ArnFormat annotation on a resource.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	var (
+		d                  FormatDatum
+		isResource, hasARN bool
+	)
+
+	for _, line := range funcDecl.Doc.List {
+		m := annotation.FindStringSubmatch(line.Text)
+		if len(m) == 0 {
+			continue
+		}
+
+		args, err := common.ParseArgs(m[3])
+		if err != nil {
+			v.errs = append(v.errs, fmt.Errorf("parsing annotation arguments in %s.%s: %w", v.packageName, funcDecl.Name.Name, err))
+			continue
+		}
+
+		switch m[1] {
+		case "FrameworkResource", "SDKResource":
+			isResource = true
+			if len(args.Positional) > 0 {
+				d.TypeName = args.Positional[0]
+			}
+
+		case "ArnFormat":
+			hasARN = true
+			if len(args.Positional) > 0 {
+				d.Template = args.Positional[0]
+			}
+			if attr, ok := args.Keyword["resourceType"]; ok {
+				d.ResourceType = attr
+			}
+		}
+	}
+
+	if !isResource || !hasARN {
+		return
+	}
+
+	if d.Template == "" {
+		return
+	}
+
+	if v.service == "" {
+		v.errs = append(v.errs, fmt.Errorf("%s.%s: no ARN namespace for service", v.packageName, funcDecl.Name.Name))
+		return
+	}
+	d.Service = v.service
+
+	if d.ResourceType == "" {
+		// Default to the resource section's leading literal, e.g. "job-definition" for "job-definition/{name}:{revision}".
+		d.ResourceType, _, _ = strings.Cut(d.Template, "{")
+		if i := strings.IndexAny(d.ResourceType, "/:"); i >= 0 {
+			d.ResourceType = d.ResourceType[:i]
+		}
+	}
+	if d.ResourceType == "" {
+		v.errs = append(v.errs, fmt.Errorf("%s.%s: \"@ArnFormat\" requires the resourceType parameter for %q", v.packageName, funcDecl.Name.Name, d.Template))
+		return
+	}
+
+	v.formats = append(v.formats, d)
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
@@ -234,6 +234,8 @@ type ResourceDatum struct {
 	TransparentTagging                bool
 	TagsIdentifierAttribute           string
 	TagsResourceType                  string
+	QuotaServiceCode                  string
+	QuotaCode                         string
 	isARNFormatGlobal                 arnFormatState
 	wrappedImport                     common.TriBoolean
 	CustomImport                      bool
@@ -405,6 +407,25 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					d.TagsResourceType = attr
 				}
 
+			case "Quota":
+				if d.QuotaCode != "" {
+					v.errs = append(v.errs, fmt.Errorf("multiple Quota annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				}
+
+				serviceCode, ok := args.Keyword["serviceCode"]
+				if !ok || serviceCode == "" {
+					v.errs = append(v.errs, fmt.Errorf("Quota missing required parameter serviceCode: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				quotaCode, ok := args.Keyword["quotaCode"]
+				if !ok || quotaCode == "" {
+					v.errs = append(v.errs, fmt.Errorf("Quota missing required parameter quotaCode: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
+				d.QuotaServiceCode = serviceCode
+				d.QuotaCode = quotaCode
+
 			case "WrappedImport":
 				if len(args.Positional) != 1 {
 					v.errs = append(v.errs, fmt.Errorf("WrappedImport missing required parameter: at %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
@@ -740,7 +761,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					v.sdkListResources[typeName] = d
 				}
 
-			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "NoImport", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
+			case "IdentityAttribute", "ArnIdentity", "ImportIDHandler", "MutableIdentity", "SingletonIdentity", "Region", "Tags", "Quota", "WrappedImport", "V60SDKv2Fix", "IdentityFix", "NoImport", "CustomImport", "IdentityVersion", "CustomInherentRegionIdentity":
 				// Handled above.
 			case "ArnFormat", "IdAttrFormat", "Testing":
 				// Ignored.
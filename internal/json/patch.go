@@ -4,6 +4,11 @@
 package json
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	evanphxjsonpatch "github.com/evanphx/json-patch/v5"
 	mattbairdjsonpatch "github.com/mattbaird/jsonpatch"
 )
 
@@ -13,3 +18,51 @@ import (
 func CreatePatchFromStrings(a, b string) ([]mattbairdjsonpatch.JsonPatchOperation, error) {
 	return mattbairdjsonpatch.CreatePatch([]byte(a), []byte(b))
 }
+
+// `ApplyPatchToString` applies an [RFC6902](https://datatracker.ietf.org/doc/html/rfc6902) JSON Patch to a JSON string.
+// `doc` is the original JSON document and `patch` is a JSON array of operations.
+// Operations are applied in order and the first failing operation stops processing.
+// The modified JSON document is returned in compact form.
+func ApplyPatchToString(doc, patch string) (string, error) {
+	p, err := evanphxjsonpatch.DecodePatch([]byte(patch))
+	if err != nil {
+		return "", fmt.Errorf("decoding patch: %w", err)
+	}
+
+	b, err := p.Apply([]byte(doc))
+	if err != nil {
+		return "", err
+	}
+
+	return compactPatchResult(b)
+}
+
+// `MergePatchToString` applies an [RFC7386](https://datatracker.ietf.org/doc/html/rfc7386) JSON Merge Patch to a JSON string.
+// The modified JSON document is returned in compact form.
+func MergePatchToString(doc, patch string) (string, error) {
+	b, err := evanphxjsonpatch.MergePatch([]byte(doc), []byte(patch))
+	if err != nil {
+		return "", err
+	}
+
+	return compactPatchResult(b)
+}
+
+// compactPatchResult returns a patched JSON document in compact form with object keys sorted.
+// Numbers are decoded as json.Number, so they keep their original precision.
+func compactPatchResult(b []byte) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("patched document: %w", err)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("patched document: %w", err)
+	}
+
+	return string(b), nil
+}
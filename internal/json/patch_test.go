@@ -94,3 +94,231 @@ func TestCreatePatchFromStrings(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyPatchToString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName string
+		doc      string
+		patch    string
+		want     string
+		wantErr  bool
+	}{
+		{
+			testName: "invalid JSON",
+			doc:      `test`,
+			patch:    `[]`,
+			wantErr:  true,
+		},
+		{
+			testName: "empty patch",
+			doc:      `{"A": "test1", "B": 42}`,
+			patch:    `[]`,
+			want:     `{"A":"test1","B":42}`,
+		},
+		// RFC 6902 Appendix A examples.
+		{
+			testName: "add object member",
+			doc:      `{"foo": "bar"}`,
+			patch:    `[{"op": "add", "path": "/baz", "value": "qux"}]`,
+			want:     `{"baz":"qux","foo":"bar"}`,
+		},
+		{
+			testName: "add array element",
+			doc:      `{"foo": ["bar", "baz"]}`,
+			patch:    `[{"op": "add", "path": "/foo/1", "value": "qux"}]`,
+			want:     `{"foo":["bar","qux","baz"]}`,
+		},
+		{
+			testName: "append array element",
+			doc:      `{"foo": ["bar"]}`,
+			patch:    `[{"op": "add", "path": "/foo/-", "value": ["abc", "def"]}]`,
+			want:     `{"foo":["bar",["abc","def"]]}`,
+		},
+		{
+			testName: "remove array element",
+			doc:      `{"foo": ["bar", "qux", "baz"]}`,
+			patch:    `[{"op": "remove", "path": "/foo/1"}]`,
+			want:     `{"foo":["bar","baz"]}`,
+		},
+		{
+			testName: "replace value",
+			doc:      `{"baz": "qux", "foo": "bar"}`,
+			patch:    `[{"op": "replace", "path": "/baz", "value": "boo"}]`,
+			want:     `{"baz":"boo","foo":"bar"}`,
+		},
+		{
+			testName: "move value",
+			doc:      `{"foo": {"bar": "baz", "waldo": "fred"}, "qux": {"corge": "grault"}}`,
+			patch:    `[{"op": "move", "from": "/foo/waldo", "path": "/qux/thud"}]`,
+			want:     `{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{
+			testName: "move array element",
+			doc:      `{"foo": ["all", "grass", "cows", "eat"]}`,
+			patch:    `[{"op": "move", "from": "/foo/1", "path": "/foo/3"}]`,
+			want:     `{"foo":["all","cows","eat","grass"]}`,
+		},
+		{
+			testName: "copy value",
+			doc:      `{"foo": {"bar": 1}}`,
+			patch:    `[{"op": "copy", "from": "/foo", "path": "/baz"}, {"op": "replace", "path": "/baz/bar", "value": 2}]`,
+			want:     `{"baz":{"bar":2},"foo":{"bar":1}}`,
+		},
+		{
+			testName: "escaped pointer",
+			doc:      `{"a/b": {"m~n": 1}}`,
+			patch:    `[{"op": "replace", "path": "/a~1b/m~0n", "value": 2}]`,
+			want:     `{"a/b":{"m~n":2}}`,
+		},
+		{
+			testName: "test success",
+			doc:      `{"baz": "qux", "foo": ["a", 2, "c"]}`,
+			patch:    `[{"op": "test", "path": "/baz", "value": "qux"}, {"op": "test", "path": "/foo/1", "value": 2}]`,
+			want:     `{"baz":"qux","foo":["a",2,"c"]}`,
+		},
+		{
+			testName: "test failure",
+			doc:      `{"baz": "qux"}`,
+			patch:    `[{"op": "test", "path": "/baz", "value": "bar"}]`,
+			wantErr:  true,
+		},
+		{
+			testName: "add to nonexistent target",
+			doc:      `{"foo": "bar"}`,
+			patch:    `[{"op": "add", "path": "/baz/bat", "value": "qux"}]`,
+			wantErr:  true,
+		},
+		{
+			testName: "remove nonexistent target",
+			doc:      `{"foo": "bar"}`,
+			patch:    `[{"op": "remove", "path": "/baz"}]`,
+			wantErr:  true,
+		},
+		{
+			testName: "array index out of bounds",
+			doc:      `{"foo": ["bar"]}`,
+			patch:    `[{"op": "add", "path": "/foo/2", "value": "qux"}]`,
+			wantErr:  true,
+		},
+		{
+			testName: "unsupported operation",
+			doc:      `{"foo": "bar"}`,
+			patch:    `[{"op": "invalid", "path": "/foo"}]`,
+			wantErr:  true,
+		},
+		{
+			testName: "large integers",
+			doc:      `{"foo": 9007199254740993}`,
+			patch:    `[{"op": "add", "path": "/bar", "value": 18446744073709551615}]`,
+			want:     `{"bar":18446744073709551615,"foo":9007199254740993}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfjson.ApplyPatchToString(testCase.doc, testCase.patch)
+			if got, want := err != nil, testCase.wantErr; !gocmp.Equal(got, want) {
+				t.Errorf("ApplyPatchToString(%s, %s) err %t, want %t", testCase.doc, testCase.patch, got, want)
+			}
+			if err == nil {
+				if diff := gocmp.Diff(got, testCase.want); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestMergePatchToString(t *testing.T) {
+	t.Parallel()
+
+	// RFC 7386 Appendix A examples.
+	testCases := []struct {
+		testName string
+		doc      string
+		patch    string
+		want     string
+		wantErr  bool
+	}{
+		{
+			testName: "invalid JSON",
+			doc:      `{}`,
+			patch:    `test`,
+			wantErr:  true,
+		},
+		{
+			testName: "replace member",
+			doc:      `{"a": "b"}`,
+			patch:    `{"a": "c"}`,
+			want:     `{"a":"c"}`,
+		},
+		{
+			testName: "add member",
+			doc:      `{"a": "b"}`,
+			patch:    `{"b": "c"}`,
+			want:     `{"a":"b","b":"c"}`,
+		},
+		{
+			testName: "remove member",
+			doc:      `{"a": "b", "b": "c"}`,
+			patch:    `{"a": null}`,
+			want:     `{"b":"c"}`,
+		},
+		{
+			testName: "replace array",
+			doc:      `{"a": ["b"]}`,
+			patch:    `{"a": ["c"]}`,
+			want:     `{"a":["c"]}`,
+		},
+		{
+			testName: "nested",
+			doc:      `{"a": {"b": "c"}}`,
+			patch:    `{"a": {"b": "d", "c": null}}`,
+			want:     `{"a":{"b":"d"}}`,
+		},
+		{
+			testName: "replace non-object document",
+			doc:      `["a", "b"]`,
+			patch:    `{"a": "c"}`,
+			want:     `{"a":"c"}`,
+		},
+		{
+			testName: "non-object patch",
+			doc:      `{"a": "b"}`,
+			patch:    `["c"]`,
+			want:     `["c"]`,
+		},
+		{
+			testName: "large integers",
+			doc:      `{"a": 9007199254740993}`,
+			patch:    `{"b": 18446744073709551615}`,
+			want:     `{"a":9007199254740993,"b":18446744073709551615}`,
+		},
+		{
+			testName: "null nested in new member",
+			doc:      `{}`,
+			patch:    `{"a": {"bb": {"ccc": null}}}`,
+			want:     `{"a":{"bb":{}}}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfjson.MergePatchToString(testCase.doc, testCase.patch)
+			if got, want := err != nil, testCase.wantErr; !gocmp.Equal(got, want) {
+				t.Errorf("MergePatchToString(%s, %s) err %t, want %t", testCase.doc, testCase.patch, got, want)
+			}
+			if err == nil {
+				if diff := gocmp.Diff(got, testCase.want); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+// accessDeniedDiagnostics returns a diagnostic explaining each access denied error reported in the specified handler diagnostics.
+func accessDeniedDiagnostics(ctx context.Context, c awsClient, handlerDiags diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var errorMessages []string
+	for _, d := range handlerDiags.Errors() {
+		errorMessages = append(errorMessages, d.Summary()+"\n"+d.Detail())
+	}
+
+	for _, e := range interceptors.AccessDeniedErrors(ctx, c, errorMessages) {
+		diags.AddError(e.Summary(), e.Detail())
+	}
+
+	return diags
+}
+
+type dataSourceAccessDeniedInterceptor struct{}
+
+func (r dataSourceAccessDeniedInterceptor) read(ctx context.Context, opts interceptorOptions[datasource.ReadRequest, datasource.ReadResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case OnError:
+		response.Diagnostics.Append(accessDeniedDiagnostics(ctx, opts.c, response.Diagnostics)...)
+	}
+}
+
+// dataSourceAccessDenied explains any access denied errors after a failed Read.
+func dataSourceAccessDenied() dataSourceCRUDInterceptor {
+	return &dataSourceAccessDeniedInterceptor{}
+}
+
+type resourceAccessDeniedInterceptor struct{}
+
+func (r resourceAccessDeniedInterceptor) create(ctx context.Context, opts interceptorOptions[resource.CreateRequest, resource.CreateResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case OnError:
+		response.Diagnostics.Append(accessDeniedDiagnostics(ctx, opts.c, response.Diagnostics)...)
+	}
+}
+
+func (r resourceAccessDeniedInterceptor) read(ctx context.Context, opts interceptorOptions[resource.ReadRequest, resource.ReadResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case OnError:
+		response.Diagnostics.Append(accessDeniedDiagnostics(ctx, opts.c, response.Diagnostics)...)
+	}
+}
+
+func (r resourceAccessDeniedInterceptor) update(ctx context.Context, opts interceptorOptions[resource.UpdateRequest, resource.UpdateResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case OnError:
+		response.Diagnostics.Append(accessDeniedDiagnostics(ctx, opts.c, response.Diagnostics)...)
+	}
+}
+
+func (r resourceAccessDeniedInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case OnError:
+		response.Diagnostics.Append(accessDeniedDiagnostics(ctx, opts.c, response.Diagnostics)...)
+	}
+}
+
+// resourceAccessDenied explains any access denied errors after a failed CRUD operation.
+func resourceAccessDenied() resourceCRUDInterceptor {
+	return &resourceAccessDeniedInterceptor{}
+}
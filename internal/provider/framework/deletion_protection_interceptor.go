@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type resourceDeletionProtectionInterceptor struct {
+	resourceNoOpCRUDInterceptor
+	typeName string
+}
+
+func (r resourceDeletionProtectionInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// Only read attributes defined in the resource's schema.
+		attributes := request.State.Schema.GetAttributes()
+
+		var arn, id types.String
+		if _, ok := attributes[names.AttrARN]; ok {
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrARN), &arn)...)
+		}
+		if _, ok := attributes[names.AttrID]; ok {
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)...)
+		}
+		var tags tftags.Map
+		if _, ok := attributes[names.AttrTagsAll]; ok {
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &tags)...)
+		}
+		if _, ok := attributes[names.AttrTags]; ok && len(tags.Elements()) == 0 {
+			response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTags), &tags)...)
+		}
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if summary, detail, ok := interceptors.DeletionProtected(ctx, opts.c, r.typeName, id.ValueString(), arn.ValueString(), tftags.New(ctx, tags).Map()); ok {
+			response.Diagnostics.AddError(summary, detail)
+		}
+	}
+}
+
+// resourceDeletionProtection refuses deletion of resources matched by the provider's deletion_protection configuration.
+func resourceDeletionProtection(typeName string) resourceCRUDInterceptor {
+	return &resourceDeletionProtectionInterceptor{
+		typeName: typeName,
+	}
+}
@@ -122,6 +122,15 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
 			},
+			"quota_preflight_check": schema.StringAttribute{
+				Optional: true,
+				Description: `The severity with which to report planned resource creations that would exceed a service quota. ` +
+					`Applies to resource types that declare the service quota they count against. ` +
+					`Valid values are "error", "warning", and "disabled". ` +
+					`When unset or "disabled", service quotas will not be checked at plan time. ` +
+					`With "warning", warnings for resources implemented with the Terraform Plugin SDK are only written to the provider log. ` +
+					`Can also be configured with the ` + conns.QuotaPreflightCheckEnvVar + ` environment variable.`,
+			},
 			"region": schema.StringAttribute{
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
@@ -304,6 +313,34 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"deletion_protection": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to refuse deletion of matching resources managed by this provider instance.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"arns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "ARN patterns of resources to protect from deletion. " +
+								"`*` matches any sequence of characters and `?` matches any single character.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource type patterns, e.g. `aws_kms_key` or `aws_route53_*`, of resources to protect from deletion. " +
+								"`*` matches any sequence of characters and `?` matches any single character.",
+						},
+						"tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags of resources to protect from deletion. " +
+								"A resource is protected if it has all of the tags. A tag value of `*` matches any value.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -397,8 +434,22 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewBuildResourceIDFunction,
+		tffunction.NewDNSSuffixFunction,
+		tffunction.NewDocumentEqualFunction,
+		tffunction.NewEC2PrivateDNSNameFunction,
+		tffunction.NewELBHostedZoneIDFunction,
+		tffunction.NewEventPatternMatchFunction,
+		tffunction.NewEventPatternValidateFunction,
+		tffunction.NewJSONMergePatchFunction,
+		tffunction.NewJSONNormalizeFunction,
+		tffunction.NewJSONPatchFunction,
+		tffunction.NewParseResourceIDFunction,
+		tffunction.NewPartitionOfFunction,
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
+		tffunction.NewYAMLNormalizeFunction,
 	}
 }
 
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type quotaAWSClient interface {
+	QuotaPreflightConfig(context.Context) *conns.QuotaPreflightConfig
+}
+
+// resourceQuotaPreflight checks at plan time whether creating a resource would exceed the service quota it counts against.
+func resourceQuotaPreflight(typeName string, quota unique.Handle[inttypes.ServicePackageResourceQuota]) resourceModifyPlanInterceptor {
+	return &resourceQuotaPreflightInterceptor{
+		typeName: typeName,
+		quota:    quota,
+	}
+}
+
+type resourceQuotaPreflightInterceptor struct {
+	typeName string
+	quota    unique.Handle[inttypes.ServicePackageResourceQuota]
+}
+
+func (r resourceQuotaPreflightInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c, ok := opts.c.(quotaAWSClient)
+	if !ok {
+		return
+	}
+
+	config := c.QuotaPreflightConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// Only planned creates count against the quota.
+		if request.Plan.Raw.IsNull() || !request.State.Raw.IsNull() {
+			return
+		}
+
+		v := r.quota.Value()
+		summary, detail, exceeded := interceptors.QuotaExceeded(ctx, c, r.typeName, v.ServiceCode, v.QuotaCode)
+		if !exceeded {
+			return
+		}
+
+		switch config.Severity {
+		case "warning":
+			response.Diagnostics.AddWarning(summary, detail)
+		default:
+			response.Diagnostics.AddError(summary, detail)
+		}
+	}
+}
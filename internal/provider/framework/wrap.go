@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
@@ -55,6 +56,8 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 
 	var interceptors interceptorInvocations
 
+	interceptors = append(interceptors, dataSourceAccessDenied())
+
 	if isRegionOverrideEnabled {
 		v := spec.Region.Value()
 
@@ -155,6 +158,8 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return
 	}
 
+	ctx = apicall.NewScopeContext(ctx, apicall.Scope{TypeName: w.spec.TypeName, DataSource: true, Handler: apicall.HandlerRead})
+
 	interceptedHandler(w.interceptors.dataSourceRead(), w.inner.Read, dataSourceReadHasError, w.meta)(ctx, request, response)
 }
 
@@ -538,6 +543,9 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 
 	var interceptors interceptorInvocations
 
+	interceptors = append(interceptors, resourceAccessDenied())
+	interceptors = append(interceptors, resourceDeletionProtection(spec.TypeName))
+
 	if isRegionOverrideEnabled {
 		v := spec.Region.Value()
 
@@ -560,6 +568,10 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	if !tfunique.IsHandleNil(spec.Quota) {
+		interceptors = append(interceptors, resourceQuotaPreflight(spec.TypeName, spec.Quota))
+	}
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
@@ -671,6 +683,8 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
+	ctx = apicall.NewScopeContext(ctx, apicall.Scope{TypeName: w.spec.TypeName, Handler: apicall.HandlerCreate})
+
 	interceptedHandler(w.interceptors.resourceCreate(), w.inner.Create, resourceCreateHasError, w.meta)(ctx, request, response)
 }
 
@@ -681,6 +695,8 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return
 	}
 
+	ctx = apicall.NewScopeContext(ctx, apicall.Scope{TypeName: w.spec.TypeName, Handler: apicall.HandlerRead})
+
 	interceptedHandler(w.interceptors.resourceRead(), w.inner.Read, resourceReadHasError, w.meta)(ctx, request, response)
 }
 
@@ -691,6 +707,8 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return
 	}
 
+	ctx = apicall.NewScopeContext(ctx, apicall.Scope{TypeName: w.spec.TypeName, Handler: apicall.HandlerUpdate})
+
 	interceptedHandler(w.interceptors.resourceUpdate(), w.inner.Update, resourceUpdateHasError, w.meta)(ctx, request, response)
 }
 
@@ -701,6 +719,8 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return
 	}
 
+	ctx = apicall.NewScopeContext(ctx, apicall.Scope{TypeName: w.spec.TypeName, Handler: apicall.HandlerDelete})
+
 	interceptedHandler(w.interceptors.resourceDelete(), w.inner.Delete, resourceDeleteHasError, w.meta)(ctx, request, response)
 }
 
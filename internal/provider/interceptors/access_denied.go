@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+type stsAWSClient interface {
+	STSClient(context.Context) *sts.Client
+}
+
+// AccessDeniedErrors returns the access denied errors returned by AWS API calls made while handling the current request
+// that were in turn returned by the handler, i.e. whose message appears in one of the handler's error messages.
+// Access denied errors that the handler tolerated (for example, an optional read of a resource's policy) are not returned.
+// Encoded authorization failure messages are decoded via sts:DecodeAuthorizationMessage if the caller is allowed to.
+func AccessDeniedErrors(ctx context.Context, c any, errorMessages []string) []*errs.AccessDeniedError {
+	failures, ok := apicall.FailuresFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	type key struct {
+		action, principal, resource string
+	}
+	var accessDeniedErrs []*errs.AccessDeniedError
+	seen := make(map[key]struct{})
+	for _, call := range failures.Calls() {
+		e, ok := errs.NewAccessDeniedError(call.Service, call.Operation, call.Err)
+		if !ok {
+			continue
+		}
+
+		if message := call.Err.Error(); !slices.ContainsFunc(errorMessages, func(v string) bool {
+			return strings.Contains(v, message)
+		}) {
+			continue
+		}
+
+		if e.Info.RequestID == "" {
+			e.Info.RequestID = call.RequestID
+		}
+
+		if e.EncodedMessage != "" {
+			if v, ok := c.(stsAWSClient); ok {
+				if err := e.Decode(ctx, decodeAuthorizationMessage(v.STSClient(ctx))); err != nil {
+					tflog.Debug(ctx, "decoding authorization failure message", map[string]any{
+						"error": err.Error(),
+					})
+				}
+			}
+		}
+
+		k := key{action: e.Action, principal: e.Principal, resource: e.Resource}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+
+		accessDeniedErrs = append(accessDeniedErrs, e)
+	}
+
+	return accessDeniedErrs
+}
+
+func decodeAuthorizationMessage(conn *sts.Client) errs.AuthorizationMessageDecoder {
+	return func(ctx context.Context, encodedMessage string) (string, error) {
+		input := sts.DecodeAuthorizationMessageInput{
+			EncodedMessage: aws.String(encodedMessage),
+		}
+		output, err := conn.DecodeAuthorizationMessage(ctx, &input)
+
+		if err != nil {
+			return "", err
+		}
+
+		return aws.ToString(output.DecodedMessage), nil
+	}
+}
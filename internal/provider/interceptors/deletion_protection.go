@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type deletionProtectionAWSClient interface {
+	DeletionProtectionConfig(context.Context) *conns.DeletionProtectionConfig
+}
+
+// DeletionProtected returns whether the provider's deletion_protection configuration refuses deletion of the specified resource.
+// If so, it returns the summary and detail of the error diagnostic to report.
+func DeletionProtected(ctx context.Context, c any, typeName, id, arn string, tags map[string]string) (string, string, bool) {
+	v, ok := c.(deletionProtectionAWSClient)
+	if !ok {
+		return "", "", false
+	}
+
+	reason, ok := v.DeletionProtectionConfig(ctx).Protects(typeName, arn, tags)
+	if !ok {
+		return "", "", false
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString("The provider's deletion_protection configuration prevents deleting this " + typeName)
+	switch {
+	case arn != "":
+		fmt.Fprintf(&sb, " (%s)", arn)
+	case id != "":
+		fmt.Fprintf(&sb, " (%s)", id)
+	}
+	fmt.Fprintf(&sb, ": %s.\n\n", reason)
+	sb.WriteString("To delete the resource, remove or narrow the matching entry in the provider's deletion_protection block. " +
+		"To stop managing the resource without deleting it, use a removed block with destroy = false or terraform state rm.")
+
+	return "Deletion Protected", sb.String(), true
+}
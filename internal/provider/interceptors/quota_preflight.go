@@ -0,0 +1,237 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package interceptors
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Usage metrics in the AWS/Usage namespace are published at 1-minute granularity
+	// but can lag by several minutes, so look back far enough to find the latest datapoint.
+	quotaUsageMetricLookback = 1 * time.Hour
+	quotaUsageMetricPeriod   = 5 * time.Minute
+)
+
+// findQuotaUsage returns the value and current usage of the specified service quota.
+// The quota's value is the applied value if one has been set, otherwise the AWS default value.
+// Usage is read from the quota's CloudWatch usage metric.
+// A NotFoundError is returned if the quota does not exist or has no usage metric.
+func findQuotaUsage(ctx context.Context, conn *servicequotas.Client, cwConn *cloudwatch.Client, serviceCode, quotaCode string) (*conns.QuotaUsage, error) {
+	// A Service Quota will always have a default value, but will only have a current value if it has been set.
+	quota, err := findDefaultServiceQuota(ctx, conn, serviceCode, quotaCode)
+	if err != nil {
+		return nil, err
+	}
+
+	usageMetric := quota.UsageMetric
+	value := aws.ToFloat64(quota.Value)
+
+	serviceQuota, err := findServiceQuota(ctx, conn, serviceCode, quotaCode)
+
+	switch {
+	case retry.NotFound(err):
+	case err != nil:
+		return nil, err
+	default:
+		value = aws.ToFloat64(serviceQuota.Value)
+		if serviceQuota.UsageMetric != nil {
+			usageMetric = serviceQuota.UsageMetric
+		}
+	}
+
+	if usageMetric == nil || aws.ToString(usageMetric.MetricNamespace) == "" || aws.ToString(usageMetric.MetricName) == "" {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("Service Quota (%s/%s) has no usage metric", serviceCode, quotaCode),
+		}
+	}
+
+	usage, err := findQuotaUsageMetricValue(ctx, cwConn, usageMetric)
+	if err != nil {
+		return nil, fmt.Errorf("reading Service Quota (%s/%s) usage metric: %w", serviceCode, quotaCode, err)
+	}
+
+	return &conns.QuotaUsage{
+		QuotaName: aws.ToString(quota.QuotaName),
+		Usage:     usage,
+		Value:     value,
+	}, nil
+}
+
+func findDefaultServiceQuota(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string) (*awstypes.ServiceQuota, error) {
+	input := servicequotas.GetAWSDefaultServiceQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	}
+	output, err := conn.GetAWSDefaultServiceQuota(ctx, &input)
+
+	if errs.IsA[*awstypes.NoSuchResourceException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Quota == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.Quota, nil
+}
+
+func findServiceQuota(ctx context.Context, conn *servicequotas.Client, serviceCode, quotaCode string) (*awstypes.ServiceQuota, error) {
+	input := servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	}
+	output, err := conn.GetServiceQuota(ctx, &input)
+
+	if errs.IsA[*awstypes.NoSuchResourceException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Quota == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	if apiObject := output.Quota.ErrorReason; apiObject != nil {
+		return nil, fmt.Errorf("%s: %s", apiObject.ErrorCode, aws.ToString(apiObject.ErrorMessage))
+	}
+
+	if output.Quota.Value == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.Quota, nil
+}
+
+// findQuotaUsageMetricValue returns the most recent value of a quota's usage metric.
+// Usage metrics are only published while there is usage, so no datapoints means no usage.
+func findQuotaUsageMetricValue(ctx context.Context, conn *cloudwatch.Client, usageMetric *awstypes.MetricInfo) (float64, error) {
+	var dimensions []cwtypes.Dimension
+	for k, v := range usageMetric.MetricDimensions {
+		dimensions = append(dimensions, cwtypes.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	stat := aws.ToString(usageMetric.MetricStatisticRecommendation)
+	if stat == "" {
+		stat = string(cwtypes.StatisticMaximum)
+	}
+
+	now := time.Now()
+	input := cloudwatch.GetMetricDataInput{
+		EndTime: aws.Time(now),
+		MetricDataQueries: []cwtypes.MetricDataQuery{
+			{
+				Id: aws.String("usage"),
+				MetricStat: &cwtypes.MetricStat{
+					Metric: &cwtypes.Metric{
+						Dimensions: dimensions,
+						MetricName: usageMetric.MetricName,
+						Namespace:  usageMetric.MetricNamespace,
+					},
+					Period: aws.Int32(int32(quotaUsageMetricPeriod.Seconds())),
+					Stat:   aws.String(stat),
+				},
+			},
+		},
+		ScanBy:    cwtypes.ScanByTimestampDescending,
+		StartTime: aws.Time(now.Add(-quotaUsageMetricLookback)),
+	}
+	output, err := conn.GetMetricData(ctx, &input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if output == nil {
+		return 0, tfresource.NewEmptyResultError()
+	}
+
+	for _, result := range output.MetricDataResults {
+		if len(result.Values) > 0 {
+			// Results are ordered by timestamp descending.
+			return result.Values[0], nil
+		}
+	}
+
+	return 0, nil
+}
+
+type quotaPreflightAWSClient interface {
+	CloudWatchClient(context.Context) *cloudwatch.Client
+	QuotaPreflight(ctx context.Context, serviceCode, quotaCode string, find func(context.Context) (*conns.QuotaUsage, error)) (*conns.QuotaUsage, int, error)
+	Region(context.Context) string
+	ServiceQuotasClient(context.Context) *servicequotas.Client
+}
+
+// QuotaExceeded checks whether creating a resource of the specified type, together with the other creates
+// already planned against the same service quota, would exceed the quota.
+// If so, it returns the summary and detail of the diagnostic to report.
+// The quota's value and usage are read once per plan.
+// Failures to read the quota's value or usage are logged and do not fail the plan.
+func QuotaExceeded(ctx context.Context, c any, typeName, serviceCode, quotaCode string) (string, string, bool) {
+	v, ok := c.(quotaPreflightAWSClient)
+	if !ok {
+		return "", "", false
+	}
+
+	usage, planned, err := v.QuotaPreflight(ctx, serviceCode, quotaCode, func(ctx context.Context) (*conns.QuotaUsage, error) {
+		return findQuotaUsage(ctx, v.ServiceQuotasClient(ctx), v.CloudWatchClient(ctx), serviceCode, quotaCode)
+	})
+
+	switch {
+	case retry.NotFound(err):
+		tflog.Debug(ctx, "Service Quota usage not available, skipping quota pre-flight check", map[string]any{
+			"error":        err.Error(),
+			"quota_code":   quotaCode,
+			"service_code": serviceCode,
+		})
+		return "", "", false
+	case err != nil:
+		tflog.Warn(ctx, "Reading Service Quota usage failed, skipping quota pre-flight check", map[string]any{
+			"error":        err.Error(),
+			"quota_code":   quotaCode,
+			"service_code": serviceCode,
+		})
+		return "", "", false
+	}
+
+	if !usage.Exceeded(planned) {
+		return "", "", false
+	}
+
+	summary := "Service Quota Exceeded"
+	detail := fmt.Sprintf("Creating this %s would exceed the %q service quota (%s/%s) in %s: %g of %g are in use and %d creates are planned against it.\n\n"+
+		"Request a quota increase, for example with the aws_servicequotas_service_quota resource, before applying. "+
+		"Usage is read from CloudWatch usage metrics, which can lag behind recent changes.",
+		typeName, usage.QuotaName, serviceCode, quotaCode, v.Region(ctx), usage.Usage, usage.Value, planned)
+
+	return summary, detail, true
+}
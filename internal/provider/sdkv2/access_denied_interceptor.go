@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+)
+
+// accessDeniedDiagnostics adds a diagnostic explaining each access denied error returned by a failed CRUD handler.
+func accessDeniedDiagnostics() crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch when := opts.when; when {
+		case OnError:
+			var errorMessages []string
+			for _, d := range opts.diags {
+				if d.Severity == diag.Error {
+					errorMessages = append(errorMessages, d.Summary+"\n"+d.Detail)
+				}
+			}
+
+			for _, e := range interceptors.AccessDeniedErrors(ctx, opts.c, errorMessages) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  e.Summary(),
+					Detail:   e.Detail(),
+				})
+			}
+		}
+
+		return diags
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// deletionProtection refuses deletion of resources matched by the provider's deletion_protection configuration.
+func deletionProtection(typeName string) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Delete:
+				// Attributes not defined in the resource's schema read as nil.
+				arn, _ := d.Get(names.AttrARN).(string)
+				var tags map[string]string
+				if v, ok := d.Get(names.AttrTagsAll).(map[string]any); ok && len(v) > 0 {
+					tags = flex.ExpandStringValueMap(v)
+				} else if v, ok := d.Get(names.AttrTags).(map[string]any); ok {
+					tags = flex.ExpandStringValueMap(v)
+				}
+
+				if summary, detail, ok := interceptors.DeletionProtected(ctx, opts.c, typeName, d.Id(), arn, tags); ok {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  summary,
+						Detail:   detail,
+					})
+				}
+			}
+		}
+
+		return diags
+	})
+}
@@ -38,10 +38,11 @@ type schemaResourceData interface {
 }
 
 type interceptorOptions[D any] struct {
-	c    awsClient
-	d    D
-	when when
-	why  why
+	c     awsClient
+	d     D
+	diags diag.Diagnostics // Diagnostics returned by the CRUD handler, for After and OnError interceptors
+	when  when
+	why   why
 }
 
 type (
@@ -177,6 +178,7 @@ func interceptedCRUDHandler[F ~func(context.Context, *schema.ResourceData, any)
 
 		d := f(ctx, rd, meta)
 		diags = append(diags, d...)
+		opts.diags = d
 
 		// All other interceptors are run last to first.
 		if d.HasError() {
@@ -95,6 +95,37 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						},
 					},
 				},
+				"deletion_protection": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: "Configuration block with settings to refuse deletion of matching resources managed by this provider instance.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"arns": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+								Description: "ARN patterns of resources to protect from deletion. " +
+									"`*` matches any sequence of characters and `?` matches any single character.",
+							},
+							"resource_types": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+								Description: "Resource type patterns, e.g. `aws_kms_key` or `aws_route53_*`, of resources to protect from deletion. " +
+									"`*` matches any sequence of characters and `?` matches any single character.",
+							},
+							"tags": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+								Description: "Resource tags of resources to protect from deletion. " +
+									"A resource is protected if it has all of the tags. A tag value of `*` matches any value.",
+							},
+						},
+					},
+				},
 				"ec2_metadata_service_endpoint": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -175,6 +206,16 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "The profile for API operations. If not set, the default profile\n" +
 						"created with `aws configure` will be used.",
 				},
+				"quota_preflight_check": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The severity with which to report planned resource creations that would exceed a service quota. ` +
+						`Applies to resource types that declare the service quota they count against. ` +
+						`Valid values are "error", "warning", and "disabled". ` +
+						`When unset or "disabled", service quotas will not be checked at plan time. ` +
+						`With "warning", warnings for resources implemented with the Terraform Plugin SDK are only written to the provider log. ` +
+						`Can also be configured with the ` + conns.QuotaPreflightCheckEnvVar + ` environment variable.`,
+				},
 				"region": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -480,6 +521,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.NoProxy = v
 	}
 
+	if v, ok := d.GetOk("deletion_protection"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.DeletionProtectionConfig = expandDeletionProtection(v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]any)[0].(map[string]any))
 	} else {
@@ -493,6 +538,13 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	quotaCfg, dg := expandQuotaPreflightConfig(cty.GetAttrPath("quota_preflight_check"), d.Get("quota_preflight_check").(string))
+	diags = append(diags, dg...)
+	if dg.HasError() {
+		return nil, diags
+	}
+	config.QuotaPreflightConfig = quotaCfg
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -561,6 +613,12 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			var interceptors interceptorInvocations
 
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        OnError,
+				why:         Read,
+				interceptor: accessDeniedDiagnostics(),
+			})
+
 			if isRegionOverrideEnabled {
 				v := v.Region.Value()
 				s := r.SchemaMap()
@@ -654,6 +712,17 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 
 			var interceptors interceptorInvocations
 
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        OnError,
+				why:         AllCRUDOps,
+				interceptor: accessDeniedDiagnostics(),
+			})
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         Delete,
+				interceptor: deletionProtection(typeName),
+			})
+
 			if isRegionOverrideEnabled {
 				v := resource.Region.Value()
 				s := r.SchemaMap()
@@ -728,6 +797,14 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			if !tfunique.IsHandleNil(resource.Quota) {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: quotaPreflight(typeName, resource.Quota),
+				})
+			}
+
 			if len(resource.Identity.Attributes) > 0 {
 				r.Identity = newResourceIdentity(resource.Identity)
 
@@ -1081,6 +1158,30 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.Defaul
 	return nil
 }
 
+func expandDeletionProtection(tfMap map[string]any) *conns.DeletionProtectionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &conns.DeletionProtectionConfig{}
+
+	if v, ok := tfMap["arns"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ARNs = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceTypes = flex.ExpandStringValueSet(v)
+	}
+	if v, ok := tfMap["tags"].(map[string]any); ok && len(v) > 0 {
+		apiObject.Tags = flex.ExpandStringValueMap(v)
+	}
+
+	if len(apiObject.ARNs) == 0 && len(apiObject.ResourceTypes) == 0 && len(apiObject.Tags) == 0 {
+		return nil
+	}
+
+	return apiObject
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]any) *tftags.IgnoreConfig {
 	var keys, keyPrefixes []any
 
@@ -1133,15 +1234,27 @@ func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyCon
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
 	case severity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: severity}, validateTagPolicySeverity(path, severity)
+		return &tftags.TagPolicyConfig{Severity: severity}, validateSeverity(path, severity)
 	case envSeverity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateTagPolicySeverityEnvVar(envSeverity)
+		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateSeverityEnvVar(tftags.TagPolicyComplianceEnvVar, envSeverity)
+	}
+
+	return nil, nil
+}
+
+func expandQuotaPreflightConfig(path cty.Path, severity string) (*conns.QuotaPreflightConfig, diag.Diagnostics) {
+	envSeverity := os.Getenv(conns.QuotaPreflightCheckEnvVar)
+	switch {
+	case severity != "" && severity != "disabled":
+		return &conns.QuotaPreflightConfig{Severity: severity}, validateSeverity(path, severity)
+	case envSeverity != "" && envSeverity != "disabled" && severity != "disabled":
+		return &conns.QuotaPreflightConfig{Severity: envSeverity}, validateSeverityEnvVar(conns.QuotaPreflightCheckEnvVar, envSeverity)
 	}
 
 	return nil, nil
 }
 
-func validateTagPolicySeverity(path cty.Path, s string) diag.Diagnostics {
+func validateSeverity(path cty.Path, s string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch s {
 	case "error", "warning", "disabled":
@@ -1154,7 +1267,7 @@ const (
 	summaryInvalidEnvironmentVariableValue = "Invalid environment variable value"
 )
 
-func validateTagPolicySeverityEnvVar(s string) diag.Diagnostics {
+func validateSeverityEnvVar(envVar, s string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch s {
 	case "error", "warning", "disabled":
@@ -1162,6 +1275,6 @@ func validateTagPolicySeverityEnvVar(s string) diag.Diagnostics {
 	}
 	return append(diags, errs.NewErrorDiagnostic(
 		summaryInvalidEnvironmentVariableValue,
-		fmt.Sprintf(`%s must be one of "error", "warning", or "disabled"`, tftags.TagPolicyComplianceEnvVar),
+		fmt.Sprintf(`%s must be one of "error", "warning", or "disabled"`, envVar),
 	))
 }
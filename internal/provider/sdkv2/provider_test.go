@@ -440,6 +440,141 @@ func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		tfMap          map[string]any
+		expectedConfig *conns.DeletionProtectionConfig
+	}{
+		"nil": {
+			tfMap:          nil,
+			expectedConfig: nil,
+		},
+		"empty": {
+			tfMap: map[string]any{
+				"arns":           schema.NewSet(schema.HashString, []any{}),
+				"resource_types": schema.NewSet(schema.HashString, []any{}),
+				"tags":           map[string]any{},
+			},
+			expectedConfig: nil,
+		},
+		"all": {
+			tfMap: map[string]any{
+				"arns":           schema.NewSet(schema.HashString, []any{"arn:aws:s3:::prod-*"}),
+				"resource_types": schema.NewSet(schema.HashString, []any{"aws_kms_key"}),
+				"tags": map[string]any{
+					"Environment": "production",
+				},
+			},
+			expectedConfig: &conns.DeletionProtectionConfig{
+				ARNs:          []string{"arn:aws:s3:::prod-*"},
+				ResourceTypes: []string{"aws_kms_key"},
+				Tags: map[string]string{
+					"Environment": "production",
+				},
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			results := expandDeletionProtection(testcase.tfMap)
+
+			if diff := cmp.Diff(results, testcase.expectedConfig); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandQuotaPreflightConfig(t *testing.T) { //nolint:paralleltest
+	testcases := map[string]struct {
+		severity       string
+		envvars        map[string]string
+		expectedConfig *conns.QuotaPreflightConfig
+		expectError    bool
+	}{
+		"unset": {
+			envvars:        map[string]string{},
+			expectedConfig: nil,
+		},
+		"config": {
+			severity:       "warning",
+			envvars:        map[string]string{},
+			expectedConfig: &conns.QuotaPreflightConfig{Severity: "warning"},
+		},
+		"config disabled": {
+			severity:       "disabled",
+			envvars:        map[string]string{},
+			expectedConfig: nil,
+		},
+		"config invalid": {
+			severity:    "fatal",
+			envvars:     map[string]string{},
+			expectError: true,
+		},
+		"envvar": {
+			envvars: map[string]string{
+				conns.QuotaPreflightCheckEnvVar: "error",
+			},
+			expectedConfig: &conns.QuotaPreflightConfig{Severity: "error"},
+		},
+		"envvar disabled": {
+			envvars: map[string]string{
+				conns.QuotaPreflightCheckEnvVar: "disabled",
+			},
+			expectedConfig: nil,
+		},
+		"envvar invalid": {
+			envvars: map[string]string{
+				conns.QuotaPreflightCheckEnvVar: "fatal",
+			},
+			expectError: true,
+		},
+		"config overrides envvar": {
+			severity: "warning",
+			envvars: map[string]string{
+				conns.QuotaPreflightCheckEnvVar: "error",
+			},
+			expectedConfig: &conns.QuotaPreflightConfig{Severity: "warning"},
+		},
+		"config disabled overrides envvar": {
+			severity: "disabled",
+			envvars: map[string]string{
+				conns.QuotaPreflightCheckEnvVar: "error",
+			},
+			expectedConfig: nil,
+		},
+	}
+
+	for name, testcase := range testcases { //nolint:paralleltest
+		t.Run(name, func(t *testing.T) {
+			oldEnv := stashEnv()
+			defer popEnv(oldEnv)
+
+			for k, v := range testcase.envvars {
+				os.Setenv(k, v) //nolint:usetesting // stashEnv & popEnv require os.Setenv
+			}
+
+			results, diags := expandQuotaPreflightConfig(cty.GetAttrPath("quota_preflight_check"), testcase.severity)
+
+			if got, want := diags.HasError(), testcase.expectError; got != want {
+				t.Fatalf("expected error %t, got diagnostics %v", want, diags)
+			}
+			if testcase.expectError {
+				return
+			}
+
+			if diff := cmp.Diff(results, testcase.expectedConfig); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestExpandAssumeRoleWithWebIdentity(t *testing.T) { //nolint:paralleltest
 	ctx := t.Context()
 	testcases := map[string]struct {
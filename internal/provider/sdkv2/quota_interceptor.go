@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+	"unique"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/interceptors"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+type quotaAWSClient interface {
+	QuotaPreflightConfig(context.Context) *conns.QuotaPreflightConfig
+}
+
+// quotaPreflight checks at plan time whether creating a resource would exceed the service quota it counts against.
+func quotaPreflight(typeName string, quota unique.Handle[inttypes.ServicePackageResourceQuota]) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c, ok := opts.c.(quotaAWSClient)
+		if !ok {
+			return nil
+		}
+
+		config := c.QuotaPreflightConfig(ctx)
+		if config == nil {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				if !d.GetRawState().IsNull() {
+					return nil
+				}
+
+				v := quota.Value()
+				summary, detail, exceeded := interceptors.QuotaExceeded(ctx, c, typeName, v.ServiceCode, v.QuotaCode)
+				if !exceeded {
+					return nil
+				}
+
+				// CustomizeDiff does not support diagnostics (only an error return)
+				switch config.Severity {
+				case "warning":
+					// Warning diagnostics are only logged, they do not appear in the plan
+					tflog.Warn(ctx, "Service Quota Pre-flight Check", map[string]any{
+						"summary": summary,
+						"detail":  detail,
+					})
+				default:
+					// Error diagnostics merge summary and detail into a single message
+					return fmt.Errorf("%s - %s", summary, detail)
+				}
+			}
+		}
+
+		return nil
+	})
+}
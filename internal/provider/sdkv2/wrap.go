@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns/apicall"
 )
 
 // Implemented by (schema.ResourceData|schema.ResourceDiff).GetOk().
@@ -18,6 +19,18 @@ type getProviderMetaFunc func(any) error
 // contextFunc augments Context.
 type contextFunc func(context.Context, getAttributeFunc, getProviderMetaFunc, any) (context.Context, error)
 
+// scopedContext returns a contextFunc that runs f and then attaches the specified API call scope.
+func scopedContext(f contextFunc, scope apicall.Scope) contextFunc {
+	return func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
+		ctx, err := f(ctx, getAttribute, getProviderMeta, meta)
+		if err != nil {
+			return ctx, err
+		}
+
+		return apicall.NewScopeContext(ctx, scope), nil
+	}
+}
+
 type wrappedDataSourceOptions struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
@@ -41,7 +54,11 @@ func wrapDataSource(r *schema.Resource, opts wrappedDataSourceOptions) {
 }
 
 func (w *wrappedDataSource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return interceptedCRUDHandler(w.scopedContext(apicall.HandlerRead), w.opts.interceptors, f, Read)
+}
+
+func (w *wrappedDataSource) scopedContext(handler string) contextFunc {
+	return scopedContext(w.opts.bootstrapContext, apicall.Scope{TypeName: w.opts.typeName, DataSource: true, Handler: handler})
 }
 
 type wrappedResourceOptions struct {
@@ -75,19 +92,23 @@ func wrapResource(r *schema.Resource, opts wrappedResourceOptions) {
 }
 
 func (w *wrappedResource) create(f schema.CreateContextFunc) schema.CreateContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Create)
+	return interceptedCRUDHandler(w.scopedContext(apicall.HandlerCreate), w.opts.interceptors, f, Create)
 }
 
 func (w *wrappedResource) read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Read)
+	return interceptedCRUDHandler(w.scopedContext(apicall.HandlerRead), w.opts.interceptors, f, Read)
 }
 
 func (w *wrappedResource) update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Update)
+	return interceptedCRUDHandler(w.scopedContext(apicall.HandlerUpdate), w.opts.interceptors, f, Update)
 }
 
 func (w *wrappedResource) delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
-	return interceptedCRUDHandler(w.opts.bootstrapContext, w.opts.interceptors, f, Delete)
+	return interceptedCRUDHandler(w.scopedContext(apicall.HandlerDelete), w.opts.interceptors, f, Delete)
+}
+
+func (w *wrappedResource) scopedContext(handler string) contextFunc {
+	return scopedContext(w.opts.bootstrapContext, apicall.Scope{TypeName: w.opts.typeName, Handler: handler})
 }
 
 func (w *wrappedResource) import_(f schema.StateContextFunc) schema.StateContextFunc {
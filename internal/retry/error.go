@@ -10,6 +10,7 @@ import (
 	"time"
 
 	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 )
 
 //
@@ -86,6 +87,10 @@ func (e *NotFoundError) Unwrap() error {
 	return e.LastError
 }
 
+func (e *NotFoundError) DiagnosticCode() errs.Code {
+	return errs.CodeNotFound
+}
+
 // UnexpectedStateError is returned when Refresh returns a state that's neither in Target nor Pending.
 type UnexpectedStateError struct {
 	LastError     error
@@ -150,3 +155,7 @@ func (e *TimeoutError) Error() string {
 func (e *TimeoutError) Unwrap() error {
 	return e.LastError
 }
+
+func (e *TimeoutError) DiagnosticCode() errs.Code {
+	return errs.CodeWaitTimeout
+}
@@ -5,6 +5,7 @@ package retry
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
@@ -85,28 +86,67 @@ func (op opFunc[T]) If(predicate predicateFunc[T]) runFunc[T] {
 	return func(ctx context.Context, timeout time.Duration, opts ...backoff.Option) (T, error) {
 		// We explicitly don't set a deadline on the context here to maintain compatibility
 		// with the Plugin SDKv2 implementation. A parent context may have set a deadline.
-		var (
-			l   *backoff.Loop
-			t   T
-			err error
-		)
-		for l = backoff.NewLoopWithOptions(ctx, timeout, opts...); l.Continue(ctx); {
-			t, err = op(ctx)
-
-			var retry bool
-			if retry, err = predicate(t, err); !retry {
-				return t, err
+		r := WaitFor(func(ctx context.Context) (T, opState, error) {
+			t, err := op(ctx)
+
+			retry, err := predicate(t, err)
+			switch {
+			case retry && err != nil:
+				return t, opStateRetryable, &retryableOpError{err: err}
+			case retry:
+				return t, opStateRetryable, nil
+			case err != nil:
+				return t, "", err
+			default:
+				return t, opStateSuccess, nil
 			}
+		}).
+			Pending(opStateRetryable).
+			Target(opStateSuccess).
+			NotFoundWhen(func(T) bool { return false }).
+			RetryWhen(func(err error) (bool, error) {
+				if err, ok := errors.AsType[*retryableOpError](err); ok {
+					return true, err.err
+				}
+
+				return false, err
+			}).
+			Options(opts...).
+			run(ctx, timeout)
+
+		if r.done {
+			return r.value, r.err
 		}
 
-		if err == nil && l.Remaining() == 0 {
+		err := r.lastErr
+		if err == nil && r.timedOut {
 			err = &TimeoutError{
-				LastState:     "retryableerror",
+				LastState:     string(opStateRetryable),
 				Timeout:       timeout,
-				ExpectedState: []string{"success"},
+				ExpectedState: []string{string(opStateSuccess)},
 			}
 		}
 
-		return t, err
+		return r.value, err
 	}
 }
+
+type opState string
+
+const (
+	opStateRetryable opState = "retryableerror"
+	opStateSuccess   opState = "success"
+)
+
+// retryableOpError wraps an error that the operation's predicate has decided is retryable.
+type retryableOpError struct {
+	err error
+}
+
+func (e *retryableOpError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableOpError) Unwrap() error {
+	return e.err
+}
@@ -5,12 +5,9 @@ package retry
 
 import (
 	"context"
-	"errors"
-	"slices"
 	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
-	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
@@ -73,107 +70,21 @@ func (conf *StateChangeConfOf[T, S]) WaitForStateContext(ctx context.Context) (T
 		conf.ContinuousTargetOccurence = 1
 	}
 
-	// Set a default Delay using the StateChangeConf values
-	delay := backoff.SDKv2HelperRetryCompatibleDelay(ctx, conf.Delay, conf.PollInterval, conf.MinTimeout)
+	w := WaitFor(conf.Refresh).
+		Pending(conf.Pending...).
+		Target(conf.Target...).
+		NotFoundChecks(conf.NotFoundChecks).
+		ContinuousTargetOccurence(conf.ContinuousTargetOccurence).
+		// Set a default Delay using the StateChangeConf values
+		Delay(backoff.SDKv2HelperRetryCompatibleDelay(ctx, conf.Delay, conf.PollInterval, conf.MinTimeout))
+	// Set a deadline on the Refresh context to maintain compatibility with the Plugin SDKv2 implementation.
+	w.refreshWithDeadline = true
 
-	var (
-		t                             T
-		currentState, priorState      S
-		err                           error
-		notFoundTick, targetOccurence int
-		l                             *backoff.Loop
-	)
-	for l = backoff.NewLoopWithOptions(ctx, conf.Timeout, backoff.WithDelay(delay)); l.Continue(ctx); {
-		t, currentState, err = conf.refreshWithTimeout(ctx, l.Remaining())
+	t, err := w.Wait(ctx, conf.Timeout)
 
-		if errors.Is(err, context.DeadlineExceeded) {
-			currentState = priorState
-			break
-		}
-
-		if err != nil {
-			return t, err
-		}
-
-		// Save prior state in case next time round the loop the deadline's exceeded.
-		priorState = currentState
-
-		if inttypes.IsZero(t) {
-			// If we're waiting for the absence of a thing, then return.
-			if len(conf.Target) == 0 {
-				targetOccurence++
-				if conf.ContinuousTargetOccurence == targetOccurence {
-					return t, err
-				}
-
-				// https://github.com/hashicorp/terraform-provider-aws/issues/48682.
-				// Backwards compatibility with SDKv2 helper/retry.
-				if v, ok := delay.(backoff.DelayWithSetIncrementDelay); ok {
-					v.SetIncrementDelay(false)
-				}
-
-				continue
-			}
-
-			// If we didn't find the resource, check if we have been
-			// not finding it for a while, and if so, report an error.
-			notFoundTick++
-			if notFoundTick > conf.NotFoundChecks {
-				return t, &NotFoundError{
-					LastError: err,
-					Retries:   notFoundTick,
-				}
-			}
-		} else {
-			// Reset the counter for when a resource isn't found.
-			notFoundTick = 0
-			found := false
-
-			if slices.Contains(conf.Target, currentState) {
-				found = true
-				targetOccurence++
-				if conf.ContinuousTargetOccurence == targetOccurence {
-					return t, err
-				}
-			}
-
-			if slices.Contains(conf.Pending, currentState) {
-				found = true
-				targetOccurence = 0
-			}
-
-			if !found && len(conf.Pending) > 0 {
-				return t, &UnexpectedStateError{
-					LastError:     err,
-					State:         string(currentState),
-					ExpectedState: tfslices.Strings(conf.Target),
-				}
-			}
-
-			// Wait between refreshes using exponential backoff, except when
-			// waiting for the target state to reoccur.
-			if v, ok := delay.(backoff.DelayWithSetIncrementDelay); ok {
-				v.SetIncrementDelay(targetOccurence == 0)
-			}
-		}
+	if TimedOut(err) {
+		return inttypes.Zero[T](), err
 	}
 
-	// Timed out or Context canceled.
-	if l.Remaining() == 0 {
-		return inttypes.Zero[T](), &TimeoutError{
-			LastError:     err,
-			LastState:     string(currentState),
-			Timeout:       conf.Timeout,
-			ExpectedState: tfslices.Strings(conf.Target),
-		}
-	}
-
-	return t, context.Cause(ctx)
-}
-
-func (conf *StateChangeConfOf[T, S]) refreshWithTimeout(ctx context.Context, timeout time.Duration) (T, S, error) {
-	// Set a deadline on the context here to maintain compatibility with the Plugin SDKv2 implementation.
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
-	return conf.Refresh(ctx)
+	return t, err
 }
@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// Waiter waits for the item watched by a StateRefreshFuncOf to reach a target state.
+// It is configured by chaining methods on the value returned by WaitFor, e.g.
+//
+//	output, err := retry.WaitFor(statusThing(conn, id)).
+//		Pending(awstypes.ThingStatusCreating).
+//		Target(awstypes.ThingStatusActive).
+//		Failure(awstypes.ThingStatusFailed).
+//		Wait(ctx, timeout)
+//
+// StateChangeConfOf, Op and the actionwait package are implemented using Waiter.
+type Waiter[T any, S ~string] struct {
+	clock                     backoff.Clock
+	continuousTargetOccurence int
+	delay                     backoff.Delay
+	failure                   []S
+	gracePeriod               *time.Duration
+	notFound                  func(T) bool
+	notFoundChecks            int
+	options                   []backoff.Option
+	pending                   []S
+	progress                  func(context.Context, Progress[T, S])
+	progressInterval          time.Duration
+	refresh                   StateRefreshFuncOf[T, S]
+	refreshWithDeadline       bool
+	retryable                 []func(error) (bool, error)
+	target                    []S
+}
+
+// Progress describes the state of a wait.
+type Progress[T any, S ~string] struct {
+	Value      T             // Latest result of the Refresh function
+	State      S             // Latest state
+	Attempt    uint          // Zero-based number of the latest attempt
+	Elapsed    time.Duration // Time since the wait started
+	Remaining  time.Duration // Time until the wait times out
+	Deadline   time.Time     // Time at which the wait times out
+	NextPollIn time.Duration // Time until the next attempt
+}
+
+// WaitFor returns a new Waiter for the item watched by the specified function.
+func WaitFor[T any, S ~string](refresh StateRefreshFuncOf[T, S]) *Waiter[T, S] {
+	return &Waiter[T, S]{
+		refresh: refresh,
+	}
+}
+
+// Pending sets the states that are "allowed" and will continue trying.
+// If no pending states are set, any state other than a target or failure state continues trying.
+func (w *Waiter[T, S]) Pending(states ...S) *Waiter[T, S] {
+	w.pending = states
+	return w
+}
+
+// Target sets the target states.
+// If no target states are set, the wait is for the absence of the item.
+func (w *Waiter[T, S]) Target(states ...S) *Waiter[T, S] {
+	w.target = states
+	return w
+}
+
+// Failure sets the states that immediately end the wait with an UnexpectedStateError.
+func (w *Waiter[T, S]) Failure(states ...S) *Waiter[T, S] {
+	w.failure = states
+	return w
+}
+
+// ContinuousTargetOccurence sets the number of times a target state has to occur continuously (default 1).
+func (w *Waiter[T, S]) ContinuousTargetOccurence(n int) *Waiter[T, S] {
+	w.continuousTargetOccurence = n
+	return w
+}
+
+// NotFoundChecks sets the number of times to allow the item to be not found before returning a NotFoundError (default 20).
+func (w *Waiter[T, S]) NotFoundChecks(n int) *Waiter[T, S] {
+	w.notFoundChecks = n
+	return w
+}
+
+// NotFoundWhen sets the function used to determine whether a result of the Refresh function represents a not found item.
+// By default a zero value result represents a not found item.
+func (w *Waiter[T, S]) NotFoundWhen(f func(T) bool) *Waiter[T, S] {
+	w.notFound = f
+	return w
+}
+
+// RetryWhen adds a predicate used to decide whether an error returned by the Refresh function is retryable.
+// If the error is retryable, the predicate returns true and the error to report if the wait times out.
+// If the error is not retryable, the predicate returns false and either no error (success) or the error ending the wait.
+// Predicates are tried in the order they are added and by default any error ends the wait.
+func (w *Waiter[T, S]) RetryWhen(f func(error) (bool, error)) *Waiter[T, S] {
+	w.retryable = append(w.retryable, f)
+	return w
+}
+
+// Delay sets the delay between attempts.
+// By default a Terraform Plugin SDK v2 helper/retry-compatible delay is used.
+func (w *Waiter[T, S]) Delay(delay backoff.Delay) *Waiter[T, S] {
+	w.delay = delay
+	return w
+}
+
+// GracePeriod sets the period after the timeout expires in which one more attempt is allowed (default 30s).
+func (w *Waiter[T, S]) GracePeriod(d time.Duration) *Waiter[T, S] {
+	w.gracePeriod = &d
+	return w
+}
+
+// Clock sets the clock used to track time, e.g. a backoff.FakeClock in unit tests.
+func (w *Waiter[T, S]) Clock(clock backoff.Clock) *Waiter[T, S] {
+	w.clock = clock
+	return w
+}
+
+// Progress sets a function called with the state of the wait after each attempt that does not end the wait,
+// no more often than every interval.
+func (w *Waiter[T, S]) Progress(interval time.Duration, f func(context.Context, Progress[T, S])) *Waiter[T, S] {
+	w.progress = f
+	w.progressInterval = interval
+	return w
+}
+
+// Options adds loop options.
+func (w *Waiter[T, S]) Options(opts ...backoff.Option) *Waiter[T, S] {
+	w.options = append(w.options, opts...)
+	return w
+}
+
+// Wait waits for the item to reach a target state.
+//
+// If the Refresh function returns an error that is not retryable, return immediately with that error.
+//
+// If the Refresh function returns a failure state, or a state other than a target state or one
+// listed in Pending, return immediately with an error.
+//
+// If the timeout is exceeded before reaching a target state, return an error.
+//
+// Otherwise, the result is the result of the first call to the Refresh function to
+// reach a target state.
+//
+// Cancellation of the passed in context will cancel the refresh loop.
+func (w *Waiter[T, S]) Wait(ctx context.Context, timeout time.Duration) (T, error) {
+	r := w.run(ctx, timeout)
+
+	if r.done {
+		return r.value, r.err
+	}
+
+	if r.timedOut {
+		return r.value, &TimeoutError{
+			LastError:     r.lastErr,
+			LastState:     string(r.state),
+			Timeout:       timeout,
+			ExpectedState: tfslices.Strings(w.target),
+		}
+	}
+
+	return r.value, context.Cause(ctx)
+}
+
+// waitResult is the outcome of a wait.
+type waitResult[T any, S ~string] struct {
+	done     bool  // The wait ended before timing out or being canceled
+	err      error // The error ending the wait
+	lastErr  error // The last retryable error
+	state    S     // The last state
+	timedOut bool  // The wait timed out
+	value    T     // The last result of the Refresh function
+}
+
+func (w *Waiter[T, S]) run(ctx context.Context, timeout time.Duration) waitResult[T, S] {
+	continuousTargetOccurence := w.continuousTargetOccurence
+	if continuousTargetOccurence < 1 {
+		continuousTargetOccurence = 1
+	}
+	notFoundChecks := w.notFoundChecks
+	if notFoundChecks == 0 {
+		notFoundChecks = 20
+	}
+	notFound := w.notFound
+	if notFound == nil {
+		notFound = inttypes.IsZero[T]
+	}
+
+	var opts []backoff.Option
+	if w.clock != nil {
+		opts = append(opts, backoff.WithClock(w.clock))
+	}
+	if w.delay != nil {
+		opts = append(opts, backoff.WithDelay(w.delay))
+	}
+	if w.gracePeriod != nil {
+		opts = append(opts, backoff.WithGracePeriod(*w.gracePeriod))
+	}
+	opts = append(opts, w.options...)
+
+	var (
+		r                             waitResult[T, S]
+		priorState                    S
+		notFoundTick, targetOccurence int
+		lastProgress                  time.Time
+		l                             *backoff.Loop
+	)
+	for l = backoff.NewLoopWithOptions(ctx, timeout, opts...); l.Continue(ctx); {
+		t, currentState, err := w.refreshWithTimeout(ctx, l.Remaining())
+		r.value, r.state, r.lastErr = t, currentState, nil
+
+		if err != nil {
+			if w.refreshWithDeadline && errors.Is(err, context.DeadlineExceeded) {
+				r.state, r.lastErr = priorState, err
+				break
+			}
+
+			var retry bool
+			if retry, err = w.isRetryable(err); !retry {
+				r.done, r.err = true, err
+				return r
+			}
+
+			r.state, r.lastErr = priorState, err
+			targetOccurence = 0
+
+			continue
+		}
+
+		// Save prior state in case next time round the loop the deadline's exceeded.
+		priorState = currentState
+
+		if notFound(t) {
+			// If we're waiting for the absence of a thing, then return.
+			if len(w.target) == 0 {
+				targetOccurence++
+				if continuousTargetOccurence == targetOccurence {
+					r.done = true
+					return r
+				}
+
+				// https://github.com/hashicorp/terraform-provider-aws/issues/48682.
+				// Backwards compatibility with SDKv2 helper/retry.
+				w.setIncrementDelay(false)
+
+				continue
+			}
+
+			// If we didn't find the resource, check if we have been
+			// not finding it for a while, and if so, report an error.
+			notFoundTick++
+			if notFoundTick > notFoundChecks {
+				r.done, r.err = true, &NotFoundError{
+					Retries: notFoundTick,
+				}
+				return r
+			}
+		} else {
+			// Reset the counter for when a resource isn't found.
+			notFoundTick = 0
+
+			if slices.Contains(w.failure, currentState) {
+				r.done, r.err = true, &UnexpectedStateError{
+					State:         string(currentState),
+					ExpectedState: tfslices.Strings(w.target),
+				}
+				return r
+			}
+
+			found := false
+
+			if slices.Contains(w.target, currentState) {
+				found = true
+				targetOccurence++
+				if continuousTargetOccurence == targetOccurence {
+					r.done = true
+					return r
+				}
+			}
+
+			if slices.Contains(w.pending, currentState) {
+				found = true
+				targetOccurence = 0
+			}
+
+			if !found && len(w.pending) > 0 {
+				r.done, r.err = true, &UnexpectedStateError{
+					State:         string(currentState),
+					ExpectedState: tfslices.Strings(w.target),
+				}
+				return r
+			}
+
+			// Wait between refreshes using exponential backoff, except when
+			// waiting for the target state to reoccur.
+			w.setIncrementDelay(targetOccurence == 0)
+		}
+
+		if w.progress != nil && w.progressInterval > 0 {
+			if now := l.Now(); lastProgress.IsZero() || now.Sub(lastProgress) >= w.progressInterval {
+				w.progress(ctx, Progress[T, S]{
+					Value:      t,
+					State:      currentState,
+					Attempt:    l.Attempt() - 1,
+					Elapsed:    now.Sub(l.Deadline().Add(-timeout)),
+					Remaining:  l.Remaining(),
+					Deadline:   l.Deadline(),
+					NextPollIn: l.NextDelay(),
+				})
+				lastProgress = now
+			}
+		}
+	}
+
+	// Timed out or Context canceled.
+	r.timedOut = l.Remaining() == 0
+
+	return r
+}
+
+func (w *Waiter[T, S]) refreshWithTimeout(ctx context.Context, timeout time.Duration) (T, S, error) {
+	if !w.refreshWithDeadline {
+		return w.refresh(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return w.refresh(ctx)
+}
+
+func (w *Waiter[T, S]) isRetryable(err error) (bool, error) {
+	for _, f := range w.retryable {
+		var retry bool
+		if retry, err = f(err); retry {
+			return true, err
+		}
+
+		if err == nil {
+			break
+		}
+	}
+
+	return false, err
+}
+
+func (w *Waiter[T, S]) setIncrementDelay(incrementDelay bool) {
+	if v, ok := w.delay.(backoff.DelayWithSetIncrementDelay); ok {
+		v.SetIncrementDelay(incrementDelay)
+	}
+}
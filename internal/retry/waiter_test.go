@@ -0,0 +1,320 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+)
+
+type testState string
+
+// sequenceRefreshFunc returns a function that refreshes the states in sequence, repeating the last state.
+// An empty state represents a not found item.
+func sequenceRefreshFunc(states ...testState) (StateRefreshFuncOf[*string, testState], *int) {
+	var n int
+
+	return func(context.Context) (*string, testState, error) {
+		state := states[min(n, len(states)-1)]
+		n++
+
+		if state == "" {
+			return nil, "", nil
+		}
+
+		v := string(state)
+		return &v, state, nil
+	}, &n
+}
+
+func newTestClock() *backoff.FakeClock {
+	return backoff.NewFakeClock(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC))
+}
+
+func TestWaiter_success(t *testing.T) {
+	t.Parallel()
+
+	refresh, n := sequenceRefreshFunc("pending", "pending", "running")
+
+	got, err := WaitFor(refresh).
+		Pending("pending").
+		Target("running").
+		Clock(newTestClock()).
+		Wait(t.Context(), 10*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got == nil || *got != "running" {
+		t.Errorf("got %v, want running", got)
+	}
+	if *n != 3 {
+		t.Errorf("refreshed %d times, want 3", *n)
+	}
+}
+
+func TestWaiter_failure(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefreshFunc("pending", "failed")
+
+	_, err := WaitFor(refresh).
+		Pending("pending").
+		Target("running").
+		Failure("failed").
+		Clock(newTestClock()).
+		Wait(t.Context(), 10*time.Minute)
+
+	if err, ok := errors.AsType[*UnexpectedStateError](err); !ok || err.State != "failed" {
+		t.Errorf("got %v, want UnexpectedStateError for state failed", err)
+	}
+}
+
+func TestWaiter_unexpectedState(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefreshFunc("pending", "unknown")
+
+	_, err := WaitFor(refresh).
+		Pending("pending").
+		Target("running").
+		Clock(newTestClock()).
+		Wait(t.Context(), 10*time.Minute)
+
+	if err, ok := errors.AsType[*UnexpectedStateError](err); !ok || err.State != "unknown" {
+		t.Errorf("got %v, want UnexpectedStateError for state unknown", err)
+	}
+}
+
+func TestWaiter_continuousTargetOccurence(t *testing.T) {
+	t.Parallel()
+
+	refresh, n := sequenceRefreshFunc("running", "pending", "running", "running", "running")
+
+	_, err := WaitFor(refresh).
+		Pending("pending").
+		Target("running").
+		ContinuousTargetOccurence(3).
+		Clock(newTestClock()).
+		Wait(t.Context(), 10*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *n != 5 {
+		t.Errorf("refreshed %d times, want 5", *n)
+	}
+}
+
+func TestWaiter_notFoundChecks(t *testing.T) {
+	t.Parallel()
+
+	refresh, n := sequenceRefreshFunc("")
+
+	_, err := WaitFor(refresh).
+		Target("running").
+		NotFoundChecks(3).
+		Clock(newTestClock()).
+		Wait(t.Context(), 10*time.Minute)
+
+	if err, ok := errors.AsType[*NotFoundError](err); !ok || err.Retries != 4 {
+		t.Errorf("got %v, want NotFoundError after 4 retries", err)
+	}
+	if *n != 4 {
+		t.Errorf("refreshed %d times, want 4", *n)
+	}
+}
+
+func TestWaiter_absence(t *testing.T) {
+	t.Parallel()
+
+	refresh, n := sequenceRefreshFunc("deleting", "deleting", "")
+
+	got, err := WaitFor(refresh).
+		Pending("deleting").
+		Clock(newTestClock()).
+		Wait(t.Context(), 10*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != nil {
+		t.Errorf("got %v, want nil", got)
+	}
+	if *n != 3 {
+		t.Errorf("refreshed %d times, want 3", *n)
+	}
+}
+
+func TestWaiter_retryWhen(t *testing.T) {
+	t.Parallel()
+
+	errRetryable := errors.New("retryable")
+	errFatal := errors.New("fatal")
+
+	testCases := map[string]struct {
+		errs    []error
+		wantErr error
+	}{
+		"retryable then success": {
+			errs: []error{errRetryable, errRetryable, nil},
+		},
+		"retryable then fatal": {
+			errs:    []error{errRetryable, errFatal},
+			wantErr: errFatal,
+		},
+		"always retryable": {
+			errs:    []error{errRetryable},
+			wantErr: errRetryable,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var n int
+			refresh := func(context.Context) (*string, testState, error) {
+				err := testCase.errs[min(n, len(testCase.errs)-1)]
+				n++
+
+				if err != nil {
+					return nil, "", err
+				}
+
+				v := "running"
+				return &v, "running", nil
+			}
+
+			_, err := WaitFor(refresh).
+				Target("running").
+				RetryWhen(func(err error) (bool, error) {
+					return errors.Is(err, errRetryable), err
+				}).
+				Clock(newTestClock()).
+				Wait(t.Context(), 10*time.Minute)
+
+			if !errors.Is(err, testCase.wantErr) {
+				t.Errorf("got %v, want %v", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaiter_timeout(t *testing.T) {
+	t.Parallel()
+
+	clock := newTestClock()
+	start := clock.Now()
+	refresh, n := sequenceRefreshFunc("pending")
+
+	got, err := WaitFor(refresh).
+		Pending("pending").
+		Target("running").
+		Delay(backoff.FixedDelay(1*time.Minute)).
+		Clock(clock).
+		Wait(t.Context(), 10*time.Minute)
+
+	if !TimedOut(err) {
+		t.Fatalf("got %v, want timeout", err)
+	}
+	if err, _ := errors.AsType[*TimeoutError](err); err.LastState != "pending" {
+		t.Errorf("LastState = %q, want pending", err.LastState)
+	}
+	if got == nil || *got != "pending" {
+		t.Errorf("got %v, want pending", got)
+	}
+	// One attempt per minute plus one more in the default grace period.
+	if *n != 12 {
+		t.Errorf("refreshed %d times, want 12", *n)
+	}
+	if elapsed := clock.Now().Sub(start); elapsed != 11*time.Minute {
+		t.Errorf("elapsed %s, want 11m", elapsed)
+	}
+}
+
+func TestWaiter_noGracePeriod(t *testing.T) {
+	t.Parallel()
+
+	refresh, n := sequenceRefreshFunc("pending")
+
+	_, err := WaitFor(refresh).
+		Pending("pending").
+		Target("running").
+		Delay(backoff.FixedDelay(1*time.Minute)).
+		GracePeriod(0).
+		Clock(newTestClock()).
+		Wait(t.Context(), 10*time.Minute)
+
+	if !TimedOut(err) {
+		t.Fatalf("got %v, want timeout", err)
+	}
+	if *n != 11 {
+		t.Errorf("refreshed %d times, want 11", *n)
+	}
+}
+
+func TestWaiter_progress(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefreshFunc("pending", "pending", "pending", "pending", "running")
+
+	var got []uint
+	_, err := WaitFor(refresh).
+		Pending("pending").
+		Target("running").
+		Delay(backoff.FixedDelay(1*time.Minute)).
+		Progress(2*time.Minute, func(_ context.Context, p Progress[*string, testState]) {
+			if p.State != "pending" {
+				t.Errorf("State = %q, want pending", p.State)
+			}
+			if p.NextPollIn != 1*time.Minute {
+				t.Errorf("NextPollIn = %s, want 1m", p.NextPollIn)
+			}
+			if want := p.Elapsed + p.Remaining; want != 10*time.Minute {
+				t.Errorf("Elapsed + Remaining = %s, want 10m", want)
+			}
+			got = append(got, p.Attempt)
+		}).
+		Clock(newTestClock()).
+		Wait(t.Context(), 10*time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff := cmp.Diff(got, []uint{0, 2}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestWaiter_cancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	var n int
+	refresh := func(context.Context) (*string, testState, error) {
+		n++
+		if n == 3 {
+			cancel()
+		}
+
+		v := "pending"
+		return &v, "pending", nil
+	}
+
+	_, err := WaitFor(refresh).
+		Pending("pending").
+		Target("running").
+		Clock(newTestClock()).
+		Wait(ctx, 10*time.Minute)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
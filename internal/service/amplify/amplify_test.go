@@ -23,6 +23,7 @@ func TestAccAmplify_serial(t *testing.T) {
 			"tags":                     testAccAmplifyApp_tagsSerial,
 			"AutoBranchCreationConfig": testAccApp_AutoBranchCreationConfig,
 			"BasicAuthCredentials":     testAccApp_BasicAuthCredentials,
+			"BasicAuthCredentialsWO":   testAccApp_BasicAuthCredentialsWriteOnly,
 			"BuildSpec":                testAccApp_BuildSpec,
 			"CacheConfig":              testAccApp_CacheConfig,
 			"ComputeRole":              testAccApp_ComputeRole,
@@ -40,12 +41,13 @@ func TestAccAmplify_serial(t *testing.T) {
 			"DeploymentArtifacts_StackName": testAccBackendEnvironment_DeploymentArtifacts_StackName,
 		},
 		"Branch": {
-			acctest.CtBasic:        testAccBranch_basic,
-			acctest.CtDisappears:   testAccBranch_disappears,
-			"tags":                 testAccAmplifyBranch_tagsSerial,
-			"BasicAuthCredentials": testAccBranch_BasicAuthCredentials,
-			"EnvironmentVariables": testAccBranch_EnvironmentVariables,
-			"OptionalArguments":    testAccBranch_OptionalArguments,
+			acctest.CtBasic:          testAccBranch_basic,
+			acctest.CtDisappears:     testAccBranch_disappears,
+			"tags":                   testAccAmplifyBranch_tagsSerial,
+			"BasicAuthCredentials":   testAccBranch_BasicAuthCredentials,
+			"BasicAuthCredentialsWO": testAccBranch_BasicAuthCredentialsWriteOnly,
+			"EnvironmentVariables":   testAccBranch_EnvironmentVariables,
+			"OptionalArguments":      testAccBranch_OptionalArguments,
 		},
 		"DomainAssociation": {
 			acctest.CtBasic:               testAccDomainAssociation_basic,
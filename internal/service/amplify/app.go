@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/amplify"
 	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -57,10 +58,25 @@ func resourceApp() *schema.Resource {
 		SchemaFunc: func() map[string]*schema.Schema {
 			return map[string]*schema.Schema{
 				"access_token": {
-					Type:         schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(1, 255),
+					ConflictsWith: []string{"access_token_wo"},
+				},
+				"access_token_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(1, 255),
+					ConflictsWith: []string{"access_token"},
+					RequiredWith:  []string{"access_token_wo_version"},
+				},
+				"access_token_wo_version": {
+					Type:         schema.TypeInt,
 					Optional:     true,
-					Sensitive:    true,
-					ValidateFunc: validation.StringLenBetween(1, 255),
+					RequiredWith: []string{"access_token_wo"},
 				},
 				names.AttrARN: {
 					Type:     schema.TypeString,
@@ -166,6 +182,21 @@ func resourceApp() *schema.Resource {
 
 						return true
 					},
+					ConflictsWith: []string{"basic_auth_credentials_wo"},
+				},
+				"basic_auth_credentials_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(1, 2000),
+					ConflictsWith: []string{"basic_auth_credentials"},
+					RequiredWith:  []string{"basic_auth_credentials_wo_version"},
+				},
+				"basic_auth_credentials_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"basic_auth_credentials_wo"},
 				},
 				"build_spec": {
 					Type:         schema.TypeString,
@@ -290,10 +321,25 @@ func resourceApp() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(1, 255),
 				},
 				"oauth_token": {
-					Type:         schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(1, 1000),
+					ConflictsWith: []string{"oauth_token_wo"},
+				},
+				"oauth_token_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(1, 1000),
+					ConflictsWith: []string{"oauth_token"},
+					RequiredWith:  []string{"oauth_token_wo_version"},
+				},
+				"oauth_token_wo_version": {
+					Type:         schema.TypeInt,
 					Optional:     true,
-					Sensitive:    true,
-					ValidateFunc: validation.StringLenBetween(1, 1000),
+					RequiredWith: []string{"oauth_token_wo"},
 				},
 				"platform": {
 					Type:             schema.TypeString,
@@ -351,6 +397,17 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		input.AccessToken = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	accessTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("access_token_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if accessTokenWO != "" {
+		input.AccessToken = aws.String(accessTokenWO)
+	}
+
 	if v, ok := d.GetOk("auto_branch_creation_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.AutoBranchCreationConfig = expandAutoBranchCreationConfig(v.([]any)[0].(map[string]any))
 	}
@@ -363,6 +420,17 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		input.BasicAuthCredentials = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	basicAuthCredentialsWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("basic_auth_credentials_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if basicAuthCredentialsWO != "" {
+		input.BasicAuthCredentials = aws.String(basicAuthCredentialsWO)
+	}
+
 	if v, ok := d.GetOk("build_spec"); ok {
 		input.BuildSpec = aws.String(v.(string))
 	}
@@ -419,6 +487,17 @@ func resourceAppCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		input.OauthToken = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	oauthTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("oauth_token_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if oauthTokenWO != "" {
+		input.OauthToken = aws.String(oauthTokenWO)
+	}
+
 	if v, ok := d.GetOk("platform"); ok {
 		input.Platform = types.Platform(v.(string))
 	}
@@ -465,7 +544,9 @@ func resourceAppRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		d.Set("auto_branch_creation_config", nil)
 	}
 	d.Set("auto_branch_creation_patterns", app.AutoBranchCreationPatterns)
-	d.Set("basic_auth_credentials", app.BasicAuthCredentials)
+	if _, ok := d.GetOk("basic_auth_credentials_wo_version"); !ok {
+		d.Set("basic_auth_credentials", app.BasicAuthCredentials)
+	}
 	d.Set("build_spec", app.BuildSpec)
 	if app.CacheConfig != nil {
 		if err := d.Set("cache_config", []any{flattenCacheConfig(app.CacheConfig)}); err != nil {
@@ -521,6 +602,17 @@ func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 			input.AccessToken = aws.String(d.Get("access_token").(string))
 		}
 
+		if d.HasChange("access_token_wo_version") {
+			// get write-only value from configuration
+			accessTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("access_token_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			input.AccessToken = aws.String(accessTokenWO)
+		}
+
 		if d.HasChange("auto_branch_creation_config") {
 			if v, ok := d.Get("auto_branch_creation_config").([]any); ok && len(v) > 0 && v[0] != nil {
 				input.AutoBranchCreationConfig = expandAutoBranchCreationConfig(v[0].(map[string]any))
@@ -541,6 +633,17 @@ func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 			input.BasicAuthCredentials = aws.String(d.Get("basic_auth_credentials").(string))
 		}
 
+		if d.HasChange("basic_auth_credentials_wo_version") {
+			// get write-only value from configuration
+			basicAuthCredentialsWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("basic_auth_credentials_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			input.BasicAuthCredentials = aws.String(basicAuthCredentialsWO)
+		}
+
 		if d.HasChange("build_spec") {
 			input.BuildSpec = aws.String(d.Get("build_spec").(string))
 		}
@@ -616,6 +719,17 @@ func resourceAppUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 			input.OauthToken = aws.String(d.Get("oauth_token").(string))
 		}
 
+		if d.HasChange("oauth_token_wo_version") {
+			// get write-only value from configuration
+			oauthTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("oauth_token_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			input.OauthToken = aws.String(oauthTokenWO)
+		}
+
 		if d.HasChange("platform") {
 			input.Platform = types.Platform(d.Get("platform").(string))
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/amplify"
 	"github.com/aws/aws-sdk-go-v2/service/amplify/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -78,6 +79,21 @@ func resourceBranch() *schema.Resource {
 
 						return true
 					},
+					ConflictsWith: []string{"basic_auth_credentials_wo"},
+				},
+				"basic_auth_credentials_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(1, 2000),
+					ConflictsWith: []string{"basic_auth_credentials"},
+					RequiredWith:  []string{"basic_auth_credentials_wo_version"},
+				},
+				"basic_auth_credentials_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"basic_auth_credentials_wo"},
 				},
 				"branch_name": {
 					Type:         schema.TypeString,
@@ -203,6 +219,17 @@ func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.BasicAuthCredentials = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	basicAuthCredentialsWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("basic_auth_credentials_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if basicAuthCredentialsWO != "" {
+		input.BasicAuthCredentials = aws.String(basicAuthCredentialsWO)
+	}
+
 	if v, ok := d.GetOk(names.AttrDescription); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -287,7 +314,9 @@ func resourceBranchRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	d.Set(names.AttrARN, branch.BranchArn)
 	d.Set("associated_resources", branch.AssociatedResources)
 	d.Set("backend_environment_arn", branch.BackendEnvironmentArn)
-	d.Set("basic_auth_credentials", branch.BasicAuthCredentials)
+	if _, ok := d.GetOk("basic_auth_credentials_wo_version"); !ok {
+		d.Set("basic_auth_credentials", branch.BasicAuthCredentials)
+	}
 	d.Set("branch_name", branch.BranchName)
 	d.Set("custom_domains", branch.CustomDomains)
 	d.Set(names.AttrDescription, branch.Description)
@@ -334,6 +363,17 @@ func resourceBranchUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			input.BasicAuthCredentials = aws.String(d.Get("basic_auth_credentials").(string))
 		}
 
+		if d.HasChange("basic_auth_credentials_wo_version") {
+			// get write-only value from configuration
+			basicAuthCredentialsWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("basic_auth_credentials_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			input.BasicAuthCredentials = aws.String(basicAuthCredentialsWO)
+		}
+
 		if d.HasChange(names.AttrDescription) {
 			input.Description = aws.String(d.Get(names.AttrDescription).(string))
 		}
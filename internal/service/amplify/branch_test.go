@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfamplify "github.com/hashicorp/terraform-provider-aws/internal/service/amplify"
@@ -150,6 +151,48 @@ func testAccBranch_BasicAuthCredentials(t *testing.T) {
 	})
 }
 
+func testAccBranch_BasicAuthCredentialsWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var branch types.Branch
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_amplify_branch.test"
+
+	credentials1 := base64.StdEncoding.EncodeToString([]byte("username1:password1"))
+	credentials2 := base64.StdEncoding.EncodeToString([]byte("username2:password2"))
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AmplifyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckBranchDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBranchConfig_basicAuthCredentialsWriteOnly(rName, credentials1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, t, resourceName, &branch),
+					resource.TestCheckNoResourceAttr(resourceName, "basic_auth_credentials"),
+					resource.TestCheckNoResourceAttr(resourceName, "basic_auth_credentials_wo"),
+					resource.TestCheckResourceAttr(resourceName, "basic_auth_credentials_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "enable_basic_auth", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccBranchConfig_basicAuthCredentialsWriteOnly(rName, credentials2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBranchExists(ctx, t, resourceName, &branch),
+					resource.TestCheckNoResourceAttr(resourceName, "basic_auth_credentials"),
+					resource.TestCheckNoResourceAttr(resourceName, "basic_auth_credentials_wo"),
+					resource.TestCheckResourceAttr(resourceName, "basic_auth_credentials_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "enable_basic_auth", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccBranch_EnvironmentVariables(t *testing.T) {
 	ctx := acctest.Context(t)
 	var branch types.Branch
@@ -435,3 +478,20 @@ resource "aws_amplify_branch" "test" {
 }
 `, rName, environmentName)
 }
+
+func testAccBranchConfig_basicAuthCredentialsWriteOnly(rName, basicAuthCredentials string, basicAuthCredentialsWOVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_amplify_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_amplify_branch" "test" {
+  app_id      = aws_amplify_app.test.id
+  branch_name = %[1]q
+
+  basic_auth_credentials_wo         = %[2]q
+  basic_auth_credentials_wo_version = %[3]d
+  enable_basic_auth                 = true
+}
+`, rName, basicAuthCredentials, basicAuthCredentialsWOVersion)
+}
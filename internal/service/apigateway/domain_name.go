@@ -16,12 +16,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -63,7 +65,7 @@ func resourceDomainName() *schema.Resource {
 				names.AttrCertificateARN: {
 					Type:          schema.TypeString,
 					Optional:      true,
-					ConflictsWith: []string{"certificate_body", names.AttrCertificateChain, "certificate_name", "certificate_private_key", "regional_certificate_arn", "regional_certificate_name"},
+					ConflictsWith: []string{"certificate_body", names.AttrCertificateChain, "certificate_name", "certificate_private_key", "certificate_private_key_wo", "regional_certificate_arn", "regional_certificate_name"},
 				},
 				"certificate_body": {
 					Type:          schema.TypeString,
@@ -87,7 +89,21 @@ func resourceDomainName() *schema.Resource {
 					ForceNew:      true,
 					Optional:      true,
 					Sensitive:     true,
-					ConflictsWith: []string{names.AttrCertificateARN, "regional_certificate_arn"},
+					ConflictsWith: []string{names.AttrCertificateARN, "certificate_private_key_wo", "regional_certificate_arn"},
+				},
+				"certificate_private_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{names.AttrCertificateARN, "certificate_private_key", "regional_certificate_arn"},
+					RequiredWith:  []string{"certificate_private_key_wo_version"},
+				},
+				"certificate_private_key_wo_version": {
+					Type:         schema.TypeInt,
+					ForceNew:     true,
+					Optional:     true,
+					RequiredWith: []string{"certificate_private_key_wo"},
 				},
 				"certificate_upload_date": {
 					Type:     schema.TypeString,
@@ -170,7 +186,7 @@ func resourceDomainName() *schema.Resource {
 				"regional_certificate_arn": {
 					Type:          schema.TypeString,
 					Optional:      true,
-					ConflictsWith: []string{names.AttrCertificateARN, "certificate_body", names.AttrCertificateChain, "certificate_name", "certificate_private_key", "regional_certificate_name"},
+					ConflictsWith: []string{names.AttrCertificateARN, "certificate_body", names.AttrCertificateChain, "certificate_name", "certificate_private_key", "certificate_private_key_wo", "regional_certificate_name"},
 				},
 				"regional_certificate_name": {
 					Type:          schema.TypeString,
@@ -237,6 +253,17 @@ func resourceDomainNameCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.CertificatePrivateKey = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	certificatePrivateKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("certificate_private_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if certificatePrivateKeyWO != "" {
+		input.CertificatePrivateKey = aws.String(certificatePrivateKeyWO)
+	}
+
 	if v, ok := d.GetOk("endpoint_access_mode"); ok {
 		input.EndpointAccessMode = types.EndpointAccessMode(v.(string))
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
 	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
@@ -126,6 +127,77 @@ func TestAccAPIGatewayDomainName_certificateName(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayDomainName_certificatePrivateKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	certificateBody := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_CERTIFICATE_BODY")
+	if certificateBody == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_CERTIFICATE_BODY is not set. " +
+				"This environment variable must be set to any non-empty value " +
+				"with a publicly trusted certificate body to enable the test.")
+	}
+
+	certificateChain := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_CERTIFICATE_CHAIN")
+	if certificateChain == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_CERTIFICATE_CHAIN is not set. " +
+				"This environment variable must be set to any non-empty value " +
+				"with a chain certificate acceptable for the certificate to enable the test.")
+	}
+
+	certificatePrivateKey := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_CERTIFICATE_PRIVATE_KEY")
+	if certificatePrivateKey == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_CERTIFICATE_PRIVATE_KEY is not set. " +
+				"This environment variable must be set to any non-empty value " +
+				"with a private key of a publicly trusted certificate to enable the test.")
+	}
+
+	domainName := os.Getenv("AWS_API_GATEWAY_DOMAIN_NAME_DOMAIN_NAME")
+	if domainName == "" {
+		t.Skip(
+			"Environment variable AWS_API_GATEWAY_DOMAIN_NAME_DOMAIN_NAME is not set. " +
+				"This environment variable must be set to any non-empty value " +
+				"with a domain name acceptable for the certificate to enable the test.")
+	}
+	var conf apigateway.GetDomainNameOutput
+	resourceName := "aws_api_gateway_domain_name.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDomainNameDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDomainNameConfig_certificatePrivateKeyWriteOnly(domainName, certificatePrivateKey, certificateBody, certificateChain, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainNameExists(ctx, t, resourceName, &conf),
+					resource.TestCheckNoResourceAttr(resourceName, "certificate_private_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "certificate_private_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_private_key_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccDomainNameConfig_certificatePrivateKeyWriteOnly(domainName, certificatePrivateKey, certificateBody, certificateChain, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDomainNameExists(ctx, t, resourceName, &conf),
+					resource.TestCheckNoResourceAttr(resourceName, "certificate_private_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_private_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayDomainName_regionalCertificateARN(t *testing.T) {
 	ctx := acctest.Context(t)
 	var domainName apigateway.GetDomainNameOutput
@@ -1100,3 +1172,16 @@ resource "aws_api_gateway_domain_name" "test" {
 }
 `, domainName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key), routingMode)
 }
+
+func testAccDomainNameConfig_certificatePrivateKeyWriteOnly(domainName, key, certificate, chainCertificate string, keyWOVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_domain_name" "test" {
+  domain_name                        = %[1]q
+  certificate_body                   = "%[2]s"
+  certificate_chain                  = "%[3]s"
+  certificate_name                   = "tf-acc-apigateway-domain-name"
+  certificate_private_key_wo         = "%[4]s"
+  certificate_private_key_wo_version = %[5]d
+}
+`, domainName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(chainCertificate), acctest.TLSPEMEscapeNewlines(key), keyWOVersion)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/appflow"
 	"github.com/aws/aws-sdk-go-v2/service/appflow/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -89,12 +90,30 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 													names.AttrSecretKey: {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 256),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.amplitude.0.secret_key", "connector_profile_config.0.connector_profile_credentials.0.amplitude.0.secret_key_wo"},
+													},
+													"secret_key_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 256),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.amplitude.0.secret_key", "connector_profile_config.0.connector_profile_credentials.0.amplitude.0.secret_key_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.amplitude.0.secret_key_wo_version"},
+													},
+													"secret_key_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.amplitude.0.secret_key_wo"},
 													},
 												},
 											},
@@ -143,9 +162,24 @@ func resourceConnectorProfile() *schema.Resource {
 															Schema: map[string]*schema.Schema{
 																names.AttrPassword: {
 																	Type:         schema.TypeString,
-																	Required:     true,
+																	Optional:     true,
+																	Sensitive:    true,
+																	ValidateFunc: validation.StringLenBetween(0, 512),
+																	ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.basic.0.password", "connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.basic.0.password_wo"},
+																},
+																"password_wo": {
+																	Type:         schema.TypeString,
+																	Optional:     true,
+																	WriteOnly:    true,
 																	Sensitive:    true,
 																	ValidateFunc: validation.StringLenBetween(0, 512),
+																	ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.basic.0.password", "connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.basic.0.password_wo"},
+																	RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.basic.0.password_wo_version"},
+																},
+																"password_wo_version": {
+																	Type:         schema.TypeInt,
+																	Optional:     true,
+																	RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.basic.0.password_wo"},
 																},
 																names.AttrUsername: {
 																	Type:         schema.TypeString,
@@ -199,6 +233,24 @@ func resourceConnectorProfile() *schema.Resource {
 																		validation.StringLenBetween(1, 4096),
 																		validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 																	),
+																	ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.oauth2.0.access_token_wo"},
+																},
+																"access_token_wo": {
+																	Type:      schema.TypeString,
+																	Optional:  true,
+																	WriteOnly: true,
+																	Sensitive: true,
+																	ValidateFunc: validation.All(
+																		validation.StringLenBetween(1, 4096),
+																		validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+																	),
+																	ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.oauth2.0.access_token"},
+																	RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.oauth2.0.access_token_wo_version"},
+																},
+																"access_token_wo_version": {
+																	Type:         schema.TypeInt,
+																	Optional:     true,
+																	RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.oauth2.0.access_token_wo"},
 																},
 																names.AttrClientID: {
 																	Type:     schema.TypeString,
@@ -216,6 +268,24 @@ func resourceConnectorProfile() *schema.Resource {
 																		validation.StringLenBetween(1, 512),
 																		validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 																	),
+																	ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.oauth2.0.client_secret_wo"},
+																},
+																"client_secret_wo": {
+																	Type:      schema.TypeString,
+																	Optional:  true,
+																	WriteOnly: true,
+																	Sensitive: true,
+																	ValidateFunc: validation.All(
+																		validation.StringLenBetween(1, 512),
+																		validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+																	),
+																	ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.oauth2.0.client_secret"},
+																	RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.oauth2.0.client_secret_wo_version"},
+																},
+																"client_secret_wo_version": {
+																	Type:         schema.TypeInt,
+																	Optional:     true,
+																	RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.custom_connector.0.oauth2.0.client_secret_wo"},
 																},
 																"oauth_request": {
 																	Type:     schema.TypeList,
@@ -312,6 +382,24 @@ func resourceConnectorProfile() *schema.Resource {
 															validation.StringLenBetween(1, 2048),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.access_token_wo"},
+													},
+													"access_token_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 2048),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.access_token"},
+														RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.access_token_wo_version"},
+													},
+													"access_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.access_token_wo"},
 													},
 													names.AttrClientID: {
 														Type:     schema.TypeString,
@@ -323,12 +411,30 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 													names.AttrClientSecret: {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 512),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.client_secret", "connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.client_secret_wo"},
+													},
+													"client_secret_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 512),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.client_secret", "connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.client_secret_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.client_secret_wo_version"},
+													},
+													"client_secret_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.google_analytics.0.client_secret_wo"},
 													},
 													"oauth_request": {
 														Type:     schema.TypeList,
@@ -380,6 +486,24 @@ func resourceConnectorProfile() *schema.Resource {
 															validation.StringLenBetween(1, 2048),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.honeycode.0.access_token_wo"},
+													},
+													"access_token_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 2048),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.honeycode.0.access_token"},
+														RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.honeycode.0.access_token_wo_version"},
+													},
+													"access_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.honeycode.0.access_token_wo"},
 													},
 													"oauth_request": {
 														Type:     schema.TypeList,
@@ -441,12 +565,30 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 													"secret_access_key": {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 512),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.infor_nexus.0.secret_access_key", "connector_profile_config.0.connector_profile_credentials.0.infor_nexus.0.secret_access_key_wo"},
+													},
+													"secret_access_key_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 512),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.infor_nexus.0.secret_access_key", "connector_profile_config.0.connector_profile_credentials.0.infor_nexus.0.secret_access_key_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.infor_nexus.0.secret_access_key_wo_version"},
+													},
+													"secret_access_key_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.infor_nexus.0.secret_access_key_wo"},
 													},
 													"user_id": {
 														Type:     schema.TypeString,
@@ -473,6 +615,24 @@ func resourceConnectorProfile() *schema.Resource {
 															validation.StringLenBetween(1, 2048),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.marketo.0.access_token_wo"},
+													},
+													"access_token_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 2048),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.marketo.0.access_token"},
+														RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.marketo.0.access_token_wo_version"},
+													},
+													"access_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.marketo.0.access_token_wo"},
 													},
 													names.AttrClientID: {
 														Type:     schema.TypeString,
@@ -484,12 +644,30 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 													names.AttrClientSecret: {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 512),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.marketo.0.client_secret", "connector_profile_config.0.connector_profile_credentials.0.marketo.0.client_secret_wo"},
+													},
+													"client_secret_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 512),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.marketo.0.client_secret", "connector_profile_config.0.connector_profile_credentials.0.marketo.0.client_secret_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.marketo.0.client_secret_wo_version"},
+													},
+													"client_secret_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.marketo.0.client_secret_wo"},
 													},
 													"oauth_request": {
 														Type:     schema.TypeList,
@@ -527,9 +705,24 @@ func resourceConnectorProfile() *schema.Resource {
 												Schema: map[string]*schema.Schema{
 													names.AttrPassword: {
 														Type:         schema.TypeString,
-														Required:     true,
+														Optional:     true,
 														Sensitive:    true,
 														ValidateFunc: validation.StringLenBetween(0, 512),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.redshift.0.password", "connector_profile_config.0.connector_profile_credentials.0.redshift.0.password_wo"},
+													},
+													"password_wo": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														WriteOnly:    true,
+														Sensitive:    true,
+														ValidateFunc: validation.StringLenBetween(0, 512),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.redshift.0.password", "connector_profile_config.0.connector_profile_credentials.0.redshift.0.password_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.redshift.0.password_wo_version"},
+													},
+													"password_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.redshift.0.password_wo"},
 													},
 													names.AttrUsername: {
 														Type:     schema.TypeString,
@@ -556,6 +749,24 @@ func resourceConnectorProfile() *schema.Resource {
 															validation.StringLenBetween(1, 2048),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.salesforce.0.access_token_wo"},
+													},
+													"access_token_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 2048),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.salesforce.0.access_token"},
+														RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.salesforce.0.access_token_wo_version"},
+													},
+													"access_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.salesforce.0.access_token_wo"},
 													},
 													"client_credentials_arn": {
 														Type:         schema.TypeString,
@@ -622,9 +833,24 @@ func resourceConnectorProfile() *schema.Resource {
 															Schema: map[string]*schema.Schema{
 																names.AttrPassword: {
 																	Type:         schema.TypeString,
-																	Required:     true,
+																	Optional:     true,
+																	Sensitive:    true,
+																	ValidateFunc: validation.StringLenBetween(0, 512),
+																	ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.basic_auth_credentials.0.password", "connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.basic_auth_credentials.0.password_wo"},
+																},
+																"password_wo": {
+																	Type:         schema.TypeString,
+																	Optional:     true,
+																	WriteOnly:    true,
 																	Sensitive:    true,
 																	ValidateFunc: validation.StringLenBetween(0, 512),
+																	ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.basic_auth_credentials.0.password", "connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.basic_auth_credentials.0.password_wo"},
+																	RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.basic_auth_credentials.0.password_wo_version"},
+																},
+																"password_wo_version": {
+																	Type:         schema.TypeInt,
+																	Optional:     true,
+																	RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.basic_auth_credentials.0.password_wo"},
 																},
 																names.AttrUsername: {
 																	Type:     schema.TypeString,
@@ -651,6 +877,24 @@ func resourceConnectorProfile() *schema.Resource {
 																		validation.StringLenBetween(1, 2048),
 																		validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 																	),
+																	ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.oauth_credentials.0.access_token_wo"},
+																},
+																"access_token_wo": {
+																	Type:      schema.TypeString,
+																	Optional:  true,
+																	WriteOnly: true,
+																	Sensitive: true,
+																	ValidateFunc: validation.All(
+																		validation.StringLenBetween(1, 2048),
+																		validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+																	),
+																	ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.oauth_credentials.0.access_token"},
+																	RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.oauth_credentials.0.access_token_wo_version"},
+																},
+																"access_token_wo_version": {
+																	Type:         schema.TypeInt,
+																	Optional:     true,
+																	RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.sapo_data.0.oauth_credentials.0.access_token_wo"},
 																},
 																names.AttrClientID: {
 																	Type:     schema.TypeString,
@@ -715,9 +959,24 @@ func resourceConnectorProfile() *schema.Resource {
 												Schema: map[string]*schema.Schema{
 													names.AttrPassword: {
 														Type:         schema.TypeString,
-														Required:     true,
+														Optional:     true,
+														Sensitive:    true,
+														ValidateFunc: validation.StringLenBetween(0, 512),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.service_now.0.password", "connector_profile_config.0.connector_profile_credentials.0.service_now.0.password_wo"},
+													},
+													"password_wo": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														WriteOnly:    true,
 														Sensitive:    true,
 														ValidateFunc: validation.StringLenBetween(0, 512),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.service_now.0.password", "connector_profile_config.0.connector_profile_credentials.0.service_now.0.password_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.service_now.0.password_wo_version"},
+													},
+													"password_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.service_now.0.password_wo"},
 													},
 													names.AttrUsername: {
 														Type:     schema.TypeString,
@@ -761,6 +1020,24 @@ func resourceConnectorProfile() *schema.Resource {
 															validation.StringLenBetween(1, 2048),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.slack.0.access_token_wo"},
+													},
+													"access_token_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 2048),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.slack.0.access_token"},
+														RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.slack.0.access_token_wo_version"},
+													},
+													"access_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.slack.0.access_token_wo"},
 													},
 													names.AttrClientID: {
 														Type:     schema.TypeString,
@@ -772,12 +1049,30 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 													names.AttrClientSecret: {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 512),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.slack.0.client_secret", "connector_profile_config.0.connector_profile_credentials.0.slack.0.client_secret_wo"},
+													},
+													"client_secret_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 512),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.slack.0.client_secret", "connector_profile_config.0.connector_profile_credentials.0.slack.0.client_secret_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.slack.0.client_secret_wo_version"},
+													},
+													"client_secret_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.slack.0.client_secret_wo"},
 													},
 													"oauth_request": {
 														Type:     schema.TypeList,
@@ -815,9 +1110,24 @@ func resourceConnectorProfile() *schema.Resource {
 												Schema: map[string]*schema.Schema{
 													names.AttrPassword: {
 														Type:         schema.TypeString,
-														Required:     true,
+														Optional:     true,
+														Sensitive:    true,
+														ValidateFunc: validation.StringLenBetween(0, 512),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.snowflake.0.password", "connector_profile_config.0.connector_profile_credentials.0.snowflake.0.password_wo"},
+													},
+													"password_wo": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														WriteOnly:    true,
 														Sensitive:    true,
 														ValidateFunc: validation.StringLenBetween(0, 512),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.snowflake.0.password", "connector_profile_config.0.connector_profile_credentials.0.snowflake.0.password_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.snowflake.0.password_wo_version"},
+													},
+													"password_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.snowflake.0.password_wo"},
 													},
 													names.AttrUsername: {
 														Type:     schema.TypeString,
@@ -838,12 +1148,30 @@ func resourceConnectorProfile() *schema.Resource {
 												Schema: map[string]*schema.Schema{
 													"api_secret_key": {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 256),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.trendmicro.0.api_secret_key", "connector_profile_config.0.connector_profile_credentials.0.trendmicro.0.api_secret_key_wo"},
+													},
+													"api_secret_key_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 256),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.trendmicro.0.api_secret_key", "connector_profile_config.0.connector_profile_credentials.0.trendmicro.0.api_secret_key_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.trendmicro.0.api_secret_key_wo_version"},
+													},
+													"api_secret_key_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.trendmicro.0.api_secret_key_wo"},
 													},
 												},
 											},
@@ -856,9 +1184,24 @@ func resourceConnectorProfile() *schema.Resource {
 												Schema: map[string]*schema.Schema{
 													names.AttrPassword: {
 														Type:         schema.TypeString,
-														Required:     true,
+														Optional:     true,
+														Sensitive:    true,
+														ValidateFunc: validation.StringLenBetween(0, 512),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.veeva.0.password", "connector_profile_config.0.connector_profile_credentials.0.veeva.0.password_wo"},
+													},
+													"password_wo": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														WriteOnly:    true,
 														Sensitive:    true,
 														ValidateFunc: validation.StringLenBetween(0, 512),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.veeva.0.password", "connector_profile_config.0.connector_profile_credentials.0.veeva.0.password_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.veeva.0.password_wo_version"},
+													},
+													"password_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.veeva.0.password_wo"},
 													},
 													names.AttrUsername: {
 														Type:     schema.TypeString,
@@ -885,6 +1228,24 @@ func resourceConnectorProfile() *schema.Resource {
 															validation.StringLenBetween(1, 2048),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.zendesk.0.access_token_wo"},
+													},
+													"access_token_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 2048),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ConflictsWith: []string{"connector_profile_config.0.connector_profile_credentials.0.zendesk.0.access_token"},
+														RequiredWith:  []string{"connector_profile_config.0.connector_profile_credentials.0.zendesk.0.access_token_wo_version"},
+													},
+													"access_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.zendesk.0.access_token_wo"},
 													},
 													names.AttrClientID: {
 														Type:     schema.TypeString,
@@ -896,12 +1257,30 @@ func resourceConnectorProfile() *schema.Resource {
 													},
 													names.AttrClientSecret: {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 512),
+															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
+														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.zendesk.0.client_secret", "connector_profile_config.0.connector_profile_credentials.0.zendesk.0.client_secret_wo"},
+													},
+													"client_secret_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 512),
 															validation.StringMatch(regexache.MustCompile(`\S+`), "must not contain any whitespace characters"),
 														),
+														ExactlyOneOf: []string{"connector_profile_config.0.connector_profile_credentials.0.zendesk.0.client_secret", "connector_profile_config.0.connector_profile_credentials.0.zendesk.0.client_secret_wo"},
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.zendesk.0.client_secret_wo_version"},
+													},
+													"client_secret_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"connector_profile_config.0.connector_profile_credentials.0.zendesk.0.client_secret_wo"},
 													},
 													"oauth_request": {
 														Type:     schema.TypeList,
@@ -1456,7 +1835,14 @@ func resourceConnectorProfileCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if v, ok := d.GetOk("connector_profile_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-		input.ConnectorProfileConfig = expandConnectorProfileConfig(v.([]any)[0].(map[string]any))
+		tfMap := v.([]any)[0].(map[string]any)
+
+		diags = append(diags, expandConnectorProfileConfigWriteOnly(d, tfMap)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.ConnectorProfileConfig = expandConnectorProfileConfig(tfMap)
 	}
 
 	if v, ok := d.Get("kms_arn").(string); ok && len(v) > 0 {
@@ -1508,7 +1894,14 @@ func resourceConnectorProfileUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if v, ok := d.GetOk("connector_profile_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-		input.ConnectorProfileConfig = expandConnectorProfileConfig(v.([]any)[0].(map[string]any))
+		tfMap := v.([]any)[0].(map[string]any)
+
+		diags = append(diags, expandConnectorProfileConfigWriteOnly(d, tfMap)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.ConnectorProfileConfig = expandConnectorProfileConfig(tfMap)
 	}
 
 	_, err := conn.UpdateConnectorProfile(ctx, input)
@@ -1559,6 +1952,75 @@ func findConnectorProfileByName(ctx context.Context, conn *appflow.Client, name
 	return tfresource.AssertSingleValueResult(output.ConnectorProfileDetails)
 }
 
+// connectorProfileCredentialsWriteOnlyAttributes are the paths, relative to
+// connector_profile_credentials, of the credentials that have write-only counterparts.
+var connectorProfileCredentialsWriteOnlyAttributes = [][]string{
+	{"amplitude", "secret_key"},
+	{"custom_connector", "basic", "password"},
+	{"custom_connector", "oauth2", "access_token"},
+	{"custom_connector", "oauth2", "client_secret"},
+	{"google_analytics", "access_token"},
+	{"google_analytics", "client_secret"},
+	{"honeycode", "access_token"},
+	{"infor_nexus", "secret_access_key"},
+	{"marketo", "access_token"},
+	{"marketo", "client_secret"},
+	{"redshift", "password"},
+	{"salesforce", "access_token"},
+	{"sapo_data", "basic_auth_credentials", "password"},
+	{"sapo_data", "oauth_credentials", "access_token"},
+	{"service_now", "password"},
+	{"slack", "access_token"},
+	{"slack", "client_secret"},
+	{"snowflake", "password"},
+	{"trendmicro", "api_secret_key"},
+	{"veeva", "password"},
+	{"zendesk", "access_token"},
+	{"zendesk", "client_secret"},
+}
+
+// expandConnectorProfileConfigWriteOnly copies any write-only credential values from
+// configuration into the connector profile configuration map before it is expanded.
+func expandConnectorProfileConfigWriteOnly(d *schema.ResourceData, tfMap map[string]any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, attrPath := range connectorProfileCredentialsWriteOnlyAttributes {
+		path := cty.GetAttrPath("connector_profile_config").IndexInt(0)
+		m := tfMap
+		for _, k := range append([]string{"connector_profile_credentials"}, attrPath[:len(attrPath)-1]...) {
+			if m = nestedConnectorProfileMap(m, k); m == nil {
+				break
+			}
+			path = path.GetAttr(k).IndexInt(0)
+		}
+		if m == nil {
+			continue
+		}
+
+		attr := attrPath[len(attrPath)-1]
+		v, di := flex.GetWriteOnlyStringValue(d, path.GetAttr(attr+"_wo"))
+		diags = append(diags, di...)
+
+		if v != "" {
+			m[attr] = v
+		}
+	}
+
+	return diags
+}
+
+func nestedConnectorProfileMap(tfMap map[string]any, key string) map[string]any {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap[key].([]any); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]any)
+	}
+
+	return nil
+}
+
 func expandConnectorProfileConfig(m map[string]any) *types.ConnectorProfileConfig {
 	cpc := &types.ConnectorProfileConfig{}
 
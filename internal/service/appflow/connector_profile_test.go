@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfappflow "github.com/hashicorp/terraform-provider-aws/internal/service/appflow"
@@ -83,6 +84,41 @@ func TestAccAppFlowConnectorProfile_update(t *testing.T) {
 	})
 }
 
+func TestAccAppFlowConnectorProfile_passwordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var connectorProfiles types.ConnectorProfile
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_appflow_connector_profile.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFlowServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConnectorProfileDestroy(ctx, t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectorProfileConfig_passwordWriteOnly(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorProfileExists(ctx, t, resourceName, &connectorProfiles),
+					resource.TestCheckNoResourceAttr(resourceName, "connector_profile_config.0.connector_profile_credentials.0.redshift.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_credentials.0.redshift.0.password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccConnectorProfileConfig_passwordWriteOnly(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConnectorProfileExists(ctx, t, resourceName, &connectorProfiles),
+					resource.TestCheckNoResourceAttr(resourceName, "connector_profile_config.0.connector_profile_credentials.0.redshift.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "connector_profile_config.0.connector_profile_credentials.0.redshift.0.password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAppFlowConnectorProfile_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var connectorProfiles types.ConnectorProfile
@@ -332,3 +368,45 @@ resource "aws_appflow_connector_profile" "test" {
 }
 `, rName, redshiftPassword, redshiftUsername, bucketPrefix))
 }
+
+func testAccConnectorProfileConfig_passwordWriteOnly(rName string, passwordVersion int) string {
+	const redshiftPassword = "testPassword123!"
+	const redshiftUsername = "testusername"
+
+	return acctest.ConfigCompose(
+		testAccConnectorProfileConfig_base(rName, redshiftPassword, redshiftUsername),
+		fmt.Sprintf(`
+resource "aws_appflow_connector_profile" "test" {
+  name            = %[1]q
+  connector_type  = "Redshift"
+  connection_mode = "Public"
+
+  connector_profile_config {
+
+    connector_profile_credentials {
+      redshift {
+        password_wo         = aws_redshift_cluster.test.master_password
+        password_wo_version = %[4]d
+        username            = aws_redshift_cluster.test.master_username
+      }
+    }
+
+    connector_profile_properties {
+      redshift {
+        bucket_name        = %[1]q
+        cluster_identifier = aws_redshift_cluster.test.cluster_identifier
+        database_name      = "dev"
+        database_url       = "jdbc:redshift://${aws_redshift_cluster.test.endpoint}/dev"
+        data_api_role_arn  = aws_iam_role.test.arn
+        role_arn           = aws_iam_role.test.arn
+      }
+    }
+  }
+
+  depends_on = [
+    aws_route.test,
+    aws_security_group_rule.test,
+  ]
+}
+`, rName, redshiftPassword, redshiftUsername, passwordVersion))
+}
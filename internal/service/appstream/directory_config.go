@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/appstream"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appstream/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -69,9 +70,23 @@ func resourceDirectoryConfig() *schema.Resource {
 								Required: true,
 							},
 							"account_password": {
-								Type:      schema.TypeString,
-								Required:  true,
-								Sensitive: true,
+								Type:         schema.TypeString,
+								Optional:     true,
+								Sensitive:    true,
+								ExactlyOneOf: []string{"service_account_credentials.0.account_password", "service_account_credentials.0.account_password_wo"},
+							},
+							"account_password_wo": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								WriteOnly:    true,
+								Sensitive:    true,
+								ExactlyOneOf: []string{"service_account_credentials.0.account_password", "service_account_credentials.0.account_password_wo"},
+								RequiredWith: []string{"service_account_credentials.0.account_password_wo_version"},
+							},
+							"account_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"service_account_credentials.0.account_password_wo"},
 							},
 						},
 					},
@@ -112,6 +127,17 @@ func resourceDirectoryConfigCreate(ctx context.Context, d *schema.ResourceData,
 		CertificateBasedAuthProperties:       expandCertificateBasedAuthProperties(d.Get("certificate_based_auth_properties").([]any)),
 	}
 
+	// get write-only value from configuration
+	accountPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("service_account_credentials").IndexInt(0).GetAttr("account_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if accountPasswordWO != "" {
+		input.ServiceAccountCredentials.AccountPassword = aws.String(accountPasswordWO)
+	}
+
 	output, err := conn.CreateDirectoryConfig(ctx, &input)
 
 	if err != nil {
@@ -166,6 +192,17 @@ func resourceDirectoryConfigUpdate(ctx context.Context, d *schema.ResourceData,
 
 	if d.HasChange("service_account_credentials") {
 		input.ServiceAccountCredentials = expandServiceAccountCredentials(d.Get("service_account_credentials").([]any))
+
+		// get write-only value from configuration
+		accountPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("service_account_credentials").IndexInt(0).GetAttr("account_password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if accountPasswordWO != "" {
+			input.ServiceAccountCredentials.AccountPassword = aws.String(accountPasswordWO)
+		}
 	}
 
 	if d.HasChange("certificate_based_auth_properties") {
@@ -269,6 +306,7 @@ func flattenServiceAccountCredentials(apiObject *awstypes.ServiceAccountCredenti
 	tfList := map[string]any{}
 	tfList["account_name"] = aws.ToString(apiObject.AccountName)
 	tfList["account_password"] = d.Get("service_account_credentials.0.account_password").(string)
+	tfList["account_password_wo_version"] = d.Get("service_account_credentials.0.account_password_wo_version").(int)
 
 	return []any{tfList}
 }
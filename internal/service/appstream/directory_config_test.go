@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfappstream "github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
@@ -219,6 +220,50 @@ func TestAccAppStreamDirectoryConfig_CertificateBasedAuthParameters(t *testing.T
 	})
 }
 
+func TestAccAppStreamDirectoryConfig_accountPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.DirectoryConfig
+	resourceName := "aws_appstream_directory_config.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName(t)
+	rUserName := fmt.Sprintf("%s\\%s", domain, acctest.RandString(t, 10))
+	rPassword := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rPasswordUpdated := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	orgUnitDN := orgUnitFromDomain("Test", domain)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDirectoryConfigDestroy(ctx, t),
+		ErrorCheck:   acctest.ErrorCheck(t, names.AppStreamServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfigConfig_accountPasswordWriteOnly(rName, domain, rUserName, rPassword, orgUnitDN, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryConfigExists(ctx, t, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "service_account_credentials.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_credentials.0.account_password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "service_account_credentials.0.account_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_credentials.0.account_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccDirectoryConfigConfig_accountPasswordWriteOnly(rName, domain, rUserName, rPasswordUpdated, orgUnitDN, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectoryConfigExists(ctx, t, resourceName, &v2),
+					testAccCheckDirectoryConfigNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "service_account_credentials.0.account_password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "service_account_credentials.0.account_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_credentials.0.account_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDirectoryConfigDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).AppStreamClient(ctx)
@@ -402,3 +447,36 @@ resource "aws_acmpca_certificate_authority" "test_ca" {
 }
 `, domain, userName, password, orgUnitDN, status))
 }
+
+func testAccDirectoryConfigConfig_accountPasswordWriteOnly(rName, domain, userName, password, orgUnitDN string, passwordWOVersion int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+resource "aws_appstream_directory_config" "test" {
+  directory_name                          = %[1]q
+  organizational_unit_distinguished_names = [%[4]q]
+
+  service_account_credentials {
+    account_name                = %[2]q
+    account_password_wo         = %[3]q
+    account_password_wo_version = %[5]d
+  }
+
+  depends_on = [
+    aws_directory_service_directory.test,
+  ]
+}
+
+resource "aws_directory_service_directory" "test" {
+  name     = %[1]q
+  password = %[3]q
+  edition  = "Standard"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, domain, userName, password, orgUnitDN, passwordWOVersion))
+}
@@ -378,6 +378,9 @@ func waitAgentAliasUpdated(ctx context.Context, conn *bedrockagent.Client, agent
 	return nil, err
 }
 
+// @AutoFlexCheck(expand="github.com/aws/aws-sdk-go-v2/service/bedrockagent;bedrockagent.CreateAgentAliasInput", ignore="ClientToken")
+// @AutoFlexCheck(expand="github.com/aws/aws-sdk-go-v2/service/bedrockagent;bedrockagent.UpdateAgentAliasInput", ignore="AliasInvocationState")
+// @AutoFlexCheck(flatten="github.com/aws/aws-sdk-go-v2/service/bedrockagent/types;awstypes.AgentAlias", ignore="AgentAliasHistoryEvents;AgentAliasStatus;AliasInvocationState;ClientToken;CreatedAt;FailureReasons;UpdatedAt")
 type agentAliasResourceModel struct {
 	framework.WithRegionModel
 	AgentAliasARN        types.String                                                                 `tfsdk:"agent_alias_arn"`
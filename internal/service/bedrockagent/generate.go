@@ -3,6 +3,7 @@
 
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/identitytests/main.go
+//go:generate go run ../../generate/autoflexchecks/main.go
 //go:generate go run ../../generate/tags/main.go -ServiceTagsMap -KVTValues -ListTags -UpdateTags
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
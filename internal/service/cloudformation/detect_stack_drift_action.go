@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_cloudformation_detect_stack_drift, name="Detect Stack Drift")
+func newDetectStackDriftAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a detectStackDriftAction
+	a.SetDefaultInvokeTimeout(30 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action = (*detectStackDriftAction)(nil)
+)
+
+type detectStackDriftAction struct {
+	framework.ActionWithModel[detectStackDriftActionModel]
+	framework.ActionWithTimeouts
+}
+
+type detectStackDriftActionModel struct {
+	framework.WithRegionModel
+	FailOnDrift        types.Bool           `tfsdk:"fail_on_drift"`
+	LogicalResourceIDs fwtypes.ListOfString `tfsdk:"logical_resource_ids"`
+	StackName          types.String         `tfsdk:"stack_name"`
+	Timeouts           timeouts.Value       `tfsdk:"timeouts"`
+}
+
+func (a *detectStackDriftAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Detects drift of a CloudFormation stack, waits for detection to complete and summarizes the drifted resources.",
+		Attributes: map[string]schema.Attribute{
+			"fail_on_drift": schema.BoolAttribute{
+				Description: "Whether the action fails when drift is detected.",
+				Optional:    true,
+			},
+			"logical_resource_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "Logical IDs of the resources to check. Defaults to all resources in the stack.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 200),
+				},
+			},
+			"stack_name": schema.StringAttribute{
+				Description: "Name or ID of the stack.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *detectStackDriftAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config detectStackDriftActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+
+	// Get AWS client
+	conn := a.Meta().CloudFormationClient(ctx)
+
+	stackName := fwflex.StringValueFromFramework(ctx, config.StackName)
+
+	ctx = tflog.SetField(ctx, "stack_name", stackName)
+
+	tflog.Info(ctx, "Starting CloudFormation detect stack drift action", map[string]any{
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting drift detection for CloudFormation stack %s...", stackName)
+
+	var input cloudformation.DetectStackDriftInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.DetectStackDrift(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Detect Stack Drift",
+			fmt.Sprintf("Could not start drift detection for CloudFormation stack %s: %s", stackName, err),
+		)
+		return
+	}
+
+	detectionID := aws.ToString(output.StackDriftDetectionId)
+	cb(ctx, "Drift detection %s started for CloudFormation stack %s, waiting for completion...", detectionID, stackName)
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput], error) {
+		output, err := findStackDriftDetectionStatusByID(ctx, conn, detectionID)
+		if err != nil {
+			return actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput]{}, fmt.Errorf("describing drift detection status: %w", err)
+		}
+		return actionwait.FetchResult[*cloudformation.DescribeStackDriftDetectionStatusOutput]{Status: actionwait.Status(output.DetectionStatus), Value: output}, nil
+	}, actionwait.Options[*cloudformation.DescribeStackDriftDetectionStatusOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(actionwait.DefaultPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.StackDriftDetectionStatusDetectionComplete),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.StackDriftDetectionStatusDetectionInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.StackDriftDetectionStatusDetectionFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Drift detection is currently %q (elapsed %s), continuing to wait for completion...", fr.Status, meta.Elapsed.Truncate(time.Second))
+		},
+	})
+
+	if err != nil {
+		switch {
+		case errs.IsA[*actionwait.TimeoutError](err):
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Drift Detection to Complete",
+				fmt.Sprintf("Drift detection %s for CloudFormation stack %s did not complete within %s: %s", detectionID, stackName, timeout, err),
+			)
+		case errs.IsA[*actionwait.FailureStateError](err):
+			detail := fmt.Sprintf("Drift detection %s for CloudFormation stack %s failed: %s", detectionID, stackName, err)
+			if fr.Value != nil && fr.Value.DetectionStatusReason != nil {
+				detail = fmt.Sprintf("%s\n\n%s", detail, aws.ToString(fr.Value.DetectionStatusReason))
+			}
+			resp.Diagnostics.AddError(
+				"Drift Detection Failed",
+				detail,
+			)
+		case errs.IsA[*actionwait.UnexpectedStateError](err):
+			resp.Diagnostics.AddError(
+				"Unexpected Drift Detection State",
+				fmt.Sprintf("Drift detection %s for CloudFormation stack %s entered unexpected state: %s", detectionID, stackName, err),
+			)
+		default:
+			resp.Diagnostics.AddError(
+				"Failed While Waiting for Drift Detection to Complete",
+				fmt.Sprintf("Error waiting for drift detection %s for CloudFormation stack %s: %s", detectionID, stackName, err),
+			)
+		}
+		return
+	}
+
+	if fr.Value.StackDriftStatus != awstypes.StackDriftStatusDrifted {
+		cb(ctx, "Drift detection for CloudFormation stack %s completed, stack drift status is %s", stackName, fr.Value.StackDriftStatus)
+		tflog.Info(ctx, "CloudFormation detect stack drift action completed successfully", map[string]any{
+			"drift_status": fr.Value.StackDriftStatus,
+		})
+		return
+	}
+
+	drifts, err := findStackResourceDriftsByName(ctx, conn, aws.ToString(fr.Value.StackId), enum.EnumSlice(awstypes.StackResourceDriftStatusModified, awstypes.StackResourceDriftStatusDeleted))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Stack Resource Drifts",
+			fmt.Sprintf("Could not read resource drifts for CloudFormation stack %s: %s", stackName, err),
+		)
+		return
+	}
+
+	summary := fmt.Sprintf("CloudFormation stack %s has %d drifted resources:\n%s", stackName, aws.ToInt32(fr.Value.DriftedStackResourceCount), stackResourceDriftsSummary(drifts))
+
+	if config.FailOnDrift.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Stack Drift Detected",
+			summary,
+		)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "%s", summary)
+
+	tflog.Info(ctx, "CloudFormation detect stack drift action completed successfully", map[string]any{
+		"drift_status":            fr.Value.StackDriftStatus,
+		"drifted_resources_count": aws.ToInt32(fr.Value.DriftedStackResourceCount),
+	})
+}
+
+func findStackDriftDetectionStatusByID(ctx context.Context, conn *cloudformation.Client, id string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: aws.String(id),
+	}
+
+	output, err := conn.DescribeStackDriftDetectionStatus(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
+func findStackResourceDriftsByName(ctx context.Context, conn *cloudformation.Client, stackName string, statuses []awstypes.StackResourceDriftStatus) ([]awstypes.StackResourceDrift, error) {
+	input := cloudformation.DescribeStackResourceDriftsInput{
+		StackName:                       aws.String(stackName),
+		StackResourceDriftStatusFilters: statuses,
+	}
+	var output []awstypes.StackResourceDrift
+
+	pages := cloudformation.NewDescribeStackResourceDriftsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.StackResourceDrifts...)
+	}
+
+	return output, nil
+}
+
+func stackResourceDriftsSummary(drifts []awstypes.StackResourceDrift) string {
+	var sb strings.Builder
+
+	for _, drift := range drifts {
+		fmt.Fprintf(&sb, "  - %s (%s, %s): %s\n", aws.ToString(drift.LogicalResourceId), aws.ToString(drift.ResourceType), aws.ToString(drift.PhysicalResourceId), drift.StackResourceDriftStatus)
+		for _, diff := range drift.PropertyDifferences {
+			fmt.Fprintf(&sb, "      %s %s: expected %s, actual %s\n", aws.ToString(diff.PropertyPath), diff.DifferenceType, aws.ToString(diff.ExpectedValue), aws.ToString(diff.ActualValue))
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
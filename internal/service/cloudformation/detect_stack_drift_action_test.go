@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationDetectStackDriftAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckStackDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectStackDriftActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackExists(ctx, t, resourceName, &stack),
+					testAccCheckDetectStackDriftActionStatus(&stack, awstypes.StackDriftStatusInSync),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationDetectStackDriftAction_failOnDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckStackDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDetectStackDriftActionConfig_failOnDrift(rName),
+				ExpectError: regexache.MustCompile(`Stack Drift Detected`),
+			},
+		},
+	})
+}
+
+// testAccCheckDetectStackDriftActionStatus verifies that drift detection has run and recorded the expected stack drift status
+func testAccCheckDetectStackDriftActionStatus(v *awstypes.Stack, want awstypes.StackDriftStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.DriftInformation == nil || v.DriftInformation.LastCheckTimestamp == nil {
+			return fmt.Errorf("CloudFormation Stack (%s) drift has not been checked", aws.ToString(v.StackName))
+		}
+
+		if got := v.DriftInformation.StackDriftStatus; got != want {
+			return fmt.Errorf("CloudFormation Stack (%s) drift status is %s, want %s", aws.ToString(v.StackName), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDetectStackDriftActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name = %[1]q
+
+  template_body = jsonencode({
+    Resources = {
+      MyVPC = {
+        Type = "AWS::EC2::VPC"
+        Properties = {
+          CidrBlock = "10.0.0.0/16"
+          Tags = [
+            { Key = "Name", Value = %[1]q }
+          ]
+        }
+      }
+    }
+    Outputs = {
+      VpcID = {
+        Value = { Ref = "MyVPC" }
+      }
+    }
+  })
+}
+`, rName)
+}
+
+func testAccDetectStackDriftActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDetectStackDriftActionConfig_base(rName), `
+action "aws_cloudformation_detect_stack_drift" "test" {
+  config {
+    stack_name = aws_cloudformation_stack.test.name
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_cloudformation_stack.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudformation_detect_stack_drift.test]
+    }
+  }
+}
+`)
+}
+
+func testAccDetectStackDriftActionConfig_failOnDrift(rName string) string {
+	return acctest.ConfigCompose(testAccDetectStackDriftActionConfig_base(rName), `
+# Change the VPC's Name tag outside of CloudFormation.
+resource "aws_ec2_tag" "test" {
+  resource_id = aws_cloudformation_stack.test.outputs["VpcID"]
+  key         = "Name"
+  value       = "drifted"
+}
+
+action "aws_cloudformation_detect_stack_drift" "test" {
+  config {
+    stack_name           = aws_cloudformation_stack.test.name
+    logical_resource_ids = ["MyVPC"]
+    fail_on_drift        = true
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_ec2_tag.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudformation_detect_stack_drift.test]
+    }
+  }
+}
+`)
+}
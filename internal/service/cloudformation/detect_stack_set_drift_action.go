@@ -0,0 +1,391 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_cloudformation_detect_stack_set_drift, name="Detect Stack Set Drift")
+func newDetectStackSetDriftAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a detectStackSetDriftAction
+	a.SetDefaultInvokeTimeout(60 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action = (*detectStackSetDriftAction)(nil)
+)
+
+type detectStackSetDriftAction struct {
+	framework.ActionWithModel[detectStackSetDriftActionModel]
+	framework.ActionWithTimeouts
+}
+
+type detectStackSetDriftActionModel struct {
+	framework.WithRegionModel
+	CallAs               fwtypes.StringEnum[awstypes.CallAs]                        `tfsdk:"call_as"`
+	FailOnDrift          types.Bool                                                 `tfsdk:"fail_on_drift"`
+	OperationPreferences fwtypes.ListNestedObjectValueOf[operationPreferencesModel] `tfsdk:"operation_preferences"`
+	StackSetName         types.String                                               `tfsdk:"stack_set_name"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type operationPreferencesModel struct {
+	ConcurrencyMode            fwtypes.StringEnum[awstypes.ConcurrencyMode]       `tfsdk:"concurrency_mode"`
+	FailureToleranceCount      types.Int32                                        `tfsdk:"failure_tolerance_count"`
+	FailureTolerancePercentage types.Int32                                        `tfsdk:"failure_tolerance_percentage"`
+	MaxConcurrentCount         types.Int32                                        `tfsdk:"max_concurrent_count"`
+	MaxConcurrentPercentage    types.Int32                                        `tfsdk:"max_concurrent_percentage"`
+	RegionConcurrencyType      fwtypes.StringEnum[awstypes.RegionConcurrencyType] `tfsdk:"region_concurrency_type"`
+	RegionOrder                fwtypes.ListOfString                               `tfsdk:"region_order"`
+}
+
+func (a *detectStackSetDriftAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Detects drift of the stack instances in a CloudFormation StackSet, waits for the drift detection operation to complete and summarizes the drifted instances.",
+		Attributes: map[string]schema.Attribute{
+			"call_as": callAsActionAttribute(),
+			"fail_on_drift": schema.BoolAttribute{
+				Description: "Whether the action fails when drift is detected.",
+				Optional:    true,
+			},
+			"stack_set_name": schema.StringAttribute{
+				Description: "Name or ID of the StackSet.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"operation_preferences": operationPreferencesActionBlock(ctx),
+			names.AttrTimeouts:      timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *detectStackSetDriftAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config detectStackSetDriftActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+
+	// Get AWS client
+	conn := a.Meta().CloudFormationClient(ctx)
+
+	stackSetName := fwflex.StringValueFromFramework(ctx, config.StackSetName)
+	callAs := config.CallAs.ValueString()
+
+	ctx = tflog.SetField(ctx, "stack_set_name", stackSetName)
+
+	tflog.Info(ctx, "Starting CloudFormation detect StackSet drift action", map[string]any{
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting drift detection for CloudFormation StackSet %s...", stackSetName)
+
+	var input cloudformation.DetectStackSetDriftInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.DetectStackSetDrift(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Detect StackSet Drift",
+			fmt.Sprintf("Could not start drift detection for CloudFormation StackSet %s: %s", stackSetName, err),
+		)
+		return
+	}
+
+	operationID := aws.ToString(output.OperationId)
+	ctx = tflog.SetField(ctx, "operation_id", operationID)
+	cb(ctx, "Drift detection operation %s started for CloudFormation StackSet %s, waiting for completion...", operationID, stackSetName)
+
+	operation, diags := waitStackSetOperationSucceededWithProgress(ctx, conn, stackSetName, operationID, callAs, timeout, cb)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	details := operation.StackSetDriftDetectionDetails
+	if details == nil || details.DriftStatus != awstypes.StackSetDriftStatusDrifted {
+		var driftStatus awstypes.StackSetDriftStatus
+		if details != nil {
+			driftStatus = details.DriftStatus
+		}
+
+		cb(ctx, "Drift detection for CloudFormation StackSet %s completed, StackSet drift status is %s", stackSetName, driftStatus)
+		tflog.Info(ctx, "CloudFormation detect StackSet drift action completed successfully", map[string]any{
+			"drift_status": driftStatus,
+		})
+		return
+	}
+
+	instances, err := findStackInstancesByDriftStatus(ctx, conn, stackSetName, callAs, awstypes.StackDriftStatusDrifted)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Drifted Stack Instances",
+			fmt.Sprintf("Could not list drifted stack instances for CloudFormation StackSet %s: %s", stackSetName, err),
+		)
+		return
+	}
+
+	summary := fmt.Sprintf("CloudFormation StackSet %s has %d of %d stack instances drifted (%d in sync, %d failed):\n%s", stackSetName,
+		aws.ToInt32(details.DriftedStackInstancesCount),
+		aws.ToInt32(details.TotalStackInstancesCount),
+		aws.ToInt32(details.InSyncStackInstancesCount),
+		aws.ToInt32(details.FailedStackInstancesCount),
+		stackInstancesSummary(instances),
+	)
+
+	if config.FailOnDrift.ValueBool() {
+		resp.Diagnostics.AddError(
+			"StackSet Drift Detected",
+			summary,
+		)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "%s", summary)
+
+	tflog.Info(ctx, "CloudFormation detect StackSet drift action completed successfully", map[string]any{
+		"drift_status":            details.DriftStatus,
+		"drifted_instances_count": aws.ToInt32(details.DriftedStackInstancesCount),
+	})
+}
+
+func callAsActionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType:  fwtypes.StringEnumType[awstypes.CallAs](),
+		Description: "Whether you are acting as an account administrator in the organization's management account or as a delegated administrator in a member account. Valid values are 'SELF' and 'DELEGATED_ADMIN'. Defaults to 'SELF'.",
+		Optional:    true,
+	}
+}
+
+func operationPreferencesActionBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[operationPreferencesModel](ctx),
+		Description: "Preferences for how CloudFormation performs the StackSet operation.",
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"concurrency_mode": schema.StringAttribute{
+					CustomType:  fwtypes.StringEnumType[awstypes.ConcurrencyMode](),
+					Description: "How the concurrency level behaves during the operation. Valid values are 'STRICT_FAILURE_TOLERANCE' and 'SOFT_FAILURE_TOLERANCE'.",
+					Optional:    true,
+				},
+				"failure_tolerance_count": schema.Int32Attribute{
+					Description: "Number of accounts, per Region, for which the operation can fail before CloudFormation stops the operation in that Region.",
+					Optional:    true,
+					Validators: []validator.Int32{
+						int32validator.AtLeast(0),
+						int32validator.ConflictsWith(path.MatchRelative().AtParent().AtName("failure_tolerance_percentage")),
+					},
+				},
+				"failure_tolerance_percentage": schema.Int32Attribute{
+					Description: "Percentage of accounts, per Region, for which the operation can fail before CloudFormation stops the operation in that Region.",
+					Optional:    true,
+					Validators: []validator.Int32{
+						int32validator.Between(0, 100),
+						int32validator.ConflictsWith(path.MatchRelative().AtParent().AtName("failure_tolerance_count")),
+					},
+				},
+				"max_concurrent_count": schema.Int32Attribute{
+					Description: "Maximum number of accounts in which to perform the operation at one time.",
+					Optional:    true,
+					Validators: []validator.Int32{
+						int32validator.AtLeast(1),
+						int32validator.ConflictsWith(path.MatchRelative().AtParent().AtName("max_concurrent_percentage")),
+					},
+				},
+				"max_concurrent_percentage": schema.Int32Attribute{
+					Description: "Maximum percentage of accounts in which to perform the operation at one time.",
+					Optional:    true,
+					Validators: []validator.Int32{
+						int32validator.Between(1, 100),
+						int32validator.ConflictsWith(path.MatchRelative().AtParent().AtName("max_concurrent_count")),
+					},
+				},
+				"region_concurrency_type": schema.StringAttribute{
+					CustomType:  fwtypes.StringEnumType[awstypes.RegionConcurrencyType](),
+					Description: "Concurrency type of deploying StackSets operations in Regions. Valid values are 'SEQUENTIAL' and 'PARALLEL'.",
+					Optional:    true,
+				},
+				"region_order": schema.ListAttribute{
+					CustomType:  fwtypes.ListOfStringType,
+					Description: "Order of the Regions where you want to perform the operation.",
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+						listvalidator.ValueStringsAre(
+							stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z-]{1,128}$`), ""),
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+// waitStackSetOperationSucceededWithProgress waits for a StackSet operation to succeed, reporting progress via cb.
+// Failed operations are reported together with the per-instance operation results.
+func waitStackSetOperationSucceededWithProgress(ctx context.Context, conn *cloudformation.Client, stackSetName, operationID, callAs string, timeout time.Duration, cb fwactions.SendProgressFunc) (*awstypes.StackSetOperation, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.StackSetOperation], error) {
+		output, err := findStackSetOperationByThreePartKey(ctx, conn, stackSetName, operationID, callAs)
+
+		// The operation may not be visible immediately after it is started.
+		if retry.NotFound(err) {
+			return actionwait.FetchResult[*awstypes.StackSetOperation]{Status: actionwait.Status(awstypes.StackSetOperationStatusQueued)}, nil
+		}
+
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.StackSetOperation]{}, fmt.Errorf("describing StackSet operation: %w", err)
+		}
+
+		return actionwait.FetchResult[*awstypes.StackSetOperation]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*awstypes.StackSetOperation]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(actionwait.DefaultPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.StackSetOperationStatusSucceeded),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.StackSetOperationStatusQueued),
+			actionwait.Status(awstypes.StackSetOperationStatusRunning),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.StackSetOperationStatusFailed),
+			actionwait.Status(awstypes.StackSetOperationStatusStopping),
+			actionwait.Status(awstypes.StackSetOperationStatusStopped),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "StackSet operation is currently %q (elapsed %s), continuing to wait for completion...", fr.Status, meta.Elapsed.Truncate(time.Second))
+		},
+	})
+
+	if err != nil {
+		switch {
+		case errs.IsA[*actionwait.TimeoutError](err):
+			diags.AddError(
+				"Timeout Waiting for StackSet Operation to Complete",
+				fmt.Sprintf("Operation %s on CloudFormation StackSet %s did not complete within %s: %s", operationID, stackSetName, timeout, err),
+			)
+		case errs.IsA[*actionwait.FailureStateError](err):
+			detail := fmt.Sprintf("Operation %s on CloudFormation StackSet %s failed: %s", operationID, stackSetName, err)
+			if fr.Value != nil && fr.Value.StatusReason != nil {
+				detail = fmt.Sprintf("%s\n\n%s", detail, aws.ToString(fr.Value.StatusReason))
+			}
+			if results, findErr := findStackSetOperationResultsByThreePartKey(ctx, conn, stackSetName, operationID, callAs); findErr == nil {
+				if err := stackSetOperationError(results); err != nil {
+					detail = fmt.Sprintf("%s\n\n%s", detail, err)
+				}
+			}
+			diags.AddError(
+				"StackSet Operation Failed",
+				detail,
+			)
+		case errs.IsA[*actionwait.UnexpectedStateError](err):
+			diags.AddError(
+				"Unexpected StackSet Operation State",
+				fmt.Sprintf("Operation %s on CloudFormation StackSet %s entered unexpected state: %s", operationID, stackSetName, err),
+			)
+		default:
+			diags.AddError(
+				"Failed While Waiting for StackSet Operation to Complete",
+				fmt.Sprintf("Error waiting for operation %s on CloudFormation StackSet %s: %s", operationID, stackSetName, err),
+			)
+		}
+		return nil, diags
+	}
+
+	return fr.Value, diags
+}
+
+func findStackInstancesByDriftStatus(ctx context.Context, conn *cloudformation.Client, stackSetName, callAs string, status awstypes.StackDriftStatus) ([]awstypes.StackInstanceSummary, error) {
+	input := cloudformation.ListStackInstancesInput{
+		Filters: []awstypes.StackInstanceFilter{
+			{
+				Name:   awstypes.StackInstanceFilterNameDriftStatus,
+				Values: aws.String(string(status)),
+			},
+		},
+		StackSetName: aws.String(stackSetName),
+	}
+	if callAs != "" {
+		input.CallAs = awstypes.CallAs(callAs)
+	}
+	var output []awstypes.StackInstanceSummary
+
+	pages := cloudformation.NewListStackInstancesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Summaries...)
+	}
+
+	return output, nil
+}
+
+func stackInstancesSummary(instances []awstypes.StackInstanceSummary) string {
+	var sb strings.Builder
+
+	for _, instance := range instances {
+		fmt.Fprintf(&sb, "  - Account (%s), Region (%s): %s", aws.ToString(instance.Account), aws.ToString(instance.Region), instance.DriftStatus)
+		if v := aws.ToString(instance.StackId); v != "" {
+			fmt.Fprintf(&sb, " (%s)", v)
+		}
+		sb.WriteString("\n")
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
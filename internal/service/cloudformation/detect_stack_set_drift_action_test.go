@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationDetectStackSetDriftAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackSet awstypes.StackSet
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckStackSetInstanceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectStackSetDriftActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetExists(ctx, t, resourceName, &stackSet),
+					testAccCheckDetectStackSetDriftActionStatus(&stackSet, awstypes.StackSetDriftStatusInSync),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckDetectStackSetDriftActionStatus verifies that drift detection has run and recorded the expected StackSet drift status
+func testAccCheckDetectStackSetDriftActionStatus(v *awstypes.StackSet, want awstypes.StackSetDriftStatus) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		details := v.StackSetDriftDetectionDetails
+		if details == nil || details.LastDriftCheckTimestamp == nil {
+			return fmt.Errorf("CloudFormation StackSet (%s) drift has not been checked", aws.ToString(v.StackSetName))
+		}
+
+		if got := details.DriftStatus; got != want {
+			return fmt.Errorf("CloudFormation StackSet (%s) drift status is %s, want %s", aws.ToString(v.StackSetName), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDetectStackSetDriftActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceConfig_basic(rName), `
+action "aws_cloudformation_detect_stack_set_drift" "test" {
+  config {
+    stack_set_name = aws_cloudformation_stack_set_instance.test.stack_set_name
+
+    operation_preferences {
+      failure_tolerance_count = 1
+      max_concurrent_count    = 2
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_cloudformation_stack_set_instance.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudformation_detect_stack_set_drift.test]
+    }
+  }
+}
+`)
+}
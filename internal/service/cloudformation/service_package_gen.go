@@ -20,6 +20,29 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newDetectStackDriftAction,
+			TypeName: "aws_cloudformation_detect_stack_drift",
+			Name:     "Detect Stack Drift",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newDetectStackSetDriftAction,
+			TypeName: "aws_cloudformation_detect_stack_set_drift",
+			Name:     "Detect Stack Set Drift",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newUpdateStackInstancesAction,
+			TypeName: "aws_cloudformation_update_stack_instances",
+			Name:     "Update Stack Instances",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{}
 }
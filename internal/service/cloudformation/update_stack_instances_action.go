@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_cloudformation_update_stack_instances, name="Update Stack Instances")
+func newUpdateStackInstancesAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a updateStackInstancesAction
+	a.SetDefaultInvokeTimeout(60 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action                     = (*updateStackInstancesAction)(nil)
+	_ action.ActionWithConfigValidators = (*updateStackInstancesAction)(nil)
+)
+
+type updateStackInstancesAction struct {
+	framework.ActionWithModel[updateStackInstancesActionModel]
+	framework.ActionWithTimeouts
+}
+
+type updateStackInstancesActionModel struct {
+	framework.WithRegionModel
+	Accounts             fwtypes.SetOfString                                        `tfsdk:"accounts"`
+	CallAs               fwtypes.StringEnum[awstypes.CallAs]                        `tfsdk:"call_as"`
+	DeploymentTargets    fwtypes.ListNestedObjectValueOf[deploymentTargetsModel]    `tfsdk:"deployment_targets"`
+	OperationPreferences fwtypes.ListNestedObjectValueOf[operationPreferencesModel] `tfsdk:"operation_preferences"`
+	ParameterOverrides   fwtypes.MapOfString                                        `tfsdk:"parameter_overrides" autoflex:"-"`
+	Regions              fwtypes.SetOfString                                        `tfsdk:"regions"`
+	StackSetName         types.String                                               `tfsdk:"stack_set_name"`
+	Timeouts             timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+type deploymentTargetsModel struct {
+	AccountFilterType     fwtypes.StringEnum[awstypes.AccountFilterType] `tfsdk:"account_filter_type"`
+	Accounts              fwtypes.SetOfString                            `tfsdk:"accounts"`
+	OrganizationalUnitIDs fwtypes.SetOfString                            `tfsdk:"organizational_unit_ids"`
+}
+
+func (a *updateStackInstancesAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Re-deploys existing stack instances of a CloudFormation StackSet, optionally overriding parameter values, and waits for the StackSet operation to complete.",
+		Attributes: map[string]schema.Attribute{
+			"accounts": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Description: "IDs of the accounts whose stack instances are updated. Conflicts with deployment_targets.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+				},
+			},
+			"call_as": callAsActionAttribute(),
+			"parameter_overrides": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				Description: "StackSet parameter values to override in the stack instances. Parameters that are not specified keep their current overridden values.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Description: "Regions whose stack instances are updated.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"stack_set_name": schema.StringAttribute{
+				Description: "Name or ID of the StackSet.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"deployment_targets": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[deploymentTargetsModel](ctx),
+				Description: "Organizational units, and optionally accounts within them, whose stack instances are updated. Only valid for StackSets with service-managed permissions.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"account_filter_type": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.AccountFilterType](),
+							Description: "How accounts are used to limit the update within the organizational units. Valid values are 'NONE', 'INTERSECTION', 'DIFFERENCE' and 'UNION'.",
+							Optional:    true,
+						},
+						"accounts": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Description: "IDs of the accounts used together with account_filter_type.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+							},
+						},
+						"organizational_unit_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Description: "IDs of the organizational units whose stack instances are updated.",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(
+									stringvalidator.RegexMatches(regexache.MustCompile(`^(ou-[0-9a-z]{4,32}-[0-9a-z]{8,32}|r-[0-9a-z]{4,32})$`), ""),
+								),
+							},
+						},
+					},
+				},
+			},
+			"operation_preferences": operationPreferencesActionBlock(ctx),
+			names.AttrTimeouts:      timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *updateStackInstancesAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			path.MatchRoot("accounts"),
+			path.MatchRoot("deployment_targets"),
+		),
+	}
+}
+
+func (a *updateStackInstancesAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config updateStackInstancesActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+
+	// Get AWS client
+	conn := a.Meta().CloudFormationClient(ctx)
+
+	stackSetName := fwflex.StringValueFromFramework(ctx, config.StackSetName)
+	callAs := config.CallAs.ValueString()
+
+	ctx = tflog.SetField(ctx, "stack_set_name", stackSetName)
+
+	tflog.Info(ctx, "Starting CloudFormation update stack instances action", map[string]any{
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting update of stack instances for CloudFormation StackSet %s...", stackSetName)
+
+	var input cloudformation.UpdateStackInstancesInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input.OperationId = aws.String(create.UniqueId(ctx))
+	// An empty list removes all existing parameter overrides, so only send parameter overrides when configured.
+	if !config.ParameterOverrides.IsNull() {
+		for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, config.ParameterOverrides) {
+			input.ParameterOverrides = append(input.ParameterOverrides, awstypes.Parameter{
+				ParameterKey:   aws.String(k),
+				ParameterValue: aws.String(v),
+			})
+		}
+	}
+
+	output, err := conn.UpdateStackInstances(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Stack Instances",
+			fmt.Sprintf("Could not start update of stack instances for CloudFormation StackSet %s: %s", stackSetName, err),
+		)
+		return
+	}
+
+	operationID := aws.ToString(output.OperationId)
+	ctx = tflog.SetField(ctx, "operation_id", operationID)
+	cb(ctx, "Update operation %s started for CloudFormation StackSet %s, waiting for completion...", operationID, stackSetName)
+
+	operation, diags := waitStackSetOperationSucceededWithProgress(ctx, conn, stackSetName, operationID, callAs, timeout, cb)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Final success message
+	if v := operation.StatusDetails; v != nil && aws.ToInt32(v.FailedStackInstancesCount) > 0 {
+		cb(ctx, "Update of stack instances for CloudFormation StackSet %s completed within the failure tolerance, %d stack instances failed", stackSetName, aws.ToInt32(v.FailedStackInstancesCount))
+	} else {
+		cb(ctx, "Update of stack instances for CloudFormation StackSet %s completed successfully", stackSetName)
+	}
+
+	tflog.Info(ctx, "CloudFormation update stack instances action completed successfully")
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFormationUpdateStackInstancesAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stackInstance awstypes.StackInstance
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack_set_instance.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckStackSet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckStackSetInstanceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateStackInstancesActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStackSetInstanceExists(ctx, t, resourceName, &stackInstance),
+					testAccCheckUpdateStackInstancesActionParameterOverride(&stackInstance, "Parameter1", "actionvalue1"),
+				),
+				// The action overrides a parameter outside of the aws_cloudformation_stack_set_instance resource.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccCheckUpdateStackInstancesActionParameterOverride verifies that the stack instance has the expected parameter override
+func testAccCheckUpdateStackInstancesActionParameterOverride(v *awstypes.StackInstance, key, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, parameter := range v.ParameterOverrides {
+			if aws.ToString(parameter.ParameterKey) == key {
+				if got := aws.ToString(parameter.ParameterValue); got != want {
+					return fmt.Errorf("CloudFormation Stack Instance (%s) parameter %s is %q, want %q", aws.ToString(v.StackId), key, got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("CloudFormation Stack Instance (%s) has no override for parameter %s", aws.ToString(v.StackId), key)
+	}
+}
+
+func testAccUpdateStackInstancesActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccStackSetInstanceConfig_basic(rName), `
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+action "aws_cloudformation_update_stack_instances" "test" {
+  config {
+    stack_set_name = aws_cloudformation_stack_set_instance.test.stack_set_name
+    accounts       = [data.aws_caller_identity.current.account_id]
+    regions        = [data.aws_region.current.region]
+
+    parameter_overrides = {
+      Parameter1 = "actionvalue1"
+    }
+
+    operation_preferences {
+      failure_tolerance_percentage = 0
+      max_concurrent_percentage    = 100
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_cloudformation_stack_set_instance.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudformation_update_stack_instances.test]
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+// @EphemeralResource(aws_cloudfront_signed_cookies, name="Signed Cookies")
+func newSignedCookiesEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signedCookiesEphemeralResource{}, nil
+}
+
+type signedCookiesEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signedCookiesEphemeralResourceModel]
+}
+
+func (e *signedCookiesEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := signingAttributes()
+	attributes["cookies"] = schema.MapAttribute{
+		CustomType: fwtypes.MapOfStringType,
+		Computed:   true,
+		Sensitive:  true,
+	}
+	attributes["resource"] = schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *signedCookiesEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := signedCookiesEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	signer, err := newCloudFrontSigner(ctx, e.Meta().CloudFrontClient(ctx), &data.signingModel)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	signature, err := signer.sign(data.Resource.ValueString())
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	data.Cookies = fwflex.FlattenFrameworkStringValueMapOfString(ctx, signature.cookies())
+	data.ExpiresAt = types.StringValue(signer.expiresAt.Format(time.RFC3339))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type signedCookiesEphemeralResourceModel struct {
+	signingModel
+	Cookies  fwtypes.MapOfString `tfsdk:"cookies"`
+	Resource types.String        `tfsdk:"resource"`
+}
@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontSignedCookiesEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	publicKey := acctest.TLSRSAPublicKeyPEM(t, privateKey)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedCookiesEphemeralConfig_basic(rName, privateKey, publicKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("cookies"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"CloudFront-Key-Pair-Id": knownvalue.NotNull(),
+						"CloudFront-Policy":      knownvalue.NotNull(),
+						"CloudFront-Signature":   knownvalue.NotNull(),
+					})),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func testAccSignedCookiesEphemeralConfig_basic(rName, privateKey, publicKey string) string {
+	return acctest.ConfigCompose(
+		testAccSignedURLEphemeralConfig_base(rName, publicKey),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_cookies.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_cookies" "test" {
+  resource     = "https://example.cloudfront.net/private/*"
+  key_group_id = aws_cloudfront_key_group.test.id
+  key_pair_id  = aws_cloudfront_public_key.test.id
+  private_key  = "%[1]s"
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
@@ -0,0 +1,325 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/cloudfront/sign"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	signedURLDefaultExpiresIn = 3600 // 1 hour.
+)
+
+// @EphemeralResource(aws_cloudfront_signed_url, name="Signed URL")
+func newSignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signedURLEphemeralResource{}, nil
+}
+
+type signedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signedURLEphemeralResourceModel]
+}
+
+func (e *signedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	attributes := signingAttributes()
+	attributes["signed_url"] = schema.StringAttribute{
+		Computed:  true,
+		Sensitive: true,
+	}
+	attributes[names.AttrURL] = schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *signedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := signedURLEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rawURL := data.URL.ValueString()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("parsing URL (%s): %w", rawURL, err))
+		return
+	}
+
+	signer, err := newCloudFrontSigner(ctx, e.Meta().CloudFrontClient(ctx), &data.signingModel)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	signature, err := signer.sign(rawURL)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	// The signature covers the URL as given, so its query string must be kept as-is.
+	if u.RawQuery == "" {
+		u.RawQuery = signature.query()
+	} else {
+		u.RawQuery += "&" + signature.query()
+	}
+
+	data.ExpiresAt = types.StringValue(signer.expiresAt.Format(time.RFC3339))
+	data.SignedURL = fwflex.StringValueToFramework(ctx, u.String())
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type signedURLEphemeralResourceModel struct {
+	signingModel
+	SignedURL types.String `tfsdk:"signed_url"`
+	URL       types.String `tfsdk:"url"`
+}
+
+// signingAttributes returns the schema attributes common to CloudFront signed URLs and signed cookies.
+func signingAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"expires_at": schema.StringAttribute{
+			Computed: true,
+		},
+		"expires_in": schema.Int32Attribute{
+			Optional: true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(1),
+			},
+		},
+		names.AttrIPAddress: schema.StringAttribute{
+			CustomType: fwtypes.CIDRBlockType,
+			Optional:   true,
+		},
+		"key_group_id": schema.StringAttribute{
+			Optional: true,
+		},
+		"key_pair_id": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+		"not_before": schema.StringAttribute{
+			CustomType: timetypes.RFC3339Type{},
+			Optional:   true,
+		},
+		names.AttrPrivateKey: schema.StringAttribute{
+			Required:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+type signingModel struct {
+	ExpiresAt  types.String      `tfsdk:"expires_at"`
+	ExpiresIn  types.Int32       `tfsdk:"expires_in"`
+	IPAddress  fwtypes.CIDRBlock `tfsdk:"ip_address"`
+	KeyGroupID types.String      `tfsdk:"key_group_id"`
+	KeyPairID  types.String      `tfsdk:"key_pair_id"`
+	NotBefore  timetypes.RFC3339 `tfsdk:"not_before"`
+	PrivateKey types.String      `tfsdk:"private_key"`
+}
+
+// cloudFrontSigner signs CloudFront policies.
+// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/PrivateContent.html.
+type cloudFrontSigner struct {
+	expiresAt  time.Time
+	ipAddress  string
+	keyPairID  string
+	notBefore  *time.Time
+	privateKey *rsa.PrivateKey
+}
+
+type cloudFrontSignature struct {
+	expires   *int64 // Set for canned policies.
+	keyPairID string
+	policy    string // Set for custom policies.
+	signature string
+}
+
+// newCloudFrontSigner validates the signing configuration and returns a signer for it.
+// If a key group is specified, the key pair ID must identify one of the group's public keys.
+func newCloudFrontSigner(ctx context.Context, conn *cloudfront.Client, data *signingModel) (*cloudFrontSigner, error) {
+	keyPairID := data.KeyPairID.ValueString()
+
+	if keyGroupID := data.KeyGroupID.ValueString(); keyGroupID != "" {
+		output, err := findKeyGroupByID(ctx, conn, keyGroupID)
+		if err != nil {
+			return nil, fmt.Errorf("reading CloudFront Key Group (%s): %w", keyGroupID, err)
+		}
+
+		if !slices.Contains(output.KeyGroup.KeyGroupConfig.Items, keyPairID) {
+			return nil, fmt.Errorf("CloudFront Public Key (%s) is not in Key Group (%s)", keyPairID, keyGroupID)
+		}
+	}
+
+	privateKey, err := parseSigningPrivateKey(data.PrivateKey.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	expiresIn := time.Duration(signedURLDefaultExpiresIn) * time.Second
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt32()) * time.Second
+	}
+
+	signer := &cloudFrontSigner{
+		expiresAt:  time.Now().UTC().Add(expiresIn),
+		ipAddress:  data.IPAddress.ValueString(),
+		keyPairID:  keyPairID,
+		privateKey: privateKey,
+	}
+
+	if !data.NotBefore.IsNull() {
+		v, diags := data.NotBefore.ValueRFC3339Time()
+		if diags.HasError() {
+			return nil, fmt.Errorf("parsing not_before: %s", diags.Errors()[0].Detail())
+		}
+		signer.notBefore = &v
+	}
+
+	return signer, nil
+}
+
+// sign returns the signature for the specified resource, which may contain wildcards.
+// A canned policy is used unless the resource contains wildcards or the policy has additional conditions.
+func (s *cloudFrontSigner) sign(resource string) (*cloudFrontSignature, error) {
+	var policy *sign.Policy
+	canned := s.notBefore == nil && s.ipAddress == "" && !strings.Contains(resource, "*")
+	if canned {
+		policy = sign.NewCannedPolicy(resource, s.expiresAt)
+	} else {
+		condition := sign.Condition{
+			DateLessThan: sign.NewAWSEpochTime(s.expiresAt),
+		}
+		if s.notBefore != nil {
+			condition.DateGreaterThan = sign.NewAWSEpochTime(*s.notBefore)
+		}
+		if s.ipAddress != "" {
+			condition.IPAddress = &sign.IPAddress{SourceIP: s.ipAddress}
+		}
+		policy = &sign.Policy{
+			Statements: []sign.Statement{{
+				Resource:  resource,
+				Condition: condition,
+			}},
+		}
+	}
+
+	signature, encodedPolicy, err := policy.Sign(s.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("signing CloudFront policy: %w", err)
+	}
+
+	v := &cloudFrontSignature{
+		keyPairID: s.keyPairID,
+		signature: string(signature),
+	}
+	if canned {
+		expires := s.expiresAt.Unix()
+		v.expires = &expires
+	} else {
+		v.policy = string(encodedPolicy)
+	}
+
+	return v, nil
+}
+
+// parameters returns the signature's parameters in the order CloudFront documents them.
+func (s *cloudFrontSignature) parameters() [][2]string {
+	var params [][2]string
+	if s.expires != nil {
+		params = append(params, [2]string{"Expires", strconv.FormatInt(*s.expires, 10)})
+	} else {
+		params = append(params, [2]string{"Policy", s.policy})
+	}
+	params = append(params, [2]string{"Signature", s.signature}, [2]string{"Key-Pair-Id", s.keyPairID})
+
+	return params
+}
+
+// query returns the signature's URL query string parameters.
+func (s *cloudFrontSignature) query() string {
+	var params []string
+	for _, p := range s.parameters() {
+		params = append(params, p[0]+"="+url.QueryEscape(p[1]))
+	}
+
+	return strings.Join(params, "&")
+}
+
+// cookies returns the signature's cookies.
+func (s *cloudFrontSignature) cookies() map[string]string {
+	m := make(map[string]string)
+	for _, p := range s.parameters() {
+		m["CloudFront-"+p[0]] = p[1]
+	}
+
+	return m
+}
+
+func parseSigningPrivateKey(v string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(v))
+	if block == nil {
+		return nil, errors.New("decoding private key: no PEM data found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing private key: %w", err)
+		}
+
+		return privateKey, nil
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing private key: %w", err)
+		}
+
+		privateKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("parsing private key: unsupported key type %T, must be RSA", key)
+		}
+
+		return privateKey, nil
+	default:
+		return nil, fmt.Errorf("parsing private key: unsupported PEM block type %q", block.Type)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontSignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	publicKey := acctest.TLSRSAPublicKeyPEM(t, privateKey)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralConfig_basic(rName, privateKey, publicKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`^https://example\.cloudfront\.net/private/object\.txt\?Expires=\d+&Signature=[\w~-]+&Key-Pair-Id=\w+$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontSignedURLEphemeral_customPolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	privateKey := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	publicKey := acctest.TLSRSAPublicKeyPEM(t, privateKey)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignedURLEphemeralConfig_customPolicy(rName, privateKey, publicKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_url"), knownvalue.StringRegexp(regexache.MustCompile(`\?Policy=[\w~-]+&Signature=[\w~-]+&Key-Pair-Id=\w+$`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccSignedURLEphemeralConfig_base(rName, publicKey string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_public_key" "test" {
+  encoded_key = "%[2]s"
+  name        = %[1]q
+}
+
+resource "aws_cloudfront_key_group" "test" {
+  items = [aws_cloudfront_public_key.test.id]
+  name  = %[1]q
+}
+`, rName, acctest.TLSPEMEscapeNewlines(publicKey))
+}
+
+func testAccSignedURLEphemeralConfig_basic(rName, privateKey, publicKey string) string {
+	return acctest.ConfigCompose(
+		testAccSignedURLEphemeralConfig_base(rName, publicKey),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url          = "https://example.cloudfront.net/private/object.txt"
+  key_group_id = aws_cloudfront_key_group.test.id
+  key_pair_id  = aws_cloudfront_public_key.test.id
+  private_key  = "%[1]s"
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
+
+func testAccSignedURLEphemeralConfig_customPolicy(rName, privateKey, publicKey string) string {
+	return acctest.ConfigCompose(
+		testAccSignedURLEphemeralConfig_base(rName, publicKey),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_cloudfront_signed_url.test"),
+		fmt.Sprintf(`
+ephemeral "aws_cloudfront_signed_url" "test" {
+  url         = "https://example.cloudfront.net/private/object.txt"
+  key_pair_id = aws_cloudfront_public_key.test.id
+  private_key = "%[1]s"
+  expires_in  = 600
+  ip_address  = "192.0.2.0/24"
+}
+`, acctest.TLSPEMEscapeNewlines(privateKey)))
+}
@@ -39,6 +39,7 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_metric_alarm", name="Metric Alarm")
+// @ArnFormat("alarm:{alarm_name}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatch/types;awstypes;awstypes.MetricAlarm")
 // @IdentityAttribute("alarm_name")
@@ -18,6 +18,10 @@ func TestAccCodeArtifact_serial(t *testing.T) {
 			"duration":      testAccAuthorizationTokenDataSource_duration,
 			"owner":         testAccAuthorizationTokenDataSource_owner,
 		},
+		"AuthorizationTokenEphemeral": {
+			acctest.CtBasic: testAccAuthorizationTokenEphemeral_basic,
+			"duration":      testAccAuthorizationTokenEphemeral_duration,
+		},
 		"Domain": {
 			acctest.CtBasic:                 testAccDomain_basic,
 			"defaultEncryptionKey":          testAccDomain_defaultEncryptionKey,
@@ -12,12 +12,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codebuild"
 	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -54,10 +56,25 @@ func resourceSourceCredential() *schema.Resource {
 					ValidateDiagFunc: enum.Validate[types.ServerType](),
 				},
 				"token": {
-					Type:      schema.TypeString,
-					Required:  true,
-					ForceNew:  true,
-					Sensitive: true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"token", "token_wo"},
+				},
+				"token_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"token", "token_wo"},
+					RequiredWith: []string{"token_wo_version"},
+				},
+				"token_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{"token_wo"},
 				},
 				names.AttrUserName: {
 					Type:     schema.TypeString,
@@ -77,7 +94,21 @@ func resourceSourceCredentialCreate(ctx context.Context, d *schema.ResourceData,
 	input := &codebuild.ImportSourceCredentialsInput{
 		AuthType:   authType,
 		ServerType: types.ServerType(d.Get("server_type").(string)),
-		Token:      aws.String(d.Get("token").(string)),
+	}
+
+	if v, ok := d.GetOk("token"); ok {
+		input.Token = aws.String(v.(string))
+	}
+
+	// get write-only value from configuration
+	tokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("token_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if tokenWO != "" {
+		input.Token = aws.String(tokenWO)
 	}
 
 	if attr, ok := d.GetOk(names.AttrUserName); ok && authType == types.AuthTypeBasicAuth {
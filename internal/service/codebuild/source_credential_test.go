@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfcodebuild "github.com/hashicorp/terraform-provider-aws/internal/service/codebuild"
@@ -98,6 +99,47 @@ func TestAccCodeBuildSourceCredential_basicAuth(t *testing.T) {
 	})
 }
 
+func TestAccCodeBuildSourceCredential_tokenWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var sourceCredentialsInfo types.SourceCredentialsInfo
+	token := acctest.RandomWithPrefix(t, "token")
+	resourceName := "aws_codebuild_source_credential.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeBuildServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckSourceCredentialDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceCredentialConfig_tokenWriteOnly(token, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceCredentialExists(ctx, t, resourceName, &sourceCredentialsInfo),
+					resource.TestCheckResourceAttr(resourceName, "token", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "token_wo"),
+					resource.TestCheckResourceAttr(resourceName, "token_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccSourceCredentialConfig_tokenWriteOnly(token, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSourceCredentialExists(ctx, t, resourceName, &sourceCredentialsInfo),
+					resource.TestCheckNoResourceAttr(resourceName, "token_wo"),
+					resource.TestCheckResourceAttr(resourceName, "token_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCodeBuildSourceCredential_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var sourceCredentialsInfo types.SourceCredentialsInfo
@@ -222,3 +264,14 @@ resource "aws_codebuild_source_credential" "test" {
 }
 `, token, userName)
 }
+
+func testAccSourceCredentialConfig_tokenWriteOnly(token string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_codebuild_source_credential" "test" {
+  auth_type        = "PERSONAL_ACCESS_TOKEN"
+  server_type      = "GITHUB"
+  token_wo         = %[1]q
+  token_wo_version = %[2]d
+}
+`, token, version)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
 	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -69,11 +71,27 @@ func resourceWebhook() *schema.Resource {
 								ValidateFunc: validation.IsCIDRNetwork(0, 32),
 							},
 							"secret_token": {
-								Type:         schema.TypeString,
+								Type:          schema.TypeString,
+								Optional:      true,
+								ForceNew:      true,
+								Sensitive:     true,
+								ValidateFunc:  validation.StringLenBetween(1, 100),
+								ConflictsWith: []string{"authentication_configuration.0.secret_token_wo"},
+							},
+							"secret_token_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ValidateFunc:  validation.StringLenBetween(1, 100),
+								ConflictsWith: []string{"authentication_configuration.0.secret_token"},
+								RequiredWith:  []string{"authentication_configuration.0.secret_token_wo_version"},
+							},
+							"secret_token_wo_version": {
+								Type:         schema.TypeInt,
 								Optional:     true,
 								ForceNew:     true,
-								Sensitive:    true,
-								ValidateFunc: validation.StringLenBetween(1, 100),
+								RequiredWith: []string{"authentication_configuration.0.secret_token_wo"},
 							},
 						},
 					},
@@ -148,7 +166,20 @@ func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	if v, ok := d.GetOk("authentication_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-		input.Webhook.AuthenticationConfiguration = expandWebhookAuthConfiguration(authType, v.([]any)[0].(map[string]any))
+		tfMap := v.([]any)[0].(map[string]any)
+
+		// get write-only value from configuration
+		secretTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("authentication_configuration").IndexInt(0).GetAttr("secret_token_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if secretTokenWO != "" {
+			tfMap["secret_token"] = secretTokenWO
+		}
+
+		input.Webhook.AuthenticationConfiguration = expandWebhookAuthConfiguration(authType, tfMap)
 	}
 
 	output, err := conn.PutWebhook(ctx, input)
@@ -181,7 +212,7 @@ func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, meta any)
 	webhookDef := webhook.Definition
 	d.Set(names.AttrARN, webhook.Arn)
 	d.Set("authentication", webhookDef.Authentication)
-	if err := d.Set("authentication_configuration", flattenWebhookAuthConfiguration(webhookDef.AuthenticationConfiguration)); err != nil {
+	if err := d.Set("authentication_configuration", flattenWebhookAuthConfiguration(webhookDef.AuthenticationConfiguration, d.Get("authentication_configuration.0.secret_token_wo_version").(int))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting authentication_configuration: %s", err)
 	}
 	if err := d.Set(names.AttrFilter, flattenWebhookFilterRules(webhookDef.Filters)); err != nil {
@@ -216,7 +247,20 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 
 		if v, ok := d.GetOk("authentication_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
-			input.Webhook.AuthenticationConfiguration = expandWebhookAuthConfiguration(authType, v.([]any)[0].(map[string]any))
+			tfMap := v.([]any)[0].(map[string]any)
+
+			// get write-only value from configuration
+			secretTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("authentication_configuration").IndexInt(0).GetAttr("secret_token_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if secretTokenWO != "" {
+				tfMap["secret_token"] = secretTokenWO
+			}
+
+			input.Webhook.AuthenticationConfiguration = expandWebhookAuthConfiguration(authType, tfMap)
 		}
 
 		_, err := conn.PutWebhook(ctx, input)
@@ -302,13 +346,16 @@ func flattenWebhookFilterRules(filters []types.WebhookFilterRule) []any {
 	return results
 }
 
-func flattenWebhookAuthConfiguration(authConfig *types.WebhookAuthConfiguration) []any {
+func flattenWebhookAuthConfiguration(authConfig *types.WebhookAuthConfiguration, secretTokenWOVersion int) []any {
 	conf := map[string]any{}
 	if authConfig.AllowedIPRange != nil {
 		conf["allowed_ip_range"] = aws.ToString(authConfig.AllowedIPRange)
 	}
 
-	if authConfig.SecretToken != nil {
+	// A write-only secret token must not be stored in state.
+	if secretTokenWOVersion != 0 {
+		conf["secret_token_wo_version"] = secretTokenWOVersion
+	} else if authConfig.SecretToken != nil {
 		conf["secret_token"] = aws.ToString(authConfig.SecretToken)
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -33,6 +34,7 @@ func TestAccCodePipelineWebhook_serial(t *testing.T) {
 		"tags":                             testAccWebhook_tags,
 		acctest.CtDisappears:               testAccWebhook_disappears,
 		"UpdateAuthentication_secretToken": testAccWebhook_UpdateAuthentication_secretToken,
+		"secretTokenWriteOnly":             testAccWebhook_secretTokenWriteOnly,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -308,6 +310,53 @@ func testAccWebhook_UpdateAuthentication_secretToken(t *testing.T) {
 	})
 }
 
+func testAccWebhook_secretTokenWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	ghToken := acctest.SkipIfEnvVarNotSet(t, envvar.GithubToken)
+	var v1, v2 types.ListWebhookItem
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_codepipeline_webhook.test"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodePipelineServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebhookDestroy(ctx, t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookConfig_secretTokenWriteOnly(rName, ghToken, "super-secret", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebhookExists(ctx, t, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "authentication_configuration.0.secret_token"),
+					resource.TestCheckNoResourceAttr(resourceName, "authentication_configuration.0.secret_token_wo"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.secret_token_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccWebhookConfig_secretTokenWriteOnly(rName, ghToken, "even-more-secret", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWebhookExists(ctx, t, resourceName, &v2),
+					resource.TestCheckNoResourceAttr(resourceName, "authentication_configuration.0.secret_token"),
+					resource.TestCheckResourceAttr(resourceName, "authentication_configuration.0.secret_token_wo_version", "2"),
+					func(s *terraform.State) error {
+						if aws.ToString(v2.Url) == aws.ToString(v1.Url) {
+							return fmt.Errorf("Codepipeline webhook not recreated when updating authentication_configuration.secret_token_wo_version")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckWebhookExists(ctx context.Context, t *testing.T, n string, v *types.ListWebhookItem) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -616,3 +665,24 @@ resource "aws_codepipeline_webhook" "test" {
 }
 `, rName))
 }
+
+func testAccWebhookConfig_secretTokenWriteOnly(rName, githubToken, secretToken string, secretTokenVersion int) string {
+	return acctest.ConfigCompose(testAccWebhookConfig_base(rName, githubToken), fmt.Sprintf(`
+resource "aws_codepipeline_webhook" "test" {
+  name            = %[1]q
+  authentication  = "GITHUB_HMAC"
+  target_action   = "Source"
+  target_pipeline = aws_codepipeline.test.name
+
+  authentication_configuration {
+    secret_token_wo         = %[2]q
+    secret_token_wo_version = %[3]d
+  }
+
+  filter {
+    json_path    = "$.ref"
+    match_equals = "refs/head/{Branch}"
+  }
+}
+`, rName, secretToken, secretTokenVersion))
+}
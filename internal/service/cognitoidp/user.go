@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -117,7 +118,21 @@ func resourceUser() *schema.Resource {
 					Optional:      true,
 					Sensitive:     true,
 					ValidateFunc:  validation.StringLenBetween(6, 256),
-					ConflictsWith: []string{"temporary_password"},
+					ConflictsWith: []string{"password_wo", "temporary_password", "temporary_password_wo"},
+				},
+				"password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(6, 256),
+					ConflictsWith: []string{names.AttrPassword, "temporary_password", "temporary_password_wo"},
+					RequiredWith:  []string{"password_wo_version"},
+				},
+				"password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"password_wo"},
 				},
 				"preferred_mfa_setting": {
 					Type:     schema.TypeString,
@@ -136,7 +151,21 @@ func resourceUser() *schema.Resource {
 					Sensitive:     true,
 					Optional:      true,
 					ValidateFunc:  validation.StringLenBetween(6, 256),
-					ConflictsWith: []string{names.AttrPassword},
+					ConflictsWith: []string{names.AttrPassword, "password_wo", "temporary_password_wo"},
+				},
+				"temporary_password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(6, 256),
+					ConflictsWith: []string{names.AttrPassword, "password_wo", "temporary_password"},
+					RequiredWith:  []string{"temporary_password_wo_version"},
+				},
+				"temporary_password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"temporary_password_wo"},
 				},
 				names.AttrUserPoolID: {
 					Type:     schema.TypeString,
@@ -193,6 +222,16 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		input.TemporaryPassword = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	temporaryPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("temporary_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if temporaryPasswordWO != "" {
+		input.TemporaryPassword = aws.String(temporaryPasswordWO)
+	}
+
 	if v, ok := d.GetOk(names.AttrAttributes); ok {
 		input.UserAttributes = expandAttributeTypes(v.(map[string]any))
 	}
@@ -222,9 +261,20 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
-	if v, ok := d.GetOk(names.AttrPassword); ok {
+	password := d.Get(names.AttrPassword).(string)
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if passwordWO != "" {
+		password = passwordWO
+	}
+
+	if password != "" {
 		input := &cognitoidentityprovider.AdminSetUserPasswordInput{
-			Password:   aws.String(v.(string)),
+			Password:   aws.String(password),
 			Permanent:  true,
 			Username:   aws.String(username),
 			UserPoolId: aws.String(userPoolID),
@@ -382,6 +432,52 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
+	if d.HasChange("temporary_password_wo_version") {
+		temporaryPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("temporary_password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if temporaryPasswordWO != "" {
+			input := &cognitoidentityprovider.AdminSetUserPasswordInput{
+				Password:   aws.String(temporaryPasswordWO),
+				Permanent:  false,
+				Username:   aws.String(username),
+				UserPoolId: aws.String(userPoolID),
+			}
+
+			_, err := conn.AdminSetUserPassword(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting Cognito User (%s) password: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("password_wo_version") {
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input := &cognitoidentityprovider.AdminSetUserPasswordInput{
+				Password:   aws.String(passwordWO),
+				Permanent:  true,
+				Username:   aws.String(username),
+				UserPoolId: aws.String(userPoolID),
+			}
+
+			_, err := conn.AdminSetUserPassword(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting Cognito User (%s) password: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceUserRead(ctx, d, meta)...)
 }
 
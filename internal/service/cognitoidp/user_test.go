@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
@@ -209,6 +210,45 @@ func TestAccCognitoIDPUser_password(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPUser_passwordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rUserPoolName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rUserName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rUserPassword := acctest.RandString(t, 16)
+	rUserPasswordUpdated := acctest.RandString(t, 16)
+	userResourceName := "aws_cognito_user.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckUserDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_passwordWriteOnly(rUserPoolName, rUserName, rUserPassword, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, t, userResourceName),
+					resource.TestCheckNoResourceAttr(userResourceName, "password_wo"),
+					resource.TestCheckResourceAttr(userResourceName, "password_wo_version", "1"),
+					resource.TestCheckResourceAttr(userResourceName, names.AttrStatus, string(awstypes.UserStatusTypeConfirmed)),
+				),
+			},
+			{
+				Config: testAccUserConfig_passwordWriteOnly(rUserPoolName, rUserName, rUserPasswordUpdated, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(ctx, t, userResourceName),
+					resource.TestCheckNoResourceAttr(userResourceName, "password_wo"),
+					resource.TestCheckResourceAttr(userResourceName, "password_wo_version", "2"),
+					resource.TestCheckResourceAttr(userResourceName, names.AttrStatus, string(awstypes.UserStatusTypeConfirmed)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIDPUser_attributes(t *testing.T) {
 	ctx := acctest.Context(t)
 	rUserPoolName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -528,6 +568,28 @@ resource "aws_cognito_user" "test" {
 `, userPoolName, clientName, userName, password)
 }
 
+func testAccUserConfig_passwordWriteOnly(userPoolName, userName, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+  password_policy {
+    temporary_password_validity_days = 7
+    minimum_length                   = 6
+    require_uppercase                = false
+    require_symbols                  = false
+    require_numbers                  = false
+  }
+}
+
+resource "aws_cognito_user" "test" {
+  user_pool_id        = aws_cognito_user_pool.test.id
+  username            = %[2]q
+  password_wo         = %[3]q
+  password_wo_version = %[4]d
+}
+`, userPoolName, userName, password, passwordVersion)
+}
+
 func testAccUserConfig_noPassword(userPoolName string, clientName string, userName string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "test" {
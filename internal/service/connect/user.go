@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/connect"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -96,10 +97,20 @@ func resourceUser() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(1, 100),
 				},
 				names.AttrPassword: {
-					Type:         schema.TypeString,
-					Optional:     true,
-					Sensitive:    true,
-					ValidateFunc: validation.StringLenBetween(8, 64),
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(8, 64),
+					ConflictsWith: []string{"password_wo"},
+				},
+				// The password is only used when the user is created, so there is no password_wo_version.
+				"password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(8, 64),
+					ConflictsWith: []string{names.AttrPassword},
 				},
 				"phone_config": {
 					Type:     schema.TypeList,
@@ -190,6 +201,16 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta any) d
 		input.Password = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if passwordWO != "" {
+		input.Password = aws.String(passwordWO)
+	}
+
 	output, err := conn.CreateUser(ctx, input)
 
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -150,10 +151,26 @@ func resourceLocationFSxONTAPFileSystem() *schema.Resource {
 										},
 										names.AttrPassword: {
 											Type:         schema.TypeString,
-											Required:     true,
+											Optional:     true,
 											ForceNew:     true,
 											Sensitive:    true,
 											ValidateFunc: validation.StringLenBetween(1, 104),
+											ExactlyOneOf: []string{"protocol.0.smb.0.password", "protocol.0.smb.0.password_wo"},
+										},
+										"password_wo": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											WriteOnly:    true,
+											Sensitive:    true,
+											ValidateFunc: validation.StringLenBetween(1, 104),
+											ExactlyOneOf: []string{"protocol.0.smb.0.password", "protocol.0.smb.0.password_wo"},
+											RequiredWith: []string{"protocol.0.smb.0.password_wo_version"},
+										},
+										"password_wo_version": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ForceNew:     true,
+											RequiredWith: []string{"protocol.0.smb.0.password_wo"},
 										},
 										"user": {
 											Type:         schema.TypeString,
@@ -217,6 +234,17 @@ func resourceLocationFSxONTAPFileSystemCreate(ctx context.Context, d *schema.Res
 		input.Subdirectory = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath(names.AttrProtocol).IndexInt(0).GetAttr("smb").IndexInt(0).GetAttr("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" && input.Protocol != nil && input.Protocol.SMB != nil {
+		input.Protocol.SMB.Password = aws.String(passwordWO)
+	}
+
 	output, err := conn.CreateLocationFsxOntap(ctx, input)
 
 	if err != nil {
@@ -259,7 +287,11 @@ func resourceLocationFSxONTAPFileSystemRead(ctx context.Context, d *schema.Resou
 			output.Protocol.SMB.Password = aws.String(smbPassword)
 		}
 	}
-	if err := d.Set(names.AttrProtocol, flattenProtocol(output.Protocol)); err != nil {
+	tfList := flattenProtocol(output.Protocol)
+	if v, ok := d.GetOk("protocol.0.smb.0.password_wo_version"); ok && output.Protocol != nil && output.Protocol.SMB != nil {
+		tfList[0].(map[string]any)["smb"].([]any)[0].(map[string]any)["password_wo_version"] = v
+	}
+	if err := d.Set(names.AttrProtocol, tfList); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting protocol: %s", err)
 	}
 	d.Set("security_group_arns", output.SecurityGroupArns)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfdatasync "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
@@ -145,6 +146,54 @@ func TestAccDataSyncLocationFSxONTAPFileSystem_smb(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncLocationFSxONTAPFileSystem_smbPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	netBiosName := "tftest-" + acctest.RandString(t, 7)
+	domainNetbiosName := "tftest" + acctest.RandString(t, 4)
+	domainName := domainNetbiosName + ".local"
+	var v datasync.DescribeLocationFsxOntapOutput
+	resourceName := "aws_datasync_location_fsx_ontap_file_system.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FSxEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckLocationFSxforNetAppONTAPFileSystemDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationFSxONTAPFileSystemConfig_smbPasswordWriteOnly(rName, netBiosName, domainNetbiosName, domainName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLocationFSxforNetAppONTAPFileSystemExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "protocol.0.smb.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "protocol.0.smb.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "protocol.0.smb.0.password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccLocationFSxONTAPFileSystemConfig_smbPasswordWriteOnly(rName, netBiosName, domainNetbiosName, domainName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLocationFSxforNetAppONTAPFileSystemExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "protocol.0.smb.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "protocol.0.smb.0.password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSyncLocationFSxONTAPFileSystem_subdirectory(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -468,3 +517,44 @@ resource "aws_datasync_location_fsx_ontap_file_system" "test" {
 }
 `, key1, value1, key2, value2))
 }
+
+func testAccLocationFSxONTAPFileSystemConfig_smbPasswordWriteOnly(rName, netBiosName, domainNetbiosName, domainName string, passwordWOVersion int) string {
+	return acctest.ConfigCompose(testAccFSxOntapFileSystemConfig_baseSMB(rName, domainName), fmt.Sprintf(`
+resource "aws_fsx_ontap_storage_virtual_machine" "test" {
+  file_system_id = aws_fsx_ontap_file_system.test.id
+  name           = %[1]q
+  depends_on     = [aws_directory_service_directory.test]
+
+  active_directory_configuration {
+    netbios_name = %[2]q
+    self_managed_active_directory_configuration {
+      dns_ips                                = aws_directory_service_directory.test.dns_ip_addresses
+      domain_name                            = %[3]q
+      password                               = "MyPassw0rd1"
+      username                               = "Admin"
+      organizational_unit_distinguished_name = "OU=computers,OU=%[4]s"
+      file_system_administrators_group       = "Admins"
+    }
+  }
+}
+
+resource "aws_datasync_location_fsx_ontap_file_system" "test" {
+  security_group_arns         = [aws_security_group.test.arn]
+  storage_virtual_machine_arn = aws_fsx_ontap_storage_virtual_machine.test.arn
+
+  protocol {
+    smb {
+      domain = %[3]q
+
+      mount_options {
+        version = "SMB3"
+      }
+
+      password_wo         = "MyPassw0rd1"
+      password_wo_version = %[5]d
+      user                = "Admin"
+    }
+  }
+}
+`, rName, netBiosName, domainName, domainNetbiosName, passwordWOVersion))
+}
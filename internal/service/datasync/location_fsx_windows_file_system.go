@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -88,10 +89,26 @@ func resourceLocationFSxWindowsFileSystem() *schema.Resource {
 				},
 				names.AttrPassword: {
 					Type:         schema.TypeString,
-					Required:     true,
+					Optional:     true,
 					ForceNew:     true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringLenBetween(1, 104),
+					ExactlyOneOf: []string{"password", "password_wo"},
+				},
+				"password_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringLenBetween(1, 104),
+					ExactlyOneOf: []string{"password", "password_wo"},
+					RequiredWith: []string{"password_wo_version"},
+				},
+				"password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{"password_wo"},
 				},
 				"security_group_arns": {
 					Type:     schema.TypeSet,
@@ -148,6 +165,17 @@ func resourceLocationFSxWindowsFileSystemCreate(ctx context.Context, d *schema.R
 		input.Subdirectory = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		input.Password = aws.String(passwordWO)
+	}
+
 	output, err := conn.CreateLocationFsxWindows(ctx, input)
 
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfdatasync "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
@@ -97,6 +98,51 @@ func TestAccDataSyncLocationFSxWindowsFileSystem_disappears(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncLocationFSxWindowsFileSystem_passwordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datasync.DescribeLocationFsxWindowsOutput
+	resourceName := "aws_datasync_location_fsx_windows_file_system.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.FSxEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckLocationFSxforWindowsFileServerFileSystemDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationFSxWindowsFileSystemConfig_passwordWriteOnly(rName, domainName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationFSxforWindowsFileServerFileSystemExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccLocationFSxWindowsFileSystemConfig_passwordWriteOnly(rName, domainName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationFSxforWindowsFileServerFileSystemExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSyncLocationFSxWindowsFileSystem_subdirectory(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v datasync.DescribeLocationFsxWindowsOutput
@@ -352,3 +398,15 @@ resource "aws_datasync_location_fsx_windows_file_system" "test" {
 }
 `, key1, value1, key2, value2))
 }
+
+func testAccLocationFSxWindowsFileSystemConfig_passwordWriteOnly(rName, domain string, passwordWOVersion int) string {
+	return acctest.ConfigCompose(testAccLocationFSxWindowsFileSystemConfig_baseFS(rName, domain), fmt.Sprintf(`
+resource "aws_datasync_location_fsx_windows_file_system" "test" {
+  fsx_filesystem_arn  = aws_fsx_windows_file_system.test.arn
+  user                = "SomeUser"
+  password_wo         = "SuperSecretPassw0rd"
+  password_wo_version = %[1]d
+  security_group_arns = [aws_security_group.test.arn]
+}
+`, passwordWOVersion))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -70,10 +71,25 @@ func resourceLocationObjectStorage() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(3, 63),
 				},
 				names.AttrSecretKey: {
-					Type:         schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(8, 200),
+					ConflictsWith: []string{"secret_key_wo"},
+				},
+				"secret_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(8, 200),
+					ConflictsWith: []string{"secret_key"},
+					RequiredWith:  []string{"secret_key_wo_version"},
+				},
+				"secret_key_wo_version": {
+					Type:         schema.TypeInt,
 					Optional:     true,
-					Sensitive:    true,
-					ValidateFunc: validation.StringLenBetween(8, 200),
+					RequiredWith: []string{"secret_key_wo"},
 				},
 				"server_certificate": {
 					Type:     schema.TypeString,
@@ -142,6 +158,17 @@ func resourceLocationObjectStorageCreate(ctx context.Context, d *schema.Resource
 		input.SecretKey = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	secretKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("secret_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if secretKeyWO != "" {
+		input.SecretKey = aws.String(secretKeyWO)
+	}
+
 	if v, ok := d.GetOk("server_certificate"); ok {
 		input.ServerCertificate = []byte(v.(string))
 	}
@@ -210,6 +237,13 @@ func resourceLocationObjectStorageUpdate(ctx context.Context, d *schema.Resource
 			LocationArn: aws.String(d.Id()),
 		}
 
+		// get write-only value from configuration
+		secretKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("secret_key_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
 		if d.HasChange(names.AttrAccessKey) {
 			input.AccessKey = aws.String(d.Get(names.AttrAccessKey).(string))
 		}
@@ -230,12 +264,19 @@ func resourceLocationObjectStorageUpdate(ctx context.Context, d *schema.Resource
 			if v, ok := d.GetOk(names.AttrSecretKey); ok {
 				input.SecretKey = aws.String(v.(string))
 			}
+			if secretKeyWO != "" {
+				input.SecretKey = aws.String(secretKeyWO)
+			}
 		}
 
 		if d.HasChange(names.AttrSecretKey) {
 			input.SecretKey = aws.String(d.Get(names.AttrSecretKey).(string))
 		}
 
+		if d.HasChange("secret_key_wo_version") {
+			input.SecretKey = aws.String(secretKeyWO)
+		}
+
 		if d.HasChange("server_certificate") {
 			input.ServerCertificate = []byte(d.Get("server_certificate").(string))
 		}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfdatasync "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
@@ -350,6 +351,44 @@ func TestAccDataSyncLocationObjectStorage_noAgentARNs(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncLocationObjectStorage_secretKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datasync.DescribeLocationObjectStorageOutput
+	resourceName := "aws_datasync_location_object_storage.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckLocationObjectStorageDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationObjectStorageConfig_secretKeyWriteOnly(rName, domain, "secretkey1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLocationObjectStorageExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrSecretKey),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_key_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccLocationObjectStorageConfig_secretKeyWriteOnly(rName, domain, "secretkey2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLocationObjectStorageExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrSecretKey),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLocationObjectStorageDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).DataSyncClient(ctx)
@@ -531,3 +570,18 @@ resource "aws_datasync_location_object_storage" "test" {
 }
 `, rName, domain))
 }
+
+func testAccLocationObjectStorageConfig_secretKeyWriteOnly(rName, domain, secretKey string, secretKeyWOVersion int) string {
+	return acctest.ConfigCompose(testAccLocationObjectStorageConfig_base(rName), fmt.Sprintf(`
+resource "aws_datasync_location_object_storage" "test" {
+  agent_arns            = [aws_datasync_agent.test.arn]
+  server_hostname       = %[2]q
+  bucket_name           = %[1]q
+  server_protocol       = "HTTP"
+  server_port           = 8080
+  access_key            = "accesskey"
+  secret_key_wo         = %[3]q
+  secret_key_wo_version = %[4]d
+}
+`, rName, domain, secretKey, secretKeyWOVersion))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/datasync"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datasync/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -79,9 +80,24 @@ func resourceLocationSMB() *schema.Resource {
 				},
 				names.AttrPassword: {
 					Type:         schema.TypeString,
-					Required:     true,
+					Optional:     true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringLenBetween(1, 104),
+					ExactlyOneOf: []string{"password", "password_wo"},
+				},
+				"password_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringLenBetween(1, 104),
+					ExactlyOneOf: []string{"password", "password_wo"},
+					RequiredWith: []string{"password_wo_version"},
+				},
+				"password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"password_wo"},
 				},
 				"server_hostname": {
 					Type:         schema.TypeString,
@@ -139,6 +155,17 @@ func resourceLocationSMBCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Domain = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		input.Password = aws.String(passwordWO)
+	}
+
 	output, err := conn.CreateLocationSmb(ctx, input)
 
 	if err != nil {
@@ -208,6 +235,17 @@ func resourceLocationSMBUpdate(ctx context.Context, d *schema.ResourceData, meta
 			input.Domain = aws.String(v.(string))
 		}
 
+		// get write-only value from configuration
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input.Password = aws.String(passwordWO)
+		}
+
 		_, err := conn.UpdateLocationSmb(ctx, input)
 
 		if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfdatasync "github.com/hashicorp/terraform-provider-aws/internal/service/datasync"
@@ -67,6 +68,41 @@ func TestAccDataSyncLocationSMB_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSyncLocationSMB_passwordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v datasync.DescribeLocationSmbOutput
+	resourceName := "aws_datasync_location_smb.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataSyncServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckLocationSMBDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationSMBConfig_passwordWriteOnly(rName, "ZaphodBeeblebroxPW", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationSMBExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccLocationSMBConfig_passwordWriteOnly(rName, "TrillianAstraPW", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLocationSMBExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataSyncLocationSMB_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v datasync.DescribeLocationSmbOutput
@@ -246,3 +282,16 @@ resource "aws_datasync_location_smb" "test" {
 }
 `, key1, value1, key2, value2))
 }
+
+func testAccLocationSMBConfig_passwordWriteOnly(rName, password string, passwordWOVersion int) string {
+	return acctest.ConfigCompose(testAccLocationSMBConfig_base(rName), fmt.Sprintf(`
+resource "aws_datasync_location_smb" "test" {
+  agent_arns          = [aws_datasync_agent.test.arn]
+  password_wo         = %[1]q
+  password_wo_version = %[2]d
+  server_hostname     = aws_instance.test.public_ip
+  subdirectory        = "/test/"
+  user                = "Guest"
+}
+`, password, passwordWOVersion))
+}
@@ -196,9 +196,23 @@ func resourceEndpoint() *schema.Resource {
 								ValidateDiagFunc: enum.Validate[awstypes.KafkaSaslMechanism](),
 							},
 							"sasl_password": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"kafka_settings.0.sasl_password_wo"},
+							},
+							"sasl_password_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"kafka_settings.0.sasl_password"},
+								RequiredWith:  []string{"kafka_settings.0.sasl_password_wo_version"},
+							},
+							"sasl_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"kafka_settings.0.sasl_password_wo"},
 							},
 							"sasl_username": {
 								Type:     schema.TypeString,
@@ -225,9 +239,23 @@ func resourceEndpoint() *schema.Resource {
 								ValidateFunc: verify.ValidARN,
 							},
 							"ssl_client_key_password": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"kafka_settings.0.ssl_client_key_password_wo"},
+							},
+							"ssl_client_key_password_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"kafka_settings.0.ssl_client_key_password"},
+								RequiredWith:  []string{"kafka_settings.0.ssl_client_key_password_wo_version"},
+							},
+							"ssl_client_key_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"kafka_settings.0.ssl_client_key_password_wo"},
 							},
 							"topic": {
 								Type:     schema.TypeString,
@@ -455,10 +483,24 @@ func resourceEndpoint() *schema.Resource {
 								Computed: true,
 							},
 							"asm_password": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Computed:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Computed:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"oracle_settings.0.asm_password_wo"},
+							},
+							"asm_password_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"oracle_settings.0.asm_password"},
+								RequiredWith:  []string{"oracle_settings.0.asm_password_wo_version"},
+							},
+							"asm_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"oracle_settings.0.asm_password_wo"},
 							},
 							"asm_server": {
 								Type:     schema.TypeString,
@@ -568,10 +610,24 @@ func resourceEndpoint() *schema.Resource {
 								Computed: true,
 							},
 							"security_db_encryption": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Computed:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Computed:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"oracle_settings.0.security_db_encryption_wo"},
+							},
+							"security_db_encryption_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"oracle_settings.0.security_db_encryption"},
+								RequiredWith:  []string{"oracle_settings.0.security_db_encryption_wo_version"},
+							},
+							"security_db_encryption_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"oracle_settings.0.security_db_encryption_wo"},
 							},
 							"security_db_encryption_name": {
 								Type:     schema.TypeString,
@@ -625,7 +681,20 @@ func resourceEndpoint() *schema.Resource {
 					Type:          schema.TypeString,
 					Optional:      true,
 					Sensitive:     true,
-					ConflictsWith: []string{"secrets_manager_access_role_arn", "secrets_manager_arn"},
+					ConflictsWith: []string{"secrets_manager_access_role_arn", "secrets_manager_arn", "password_wo"},
+				},
+				"password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"secrets_manager_access_role_arn", "secrets_manager_arn", names.AttrPassword},
+					RequiredWith:  []string{"password_wo_version"},
+				},
+				"password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"password_wo"},
 				},
 				"pause_replication_tasks": {
 					Type:     schema.TypeBool,
@@ -732,9 +801,23 @@ func resourceEndpoint() *schema.Resource {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"auth_password": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"redis_settings.0.auth_password_wo"},
+							},
+							"auth_password_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"redis_settings.0.auth_password"},
+								RequiredWith:  []string{"redis_settings.0.auth_password_wo_version"},
+							},
+							"auth_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"redis_settings.0.auth_password_wo"},
 							},
 							"auth_type": {
 								Type:             schema.TypeString,
@@ -808,14 +891,14 @@ func resourceEndpoint() *schema.Resource {
 					Optional:      true,
 					ValidateFunc:  verify.ValidARN,
 					RequiredWith:  []string{"secrets_manager_arn"},
-					ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort},
+					ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "password_wo", "server_name", names.AttrPort},
 				},
 				"secrets_manager_arn": {
 					Type:          schema.TypeString,
 					Optional:      true,
 					ValidateFunc:  verify.ValidARN,
 					RequiredWith:  []string{"secrets_manager_access_role_arn"},
-					ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort},
+					ConflictsWith: []string{names.AttrUsername, names.AttrPassword, "password_wo", "server_name", names.AttrPort},
 				},
 				"server_name": {
 					Type:          schema.TypeString,
@@ -880,6 +963,17 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		input.SslMode = awstypes.DmsSslModeValue(v.(string))
 	}
 
+	password := d.Get(names.AttrPassword).(string)
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if passwordWO != "" {
+		password = passwordWO
+	}
+
 	switch d.Get("engine_name").(string) {
 	case engineNameAurora, engineNameMariadb, engineNameMySQL:
 		settings := &awstypes.MySQLSettings{}
@@ -891,7 +985,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-			settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+			settings.Password = aws.String(password)
 			settings.ServerName = aws.String(d.Get("server_name").(string))
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
@@ -902,7 +996,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, &input)
+			expandTopLevelConnectionInfo(d, &input, password)
 		}
 		input.MySQLSettings = settings
 	case engineNameAuroraPostgresql, engineNamePostgres:
@@ -919,8 +1013,8 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
-			if v, ok := d.GetOk(names.AttrPassword); ok {
-				settings.Password = aws.String(v.(string))
+			if password != "" {
+				settings.Password = aws.String(password)
 			}
 
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
@@ -928,7 +1022,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, &input)
+			expandTopLevelConnectionInfo(d, &input, password)
 		}
 
 		input.PostgreSQLSettings = settings
@@ -946,6 +1040,11 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	case engineNameKafka:
 		input.KafkaSettings = expandKafkaSettings(d.Get("kafka_settings").([]any)[0].(map[string]any))
+
+		diags = append(diags, expandKafkaSettingsWriteOnly(d, input.KafkaSettings)...)
+		if diags.HasError() {
+			return diags
+		}
 	case engineNameKinesis:
 		input.KinesisSettings = expandKinesisSettings(d.Get("kinesis_settings").([]any)[0].(map[string]any))
 	case engineNameMongodb:
@@ -963,8 +1062,8 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
-			if v, ok := d.GetOk(names.AttrPassword); ok {
-				settings.Password = aws.String(v.(string))
+			if password != "" {
+				settings.Password = aws.String(password)
 			}
 
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
@@ -972,7 +1071,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, &input)
+			expandTopLevelConnectionInfo(d, &input, password)
 		}
 
 		input.MongoDbSettings = settings
@@ -981,6 +1080,11 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 
 		if v, ok := d.GetOk("oracle_settings"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			settings = expandOracleSettings(v.([]any)[0].(map[string]any), endpointType)
+
+			diags = append(diags, expandOracleSettingsWriteOnly(d, settings)...)
+			if diags.HasError() {
+				return diags
+			}
 		} else {
 			settings = &awstypes.OracleSettings{}
 		}
@@ -990,8 +1094,8 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
-			if v, ok := d.GetOk(names.AttrPassword); ok {
-				settings.Password = aws.String(v.(string))
+			if password != "" {
+				settings.Password = aws.String(password)
 			}
 
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
@@ -999,12 +1103,17 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, &input)
+			expandTopLevelConnectionInfo(d, &input, password)
 		}
 
 		input.OracleSettings = settings
 	case engineNameRedis:
 		input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]any)[0].(map[string]any))
+
+		diags = append(diags, expandRedisSettingsWriteOnly(d, input.RedisSettings)...)
+		if diags.HasError() {
+			return diags
+		}
 	case engineNameRedshift:
 		var settings = &awstypes.RedshiftSettings{
 			DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1014,8 +1123,8 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
 			settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 		} else {
-			if v, ok := d.GetOk(names.AttrPassword); ok {
-				settings.Password = aws.String(v.(string))
+			if password != "" {
+				settings.Password = aws.String(password)
 			}
 
 			settings.Username = aws.String(d.Get(names.AttrUsername).(string))
@@ -1023,7 +1132,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 			settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, &input)
+			expandTopLevelConnectionInfo(d, &input, password)
 		}
 
 		if v, ok := d.GetOk("redshift_settings"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -1061,14 +1170,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		} else {
 			input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, &input)
+			expandTopLevelConnectionInfo(d, &input, password)
 		}
 	case engineNameSybase:
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
@@ -1080,14 +1189,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		} else {
 			input.SybaseSettings = &awstypes.SybaseSettings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, &input)
+			expandTopLevelConnectionInfo(d, &input, password)
 		}
 	case engineNameDB2, engineNameDB2zOS:
 		if _, ok := d.GetOk("secrets_manager_arn"); ok {
@@ -1099,17 +1208,17 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta an
 		} else {
 			input.IBMDb2Settings = &awstypes.IBMDb2Settings{
 				Username:     aws.String(d.Get(names.AttrUsername).(string)),
-				Password:     aws.String(d.Get(names.AttrPassword).(string)),
+				Password:     aws.String(password),
 				ServerName:   aws.String(d.Get("server_name").(string)),
 				Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 				DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 			}
 
 			// Set connection info in top-level namespace as well
-			expandTopLevelConnectionInfo(d, &input)
+			expandTopLevelConnectionInfo(d, &input, password)
 		}
 	default:
-		expandTopLevelConnectionInfo(d, &input)
+		expandTopLevelConnectionInfo(d, &input, password)
 	}
 
 	_, err := tfresource.RetryWhenIsA[any, *awstypes.AccessDeniedFault](ctx, d.Timeout(schema.TimeoutCreate),
@@ -1156,6 +1265,17 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		endpointARN := d.Get("endpoint_arn").(string)
 		pauseTasks := d.Get("pause_replication_tasks").(bool)
+		password := d.Get(names.AttrPassword).(string)
+		// get write-only value from configuration
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+		if passwordWO != "" {
+			password = passwordWO
+		}
+
 		var tasks []awstypes.ReplicationTask
 
 		if pauseTasks {
@@ -1199,7 +1319,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			switch engineName := d.Get("engine_name").(string); engineName {
 			case engineNameAurora, engineNameMariadb, engineNameMySQL:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName,
 					"secrets_manager_access_role_arn", "secrets_manager_arn", "mysql_settings") {
 					var settings *awstypes.MySQLSettings
 
@@ -1214,7 +1334,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
 						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-						settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+						settings.Password = aws.String(password)
 						settings.ServerName = aws.String(d.Get("server_name").(string))
 						settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
@@ -1225,7 +1345,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						}
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, &input)
+						expandTopLevelConnectionInfoModify(d, &input, password)
 					}
 
 					input.MySQLSettings = settings
@@ -1233,7 +1353,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			case engineNameAuroraPostgresql, engineNamePostgres:
 				if d.HasChanges(
 					names.AttrDatabaseName, "postgres_settings",
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort,
 					"secrets_manager_access_role_arn", "secrets_manager_arn") {
 					var settings *awstypes.PostgreSQLSettings
 
@@ -1248,8 +1368,8 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
 						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
-						if v, ok := d.GetOk(names.AttrPassword); ok {
-							settings.Password = aws.String(v.(string))
+						if password != "" {
+							settings.Password = aws.String(password)
 						}
 
 						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
@@ -1257,7 +1377,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, &input)
+						expandTopLevelConnectionInfoModify(d, &input, password)
 					}
 
 					input.PostgreSQLSettings = settings
@@ -1286,6 +1406,11 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			case engineNameKafka:
 				if d.HasChange("kafka_settings") {
 					input.KafkaSettings = expandKafkaSettings(d.Get("kafka_settings").([]any)[0].(map[string]any))
+
+					diags = append(diags, expandKafkaSettingsWriteOnly(d, input.KafkaSettings)...)
+					if diags.HasError() {
+						return diags
+					}
 				}
 			case engineNameKinesis:
 				if d.HasChanges("kinesis_settings") {
@@ -1294,7 +1419,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			case engineNameMongodb:
 				if d.HasChanges(
 					names.AttrDatabaseName, "mongodb_settings",
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort,
 					"secrets_manager_access_role_arn", "secrets_manager_arn", names.AttrKMSKeyARN) {
 					var settings *awstypes.MongoDbSettings
 
@@ -1311,12 +1436,12 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
 						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
-						settings.Password = aws.String(d.Get(names.AttrPassword).(string))
+						settings.Password = aws.String(password)
 						settings.ServerName = aws.String(d.Get("server_name").(string))
 						settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, &input)
+						expandTopLevelConnectionInfoModify(d, &input, password)
 					}
 
 					input.MongoDbSettings = settings
@@ -1324,12 +1449,17 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			case engineNameOracle:
 				if d.HasChanges(
 					names.AttrDatabaseName, "oracle_settings",
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort,
 					"secrets_manager_access_role_arn", "secrets_manager_arn") {
 					var settings *awstypes.OracleSettings
 
 					if v, ok := d.GetOk("oracle_settings"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 						settings = expandOracleSettings(v.([]any)[0].(map[string]any), endpointType)
+
+						diags = append(diags, expandOracleSettingsWriteOnly(d, settings)...)
+						if diags.HasError() {
+							return diags
+						}
 					} else {
 						settings = &awstypes.OracleSettings{}
 					}
@@ -1339,8 +1469,8 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
 						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
-						if v, ok := d.GetOk(names.AttrPassword); ok {
-							settings.Password = aws.String(v.(string))
+						if password != "" {
+							settings.Password = aws.String(password)
 						}
 
 						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
@@ -1348,7 +1478,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, &input)
+						expandTopLevelConnectionInfoModify(d, &input, password)
 					}
 
 					input.OracleSettings = settings
@@ -1356,11 +1486,16 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			case engineNameRedis:
 				if d.HasChanges("redis_settings") {
 					input.RedisSettings = expandRedisSettings(d.Get("redis_settings").([]any)[0].(map[string]any))
+
+					diags = append(diags, expandRedisSettingsWriteOnly(d, input.RedisSettings)...)
+					if diags.HasError() {
+						return diags
+					}
 				}
 			case engineNameRedshift:
 				if d.HasChanges(
 					names.AttrDatabaseName, "redshift_settings",
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort,
 					"secrets_manager_access_role_arn", "secrets_manager_arn") {
 					var settings = &awstypes.RedshiftSettings{
 						DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
@@ -1370,8 +1505,8 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						settings.SecretsManagerAccessRoleArn = aws.String(d.Get("secrets_manager_access_role_arn").(string))
 						settings.SecretsManagerSecretId = aws.String(d.Get("secrets_manager_arn").(string))
 					} else {
-						if v, ok := d.GetOk(names.AttrPassword); ok {
-							settings.Password = aws.String(v.(string))
+						if password != "" {
+							settings.Password = aws.String(password)
 						}
 
 						settings.Username = aws.String(d.Get(names.AttrUsername).(string))
@@ -1379,7 +1514,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 						settings.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, &input)
+						expandTopLevelConnectionInfoModify(d, &input, password)
 					}
 
 					if v, ok := d.GetOk("redshift_settings"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -1410,7 +1545,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 				}
 			case engineNameSQLServer, engineNameBabelfish:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName,
 					"secrets_manager_access_role_arn", "secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
@@ -1421,19 +1556,19 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.MicrosoftSQLServerSettings = &awstypes.MicrosoftSQLServerSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 						}
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, &input)
+						expandTopLevelConnectionInfoModify(d, &input, password)
 					}
 				}
 			case engineNameSybase:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName,
 					"secrets_manager_access_role_arn", "secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.SybaseSettings = &awstypes.SybaseSettings{
@@ -1444,19 +1579,19 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.SybaseSettings = &awstypes.SybaseSettings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 						}
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, &input)
+						expandTopLevelConnectionInfoModify(d, &input, password)
 					}
 				}
 			case engineNameDB2, engineNameDB2zOS:
 				if d.HasChanges(
-					names.AttrUsername, names.AttrPassword, "server_name", names.AttrPort, names.AttrDatabaseName,
+					names.AttrUsername, names.AttrPassword, "password_wo_version", "server_name", names.AttrPort, names.AttrDatabaseName,
 					"secrets_manager_access_role_arn", "secrets_manager_arn") {
 					if _, ok := d.GetOk("secrets_manager_arn"); ok {
 						input.IBMDb2Settings = &awstypes.IBMDb2Settings{
@@ -1467,14 +1602,14 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					} else {
 						input.IBMDb2Settings = &awstypes.IBMDb2Settings{
 							Username:     aws.String(d.Get(names.AttrUsername).(string)),
-							Password:     aws.String(d.Get(names.AttrPassword).(string)),
+							Password:     aws.String(password),
 							ServerName:   aws.String(d.Get("server_name").(string)),
 							Port:         aws.Int32(int32(d.Get(names.AttrPort).(int))),
 							DatabaseName: aws.String(d.Get(names.AttrDatabaseName).(string)),
 						}
 
 						// Update connection info in top-level namespace as well
-						expandTopLevelConnectionInfoModify(d, &input)
+						expandTopLevelConnectionInfoModify(d, &input, password)
 					}
 				}
 			default:
@@ -1482,8 +1617,8 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta an
 					input.DatabaseName = aws.String(d.Get(names.AttrDatabaseName).(string))
 				}
 
-				if d.HasChange(names.AttrPassword) {
-					input.Password = aws.String(d.Get(names.AttrPassword).(string))
+				if d.HasChanges(names.AttrPassword, "password_wo_version") {
+					input.Password = aws.String(password)
 				}
 
 				if d.HasChange(names.AttrPort) {
@@ -1664,6 +1799,12 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *awstypes.Endpoin
 			// SASL password isn't returned in API. Propagate state value.
 			tfMap := flattenKafkaSettings(v)
 			tfMap["sasl_password"] = d.Get("kafka_settings.0.sasl_password").(string)
+			tfMap["sasl_password_wo_version"] = d.Get("kafka_settings.0.sasl_password_wo_version").(int)
+			tfMap["ssl_client_key_password_wo_version"] = d.Get("kafka_settings.0.ssl_client_key_password_wo_version").(int)
+			if _, ok := d.GetOk("kafka_settings.0.ssl_client_key_password_wo_version"); ok {
+				// Don't store the write-only SSL client key password.
+				tfMap["ssl_client_key_password"] = ""
+			}
 
 			if err := d.Set("kafka_settings", []any{tfMap}); err != nil {
 				return fmt.Errorf("setting kafka_settings: %w", err)
@@ -1703,6 +1844,8 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *awstypes.Endpoin
 			tfMap := flattenOracleSettings(v)
 			tfMap["asm_password"] = d.Get("oracle_settings.0.asm_password").(string)
 			tfMap["security_db_encryption"] = d.Get("oracle_settings.0.security_db_encryption").(string)
+			tfMap["asm_password_wo_version"] = d.Get("oracle_settings.0.asm_password_wo_version").(int)
+			tfMap["security_db_encryption_wo_version"] = d.Get("oracle_settings.0.security_db_encryption_wo_version").(int)
 
 			if err := d.Set("oracle_settings", []any{tfMap}); err != nil {
 				return fmt.Errorf("setting oracle_settings: %w", err)
@@ -1714,6 +1857,7 @@ func resourceEndpointSetState(d *schema.ResourceData, endpoint *awstypes.Endpoin
 		// Auth password isn't returned in API. Propagate state value.
 		tfMap := flattenRedisSettings(endpoint.RedisSettings)
 		tfMap["auth_password"] = d.Get("redis_settings.0.auth_password").(string)
+		tfMap["auth_password_wo_version"] = d.Get("redis_settings.0.auth_password_wo_version").(int)
 
 		if err := d.Set("redis_settings", []any{tfMap}); err != nil {
 			return fmt.Errorf("setting redis_settings: %w", err)
@@ -1966,6 +2110,32 @@ func expandKafkaSettings(tfMap map[string]any) *awstypes.KafkaSettings {
 	return apiObject
 }
 
+// expandKafkaSettingsWriteOnly sets the Kafka passwords configured with write-only arguments.
+func expandKafkaSettingsWriteOnly(d *schema.ResourceData, apiObject *awstypes.KafkaSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+	path := cty.GetAttrPath("kafka_settings").IndexInt(0)
+
+	saslPasswordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("sasl_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if saslPasswordWO != "" {
+		apiObject.SaslPassword = aws.String(saslPasswordWO)
+	}
+
+	sslClientKeyPasswordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("ssl_client_key_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if sslClientKeyPasswordWO != "" {
+		apiObject.SslClientKeyPassword = aws.String(sslClientKeyPasswordWO)
+	}
+
+	return diags
+}
+
 func flattenKafkaSettings(apiObject *awstypes.KafkaSettings) map[string]any {
 	if apiObject == nil {
 		return nil
@@ -2190,6 +2360,23 @@ func expandRedisSettings(tfMap map[string]any) *awstypes.RedisSettings {
 	return apiObject
 }
 
+// expandRedisSettingsWriteOnly sets the Redis authentication password configured with write-only arguments.
+func expandRedisSettingsWriteOnly(d *schema.ResourceData, apiObject *awstypes.RedisSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+	path := cty.GetAttrPath("redis_settings").IndexInt(0)
+
+	authPasswordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("auth_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if authPasswordWO != "" {
+		apiObject.AuthPassword = aws.String(authPasswordWO)
+	}
+
+	return diags
+}
+
 func flattenRedisSettings(apiObject *awstypes.RedisSettings) map[string]any {
 	if apiObject == nil {
 		return nil
@@ -2562,6 +2749,32 @@ func expandOracleSettings(tfMap map[string]any, endpointType awstypes.Replicatio
 	return apiObject
 }
 
+// expandOracleSettingsWriteOnly sets the Oracle ASM and TDE passwords configured with write-only arguments.
+func expandOracleSettingsWriteOnly(d *schema.ResourceData, apiObject *awstypes.OracleSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+	path := cty.GetAttrPath("oracle_settings").IndexInt(0)
+
+	asmPasswordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("asm_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if asmPasswordWO != "" {
+		apiObject.AsmPassword = aws.String(asmPasswordWO)
+	}
+
+	securityDBEncryptionWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("security_db_encryption_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if securityDBEncryptionWO != "" {
+		apiObject.SecurityDbEncryption = aws.String(securityDBEncryptionWO)
+	}
+
+	return diags
+}
+
 func flattenOracleSettings(apiObject *awstypes.OracleSettings) map[string]any {
 	if apiObject == nil {
 		return nil
@@ -2760,7 +2973,7 @@ func engineSettingsToSet(tfList []any) *schema.Set {
 	return s
 }
 
-func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpointInput) {
+func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpointInput, password string) {
 	input.Username = aws.String(d.Get(names.AttrUsername).(string))
 	input.ServerName = aws.String(d.Get("server_name").(string))
 	input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
@@ -2768,12 +2981,12 @@ func expandTopLevelConnectionInfo(d *schema.ResourceData, input *dms.CreateEndpo
 	if v, ok := d.GetOk(names.AttrDatabaseName); ok {
 		input.DatabaseName = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk(names.AttrPassword); ok {
-		input.Password = aws.String(v.(string))
+	if password != "" {
+		input.Password = aws.String(password)
 	}
 }
 
-func expandTopLevelConnectionInfoModify(d *schema.ResourceData, input *dms.ModifyEndpointInput) {
+func expandTopLevelConnectionInfoModify(d *schema.ResourceData, input *dms.ModifyEndpointInput, password string) {
 	input.Username = aws.String(d.Get(names.AttrUsername).(string))
 	input.ServerName = aws.String(d.Get("server_name").(string))
 	input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
@@ -2781,8 +2994,8 @@ func expandTopLevelConnectionInfoModify(d *schema.ResourceData, input *dms.Modif
 	if v, ok := d.GetOk(names.AttrDatabaseName); ok {
 		input.DatabaseName = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk(names.AttrPassword); ok {
-		input.Password = aws.String(v.(string))
+	if password != "" {
+		input.Password = aws.String(password)
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
@@ -71,6 +72,38 @@ func TestAccDSDirectory_basic(t *testing.T) {
 	})
 }
 
+func TestAccDSDirectory_passwordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ds awstypes.DirectoryDescription
+	resourceName := "aws_directory_service_directory.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+			acctest.PreCheckDirectoryServiceSimpleDirectory(ctx, t)
+		},
+		ErrorCheck: acctest.ErrorCheck(t, names.DSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectoryDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryConfig_passwordWriteOnly(rName, domainName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryExists(ctx, t, resourceName, &ds),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrPassword),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDSDirectory_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ds awstypes.DirectoryDescription
@@ -568,6 +601,25 @@ resource "aws_directory_service_directory" "test" {
 	)
 }
 
+func testAccDirectoryConfig_passwordWriteOnly(rName, domain string, passwordVersion int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name                = %[1]q
+  password_wo         = "SuperSecretPassw0rd"
+  password_wo_version = %[2]d
+  size                = "Small"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, domain, passwordVersion),
+	)
+}
+
 func testAccDirectoryConfig_tags1(rName, domain, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigVPCWithSubnets(rName, 2),
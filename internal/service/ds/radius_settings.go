@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -84,9 +85,24 @@ func resourceRadiusSettings() *schema.Resource {
 				},
 				"shared_secret": {
 					Type:         schema.TypeString,
-					Required:     true,
+					Optional:     true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringLenBetween(8, 512),
+					ExactlyOneOf: []string{"shared_secret", "shared_secret_wo"},
+				},
+				"shared_secret_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
 					Sensitive:    true,
 					ValidateFunc: validation.StringLenBetween(8, 512),
+					ExactlyOneOf: []string{"shared_secret", "shared_secret_wo"},
+					RequiredWith: []string{"shared_secret_wo_version"},
+				},
+				"shared_secret_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"shared_secret_wo"},
 				},
 				"use_same_username": {
 					Type:     schema.TypeBool,
@@ -111,11 +127,25 @@ func resourceRadiusSettingsCreate(ctx context.Context, d *schema.ResourceData, m
 			RadiusRetries:          int32(d.Get("radius_retries").(int)),
 			RadiusServers:          flex.ExpandStringValueSet(d.Get("radius_servers").(*schema.Set)),
 			RadiusTimeout:          aws.Int32(int32(d.Get("radius_timeout").(int))),
-			SharedSecret:           aws.String(d.Get("shared_secret").(string)),
 			UseSameUsername:        d.Get("use_same_username").(bool),
 		},
 	}
 
+	if v, ok := d.GetOk("shared_secret"); ok {
+		input.RadiusSettings.SharedSecret = aws.String(v.(string))
+	}
+
+	// get write-only value from configuration
+	sharedSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("shared_secret_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if sharedSecretWO != "" {
+		input.RadiusSettings.SharedSecret = aws.String(sharedSecretWO)
+	}
+
 	_, err := conn.EnableRadius(ctx, input)
 
 	if err != nil {
@@ -154,7 +184,9 @@ func resourceRadiusSettingsRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("radius_retries", output.RadiusRetries)
 	d.Set("radius_servers", output.RadiusServers)
 	d.Set("radius_timeout", output.RadiusTimeout)
-	d.Set("shared_secret", output.SharedSecret)
+	if _, ok := d.GetOk("shared_secret_wo_version"); !ok {
+		d.Set("shared_secret", output.SharedSecret)
+	}
 	d.Set("use_same_username", output.UseSameUsername)
 
 	return diags
@@ -173,11 +205,25 @@ func resourceRadiusSettingsUpdate(ctx context.Context, d *schema.ResourceData, m
 			RadiusRetries:          int32(d.Get("radius_retries").(int)),
 			RadiusServers:          flex.ExpandStringValueSet(d.Get("radius_servers").(*schema.Set)),
 			RadiusTimeout:          aws.Int32(int32(d.Get("radius_timeout").(int))),
-			SharedSecret:           aws.String(d.Get("shared_secret").(string)),
 			UseSameUsername:        d.Get("use_same_username").(bool),
 		},
 	}
 
+	if v, ok := d.GetOk("shared_secret"); ok {
+		input.RadiusSettings.SharedSecret = aws.String(v.(string))
+	}
+
+	// get write-only value from configuration
+	sharedSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("shared_secret_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if sharedSecretWO != "" {
+		input.RadiusSettings.SharedSecret = aws.String(sharedSecretWO)
+	}
+
 	_, err := conn.UpdateRadius(ctx, input)
 
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfds "github.com/hashicorp/terraform-provider-aws/internal/service/ds"
@@ -67,6 +68,53 @@ func TestAccDSRadiusSettings_basic(t *testing.T) {
 	})
 }
 
+func TestAccDSRadiusSettings_sharedSecretWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "DIRECTORY_SERVICE_RADIUS_SERVER"
+	radiusServer := os.Getenv(key)
+	if radiusServer == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v awstypes.RadiusSettings
+	resourceName := "aws_directory_service_radius_settings.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	domainName := acctest.RandomDomainName(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckDirectoryService(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckRadiusSettingsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRadiusSettingsConfig_sharedSecretWriteOnly(rName, domainName, radiusServer, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRadiusSettingsExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "shared_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "shared_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "shared_secret_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccRadiusSettingsConfig_sharedSecretWriteOnly(rName, domainName, radiusServer, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRadiusSettingsExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "shared_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "shared_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "shared_secret_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDSRadiusSettings_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	key := "DIRECTORY_SERVICE_RADIUS_SERVER"
@@ -182,3 +230,31 @@ resource "aws_directory_service_radius_settings" "test" {
 }
 `, domain, radiusServer))
 }
+
+func testAccRadiusSettingsConfig_sharedSecretWriteOnly(rName, domain, radiusServer string, sharedSecretWOVersion int) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
+resource "aws_directory_service_directory" "test" {
+  name     = %[1]q
+  password = "SuperSecretPassw0rd"
+  type     = "MicrosoftAD"
+
+  vpc_settings {
+    vpc_id     = aws_vpc.test.id
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+
+resource "aws_directory_service_radius_settings" "test" {
+  directory_id = aws_directory_service_directory.test.id
+
+  authentication_protocol  = "PAP"
+  display_label            = "test"
+  radius_port              = 1812
+  radius_retries           = 3
+  radius_servers           = [%[2]q]
+  radius_timeout           = 30
+  shared_secret_wo         = "avoid-plaintext-passwords"
+  shared_secret_wo_version = %[3]d
+}
+`, domain, radiusServer, sharedSecretWOVersion))
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/dsql/auth"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	authTokenDefaultExpiresIn = 900 // 15 minutes.
+	authTokenMaxExpiresIn     = 604800
+)
+
+// @EphemeralResource(aws_dsql_auth_token, name="Auth Token")
+func newAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &authTokenEphemeralResource{}, nil
+}
+
+type authTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[authTokenEphemeralResourceModel]
+}
+
+func (e *authTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrEndpoint: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, authTokenMaxExpiresIn),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := authTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// See https://docs.aws.amazon.com/aurora-dsql/latest/userguide/SECTION_authentication-token.html.
+	generateAuthToken := auth.GenerateDbConnectAuthToken
+	if data.Admin.ValueBool() {
+		generateAuthToken = auth.GenerateDBConnectAdminAuthToken
+	}
+	expiresIn := time.Duration(authTokenDefaultExpiresIn) * time.Second
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt32()) * time.Second
+	}
+	now := time.Now().UTC()
+
+	token, err := generateAuthToken(ctx, data.Endpoint.ValueString(), e.Meta().Region(ctx), e.Meta().CredentialsProvider(ctx), func(o *auth.TokenOptions) {
+		o.ExpiresIn = expiresIn
+	})
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("generating Aurora DSQL authentication token: %w", err))
+		return
+	}
+
+	expiresAt := now.Add(expiresIn)
+	data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	data.Token = fwflex.StringValueToFramework(ctx, token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type authTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Admin     types.Bool   `tfsdk:"admin"`
+	Endpoint  types.String `tfsdk:"endpoint"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	ExpiresIn types.Int32  `tfsdk:"expires_in"`
+	Token     types.String `tfsdk:"token"`
+}
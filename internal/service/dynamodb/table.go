@@ -50,6 +50,7 @@ const (
 )
 
 // @SDKResource("aws_dynamodb_table", name="Table")
+// @ArnFormat("table/{name}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/dynamodb/types;types.TableDescription")
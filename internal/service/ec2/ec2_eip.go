@@ -30,6 +30,7 @@ import (
 
 // @SDKResource("aws_eip", name="EIP")
 // @Tags(identifierAttribute="id")
+// @Quota(serviceCode="ec2", quotaCode="L-0263D0A3")
 // @Testing(tagsTest=false)
 // @IdentityAttribute("id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Address")
@@ -1542,7 +1542,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			}),
-			Region:   inttypes.ResourceRegionDefault(),
+			Region: inttypes.ResourceRegionDefault(),
+			Quota: unique.Make(inttypes.ServicePackageResourceQuota{
+				ServiceCode: "ec2",
+				QuotaCode:   "L-0263D0A3",
+			}),
 			Identity: inttypes.RegionalSingleParameterIdentity(inttypes.StringIdentityAttribute(names.AttrID, true)),
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
@@ -1587,7 +1591,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			}),
-			Region:   inttypes.ResourceRegionDefault(),
+			Region: inttypes.ResourceRegionDefault(),
+			Quota: unique.Make(inttypes.ServicePackageResourceQuota{
+				ServiceCode: "vpc",
+				QuotaCode:   "L-A4707A72",
+			}),
 			Identity: inttypes.RegionalSingleParameterIdentity(inttypes.StringIdentityAttribute(names.AttrID, true)),
 			Import: inttypes.SDKv2Import{
 				WrappedImport: true,
@@ -1878,7 +1886,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			}),
-			Region:   inttypes.ResourceRegionDefault(),
+			Region: inttypes.ResourceRegionDefault(),
+			Quota: unique.Make(inttypes.ServicePackageResourceQuota{
+				ServiceCode: "vpc",
+				QuotaCode:   "L-F678F1CE",
+			}),
 			Identity: inttypes.RegionalSingleParameterIdentity(inttypes.StringIdentityAttribute(names.AttrID, true)),
 			Import: inttypes.SDKv2Import{
 				CustomImport: true,
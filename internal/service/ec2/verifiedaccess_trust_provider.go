@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -91,9 +93,23 @@ func resourceVerifiedAccessTrustProvider() *schema.Resource {
 								Optional: true,
 							},
 							names.AttrClientSecret: {
-								Type:      schema.TypeString,
-								Required:  true,
-								Sensitive: true,
+								Type:         schema.TypeString,
+								Optional:     true,
+								Sensitive:    true,
+								ExactlyOneOf: []string{"native_application_oidc_options.0.client_secret", "native_application_oidc_options.0.client_secret_wo"},
+							},
+							"client_secret_wo": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								WriteOnly:    true,
+								Sensitive:    true,
+								ExactlyOneOf: []string{"native_application_oidc_options.0.client_secret", "native_application_oidc_options.0.client_secret_wo"},
+								RequiredWith: []string{"native_application_oidc_options.0.client_secret_wo_version"},
+							},
+							"client_secret_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"native_application_oidc_options.0.client_secret_wo"},
 							},
 							names.AttrIssuer: {
 								Type:         schema.TypeString,
@@ -144,9 +160,23 @@ func resourceVerifiedAccessTrustProvider() *schema.Resource {
 								Optional: true,
 							},
 							names.AttrClientSecret: {
-								Type:      schema.TypeString,
-								Required:  true,
-								Sensitive: true,
+								Type:         schema.TypeString,
+								Optional:     true,
+								Sensitive:    true,
+								ExactlyOneOf: []string{"oidc_options.0.client_secret", "oidc_options.0.client_secret_wo"},
+							},
+							"client_secret_wo": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								WriteOnly:    true,
+								Sensitive:    true,
+								ExactlyOneOf: []string{"oidc_options.0.client_secret", "oidc_options.0.client_secret_wo"},
+								RequiredWith: []string{"oidc_options.0.client_secret_wo_version"},
+							},
+							"client_secret_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"oidc_options.0.client_secret_wo"},
 							},
 							names.AttrIssuer: {
 								Type:         schema.TypeString,
@@ -241,10 +271,32 @@ func resourceVerifiedAccessTrustProviderCreate(ctx context.Context, d *schema.Re
 
 	if v, ok := d.GetOk("native_application_oidc_options"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.NativeApplicationOidcOptions = expandCreateVerifiedAccessTrustProviderNativeApplicationOIDCOptions(v.([]any)[0].(map[string]any))
+
+		// get write-only value from configuration
+		clientSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("native_application_oidc_options").IndexInt(0).GetAttr("client_secret_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if clientSecretWO != "" {
+			input.NativeApplicationOidcOptions.ClientSecret = aws.String(clientSecretWO)
+		}
 	}
 
 	if v, ok := d.GetOk("oidc_options"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.OidcOptions = expandCreateVerifiedAccessTrustProviderOIDCOptions(v.([]any)[0].(map[string]any))
+
+		// get write-only value from configuration
+		clientSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("oidc_options").IndexInt(0).GetAttr("client_secret_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if clientSecretWO != "" {
+			input.OidcOptions.ClientSecret = aws.String(clientSecretWO)
+		}
 	}
 
 	if v, ok := d.GetOk("sse_specification"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -292,14 +344,14 @@ func resourceVerifiedAccessTrustProviderRead(ctx context.Context, d *schema.Reso
 	}
 	d.Set("device_trust_provider_type", output.DeviceTrustProviderType)
 	if v := output.OidcOptions; v != nil {
-		if err := d.Set("oidc_options", flattenOIDCOptions(v, d.Get("oidc_options.0.client_secret").(string))); err != nil {
+		if err := d.Set("oidc_options", flattenOIDCOptions(v, d.Get("oidc_options.0.client_secret").(string), d.Get("oidc_options.0.client_secret_wo_version").(int))); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting oidc_options: %s", err)
 		}
 	} else {
 		d.Set("oidc_options", nil)
 	}
 	if v := output.NativeApplicationOidcOptions; v != nil {
-		if err := d.Set("native_application_oidc_options", flattenNativeApplicationOIDCOptions(v, d.Get("native_application_oidc_options.0.client_secret").(string))); err != nil {
+		if err := d.Set("native_application_oidc_options", flattenNativeApplicationOIDCOptions(v, d.Get("native_application_oidc_options.0.client_secret").(string), d.Get("native_application_oidc_options.0.client_secret_wo_version").(int))); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting native_application_oidc_options: %s", err)
 		}
 	} else {
@@ -335,12 +387,38 @@ func resourceVerifiedAccessTrustProviderUpdate(ctx context.Context, d *schema.Re
 		if d.HasChange("oidc_options") {
 			if v, ok := d.GetOk("oidc_options"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 				input.OidcOptions = expandModifyVerifiedAccessTrustProviderOIDCOptions(v.([]any)[0].(map[string]any))
+
+				if d.HasChange("oidc_options.0.client_secret_wo_version") {
+					// get write-only value from configuration
+					clientSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("oidc_options").IndexInt(0).GetAttr("client_secret_wo"))
+					diags = append(diags, di...)
+					if diags.HasError() {
+						return diags
+					}
+
+					if clientSecretWO != "" {
+						input.OidcOptions.ClientSecret = aws.String(clientSecretWO)
+					}
+				}
 			}
 		}
 
 		if d.HasChange("native_application_oidc_options") {
 			if v, ok := d.GetOk("native_application_oidc_options"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 				input.NativeApplicationOidcOptions = expandModifyVerifiedAccessTrustProviderNativeApplicationOIDCOptions(v.([]any)[0].(map[string]any))
+
+				if d.HasChange("native_application_oidc_options.0.client_secret_wo_version") {
+					// get write-only value from configuration
+					clientSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("native_application_oidc_options").IndexInt(0).GetAttr("client_secret_wo"))
+					diags = append(diags, di...)
+					if diags.HasError() {
+						return diags
+					}
+
+					if clientSecretWO != "" {
+						input.NativeApplicationOidcOptions.ClientSecret = aws.String(clientSecretWO)
+					}
+				}
 			}
 		}
 
@@ -390,13 +468,14 @@ func flattenDeviceOptions(apiObject *awstypes.DeviceOptions) []any {
 	return []any{tfMap}
 }
 
-func flattenNativeApplicationOIDCOptions(apiObject *awstypes.NativeApplicationOidcOptions, clientSecret string) []any {
+func flattenNativeApplicationOIDCOptions(apiObject *awstypes.NativeApplicationOidcOptions, clientSecret string, clientSecretWOVersion int) []any {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]any{
-		names.AttrClientSecret: clientSecret,
+		names.AttrClientSecret:     clientSecret,
+		"client_secret_wo_version": clientSecretWOVersion,
 	}
 
 	if v := apiObject.AuthorizationEndpoint; v != nil {
@@ -430,13 +509,14 @@ func flattenNativeApplicationOIDCOptions(apiObject *awstypes.NativeApplicationOi
 	return []any{tfMap}
 }
 
-func flattenOIDCOptions(apiObject *awstypes.OidcOptions, clientSecret string) []any {
+func flattenOIDCOptions(apiObject *awstypes.OidcOptions, clientSecret string, clientSecretWOVersion int) []any {
 	if apiObject == nil {
 		return nil
 	}
 
 	tfMap := map[string]any{
-		names.AttrClientSecret: clientSecret,
+		names.AttrClientSecret:     clientSecret,
+		"client_secret_wo_version": clientSecretWOVersion,
 	}
 
 	if v := apiObject.AuthorizationEndpoint; v != nil {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -189,6 +190,55 @@ func TestAccVerifiedAccessTrustProvider_oidcOptions(t *testing.T) {
 	})
 }
 
+func TestAccVerifiedAccessTrustProvider_oidcOptionsClientSecretWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.VerifiedAccessTrustProvider
+	resourceName := "aws_verifiedaccess_trust_provider.test"
+
+	trustProviderType := "user"
+	userTrustProviderType := "oidc"
+	authorizationEndpoint := "https://authorization.example.com"
+	clientId := acctest.RandString(t, 10)
+	clientSecret := acctest.RandString(t, 10)
+	issuer := "https://issuer.example.com"
+	scope := acctest.RandString(t, 10)
+	tokenEndpoint := "https://token.example.com"
+	userInfoEndpoint := "https://user.example.com"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckVerifiedAccess(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckVerifiedAccessTrustProviderDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_oidcOptionsClientSecretWriteOnly("test", trustProviderType, userTrustProviderType, authorizationEndpoint, clientId, clientSecret, issuer, scope, tokenEndpoint, userInfoEndpoint, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(ctx, t, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.client_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "oidc_options.0.client_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.client_secret_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccVerifiedAccessTrustProviderConfig_oidcOptionsClientSecretWriteOnly("test", trustProviderType, userTrustProviderType, authorizationEndpoint, clientId, clientSecret, issuer, scope, tokenEndpoint, userInfoEndpoint, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVerifiedAccessTrustProviderExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "oidc_options.0.client_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_options.0.client_secret_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVerifiedAccessTrustProvider_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.VerifiedAccessTrustProvider
@@ -375,3 +425,23 @@ resource "aws_verifiedaccess_trust_provider" "test" {
 }
 `, policyReferenceName, trustProviderType, userTrustProviderType, description, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccVerifiedAccessTrustProviderConfig_oidcOptionsClientSecretWriteOnly(policyReferenceName, trustProviderType, userTrustProviderType, authorizationEndpoint, clientId, clientSecret, issuer, scope, tokenEndpoint, userInfoEndpoint string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedaccess_trust_provider" "test" {
+  oidc_options {
+    authorization_endpoint   = %[4]q
+    client_id                = %[5]q
+    client_secret_wo         = %[6]q
+    client_secret_wo_version = %[11]d
+    issuer                   = %[7]q
+    scope                    = %[8]q
+    token_endpoint           = %[9]q
+    user_info_endpoint       = %[10]q
+  }
+  policy_reference_name    = %[1]q
+  trust_provider_type      = %[2]q
+  user_trust_provider_type = %[3]q
+}
+`, policyReferenceName, trustProviderType, userTrustProviderType, authorizationEndpoint, clientId, clientSecret, issuer, scope, tokenEndpoint, userInfoEndpoint, version)
+}
@@ -58,6 +58,7 @@ var (
 
 // @SDKResource("aws_vpc", name="VPC")
 // @Tags(identifierAttribute="id")
+// @Quota(serviceCode="vpc", quotaCode="L-F678F1CE")
 // @IdentityAttribute("id")
 // @CustomImport
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Vpc")
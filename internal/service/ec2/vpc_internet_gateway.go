@@ -27,6 +27,7 @@ import (
 
 // @SDKResource("aws_internet_gateway", name="Internet Gateway")
 // @Tags(identifierAttribute="id")
+// @Quota(serviceCode="vpc", quotaCode="L-A4707A72")
 // @Testing(tagsTest=false)
 // @IdentityAttribute("id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.InternetGateway")
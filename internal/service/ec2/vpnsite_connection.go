@@ -21,12 +21,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -346,11 +348,26 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 				"tunnel1_preshared_key": {
-					Type:         schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Computed:      true,
+					ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
+					ConflictsWith: []string{"tunnel1_preshared_key_wo"},
+				},
+				"tunnel1_preshared_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
+					ConflictsWith: []string{"tunnel1_preshared_key"},
+					RequiredWith:  []string{"tunnel1_preshared_key_wo_version"},
+				},
+				"tunnel1_preshared_key_wo_version": {
+					Type:         schema.TypeInt,
 					Optional:     true,
-					Sensitive:    true,
-					Computed:     true,
-					ValidateFunc: validVPNConnectionTunnelPreSharedKey(),
+					RequiredWith: []string{"tunnel1_preshared_key_wo"},
 				},
 				"tunnel1_rekey_fuzz_percentage": {
 					Type:         schema.TypeInt,
@@ -573,11 +590,26 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 				"tunnel2_preshared_key": {
-					Type:         schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					Computed:      true,
+					ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
+					ConflictsWith: []string{"tunnel2_preshared_key_wo"},
+				},
+				"tunnel2_preshared_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
+					ConflictsWith: []string{"tunnel2_preshared_key"},
+					RequiredWith:  []string{"tunnel2_preshared_key_wo_version"},
+				},
+				"tunnel2_preshared_key_wo_version": {
+					Type:         schema.TypeInt,
 					Optional:     true,
-					Sensitive:    true,
-					Computed:     true,
-					ValidateFunc: validVPNConnectionTunnelPreSharedKey(),
+					RequiredWith: []string{"tunnel2_preshared_key_wo"},
 				},
 				"tunnel2_rekey_fuzz_percentage": {
 					Type:         schema.TypeInt,
@@ -734,6 +766,18 @@ func resourceVPNConnectionCreate(ctx context.Context, d *schema.ResourceData, me
 		Type:              aws.String(d.Get(names.AttrType).(string)),
 	}
 
+	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		presharedKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath(prefix+"preshared_key_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if presharedKeyWO != "" {
+			input.Options.TunnelOptions[i].PreSharedKey = aws.String(presharedKeyWO)
+		}
+	}
+
 	if v, ok := d.GetOk("preshared_key_storage"); ok {
 		input.PreSharedKeyStorage = aws.String(v.(string))
 	}
@@ -869,13 +913,23 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("tunnel1_bgp_asn", tunnelInfo.Tunnel1BGPASN)
 		d.Set("tunnel1_bgp_holdtime", tunnelInfo.Tunnel1BGPHoldTime)
 		d.Set("tunnel1_cgw_inside_address", tunnelInfo.Tunnel1CgwInsideAddress)
-		d.Set("tunnel1_preshared_key", tunnelInfo.Tunnel1PreSharedKey)
+		if _, ok := d.GetOk("tunnel1_preshared_key_wo_version"); ok {
+			// The key was supplied via the write-only argument, so keep it out of state.
+			d.Set("tunnel1_preshared_key", nil)
+		} else {
+			d.Set("tunnel1_preshared_key", tunnelInfo.Tunnel1PreSharedKey)
+		}
 		d.Set("tunnel1_vgw_inside_address", tunnelInfo.Tunnel1VgwInsideAddress)
 		d.Set("tunnel2_address", tunnelInfo.Tunnel2Address)
 		d.Set("tunnel2_bgp_asn", tunnelInfo.Tunnel2BGPASN)
 		d.Set("tunnel2_bgp_holdtime", tunnelInfo.Tunnel2BGPHoldTime)
 		d.Set("tunnel2_cgw_inside_address", tunnelInfo.Tunnel2CgwInsideAddress)
-		d.Set("tunnel2_preshared_key", tunnelInfo.Tunnel2PreSharedKey)
+		if _, ok := d.GetOk("tunnel2_preshared_key_wo_version"); ok {
+			// The key was supplied via the write-only argument, so keep it out of state.
+			d.Set("tunnel2_preshared_key", nil)
+		} else {
+			d.Set("tunnel2_preshared_key", tunnelInfo.Tunnel2PreSharedKey)
+		}
 		d.Set("tunnel2_vgw_inside_address", tunnelInfo.Tunnel2VgwInsideAddress)
 	} else {
 		// This element is present in the DescribeVpnConnections response only if the VPN connection is in the pending or available state.
@@ -971,7 +1025,24 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	for i, prefix := range []string{"tunnel1_", "tunnel2_"} {
-		if options, address, pskStorageChanged := expandModifyVPNTunnelOptionsSpecification(d, prefix), d.Get(prefix+names.AttrAddress).(string), d.HasChange("preshared_key_storage"); (options != nil || pskStorageChanged) && address != "" {
+		options := expandModifyVPNTunnelOptionsSpecification(d, prefix)
+
+		if d.HasChange(prefix + "preshared_key_wo_version") {
+			presharedKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath(prefix+"preshared_key_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if presharedKeyWO != "" {
+				if options == nil {
+					options = &awstypes.ModifyVpnTunnelOptionsSpecification{}
+				}
+				options.PreSharedKey = aws.String(presharedKeyWO)
+			}
+		}
+
+		if address, pskStorageChanged := d.Get(prefix+names.AttrAddress).(string), d.HasChange("preshared_key_storage"); (options != nil || pskStorageChanged) && address != "" {
 			input := ec2.ModifyVpnTunnelOptionsInput{
 				VpnConnectionId:           aws.String(d.Id()),
 				VpnTunnelOutsideIpAddress: aws.String(address),
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
@@ -646,6 +647,46 @@ func TestAccSiteVPNConnection_tunnel1PreSharedKey(t *testing.T) {
 	})
 }
 
+func TestAccSiteVPNConnection_tunnelPreSharedKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	rBgpAsn := acctest.RandIntRange(t, 64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn awstypes.VpnConnection
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckVPNConnectionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPNConnectionConfig_tunnelPresharedKeyWriteOnly(rName, rBgpAsn, "tunnel1presharedkey", "tunnel2presharedkey", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, t, resourceName, &vpn),
+					resource.TestCheckNoResourceAttr(resourceName, "tunnel1_preshared_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "tunnel2_preshared_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccVPNConnectionConfig_tunnelPresharedKeyWriteOnly(rName, rBgpAsn, "tunnel1presharedkeyupdated", "tunnel2presharedkeyupdated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, t, resourceName, &vpn),
+					resource.TestCheckNoResourceAttr(resourceName, "tunnel1_preshared_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "tunnel2_preshared_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSiteVPNConnection_tunnelOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -2779,6 +2820,40 @@ resource "aws_vpn_connection" "test" {
 `, rName, rBgpAsn, tunnel1PresharedKey, tunnel2PresharedKey)
 }
 
+func testAccVPNConnectionConfig_tunnelPresharedKeyWriteOnly(rName string, rBgpAsn int, tunnel1PresharedKey, tunnel2PresharedKey string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id              = aws_customer_gateway.test.id
+  tunnel1_preshared_key_wo         = %[3]q
+  tunnel1_preshared_key_wo_version = %[5]d
+  tunnel2_preshared_key_wo         = %[4]q
+  tunnel2_preshared_key_wo_version = %[5]d
+  type                             = "ipsec.1"
+  vpn_gateway_id                   = aws_vpn_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, tunnel1PresharedKey, tunnel2PresharedKey, version)
+}
+
 func testAccVPNConnectionConfig_tunnelOptions(
 	rName string,
 	rBgpAsn int,
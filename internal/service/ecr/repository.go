@@ -32,6 +32,7 @@ import (
 )
 
 // @SDKResource("aws_ecr_repository", name="Repository")
+// @ArnFormat("repository/{name}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ecr/types;types.Repository")
@@ -39,6 +39,7 @@ import (
 )
 
 // @SDKResource("aws_ecs_task_definition", name="Task Definition")
+// @ArnFormat("task-definition/{family}:{revision}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @IdentityAttribute("family")
 // @IdentityAttribute("revision", valueType="int")
@@ -22,6 +22,23 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newUpdateAddonAction,
+			TypeName: "aws_eks_update_addon",
+			Name:     "Update Add-On",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+		{
+			Factory:  newUpdateNodegroupVersionAction,
+			TypeName: "aws_eks_update_nodegroup_version",
+			Name:     "Update Node Group Version",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
 		{
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_eks_update_addon, name="Update Add-On")
+func newUpdateAddonAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a updateAddonAction
+	a.SetDefaultInvokeTimeout(20 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action = (*updateAddonAction)(nil)
+)
+
+type updateAddonAction struct {
+	framework.ActionWithModel[updateAddonActionModel]
+	framework.ActionWithTimeouts
+}
+
+type updateAddonActionModel struct {
+	framework.WithRegionModel
+	AddonName             types.String                                  `tfsdk:"addon_name"`
+	AddonVersion          types.String                                  `tfsdk:"addon_version"`
+	ClusterName           types.String                                  `tfsdk:"cluster_name"`
+	ConfigurationValues   types.String                                  `tfsdk:"configuration_values"`
+	ResolveConflicts      fwtypes.StringEnum[awstypes.ResolveConflicts] `tfsdk:"resolve_conflicts"`
+	ServiceAccountRoleARN fwtypes.ARN                                   `tfsdk:"service_account_role_arn"`
+	Timeouts              timeouts.Value                                `tfsdk:"timeouts"`
+}
+
+func (a *updateAddonAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Updates an EKS add-on to a new version or configuration and waits for the update to complete.",
+		Attributes: map[string]schema.Attribute{
+			"addon_name": schema.StringAttribute{
+				Description: "Name of the add-on to update.",
+				Required:    true,
+			},
+			"addon_version": schema.StringAttribute{
+				Description: "Version of the add-on to update to.",
+				Optional:    true,
+			},
+			names.AttrClusterName: schema.StringAttribute{
+				Description: "Name of the EKS cluster.",
+				Required:    true,
+			},
+			"configuration_values": schema.StringAttribute{
+				Description: "Custom configuration values for the add-on, as a JSON string.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.JSON(),
+				},
+			},
+			"resolve_conflicts": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.ResolveConflicts](),
+				Description: "How to resolve field value conflicts for the add-on. Valid values are 'NONE', 'OVERWRITE' and 'PRESERVE'. Use 'OVERWRITE' to force the update over local changes.",
+				Optional:    true,
+			},
+			"service_account_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of an existing IAM role to bind to the add-on's service account.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *updateAddonAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config updateAddonActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+
+	// Get AWS client
+	conn := a.Meta().EKSClient(ctx)
+
+	clusterName := fwflex.StringValueFromFramework(ctx, config.ClusterName)
+	addonName := fwflex.StringValueFromFramework(ctx, config.AddonName)
+
+	ctx = tflog.SetField(ctx, names.AttrClusterName, clusterName)
+	ctx = tflog.SetField(ctx, "addon_name", addonName)
+
+	tflog.Info(ctx, "Starting EKS add-on update action", map[string]any{
+		"addon_version":     config.AddonVersion.ValueString(),
+		"resolve_conflicts": config.ResolveConflicts.ValueString(),
+		names.AttrTimeout:   timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting update for EKS add-on %s in cluster %s...", addonName, clusterName)
+
+	var input eks.UpdateAddonInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.ClientRequestToken = aws.String(create.UniqueId(ctx))
+
+	output, err := conn.UpdateAddon(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Add-On",
+			fmt.Sprintf("Could not start update for EKS add-on %s in cluster %s: %s", addonName, clusterName, err),
+		)
+		return
+	}
+
+	updateID := aws.ToString(output.Update.Id)
+	cb(ctx, "Update %s started for EKS add-on %s, waiting for completion...", updateID, addonName)
+
+	update, err := waitUpdateSuccessfulWithProgress(ctx, cb, func(ctx context.Context) (*awstypes.Update, error) {
+		return findAddonUpdateByThreePartKey(ctx, conn, clusterName, addonName, updateID)
+	}, timeout)
+	if err != nil {
+		addUpdateWaitError(resp, fmt.Sprintf("EKS add-on %s update %s", addonName, updateID), update, timeout, err)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "EKS add-on %s update %s completed successfully", addonName, updateID)
+
+	tflog.Info(ctx, "EKS add-on update action completed successfully", map[string]any{
+		"update_id": updateID,
+	})
+}
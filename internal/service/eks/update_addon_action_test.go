@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSUpdateAddonAction_configurationValues(t *testing.T) {
+	ctx := acctest.Context(t)
+	var addon types.Addon
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_eks_addon.test"
+	addonName := addonNames[0]
+	configurationValues := `{"env":{"WARM_ENI_TARGET":"2"}}`
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckAddonDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateAddonActionConfig_configurationValues(rName, addonName, configurationValues),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAddonExists(ctx, t, resourceName, &addon),
+					testAccCheckUpdateAddonActionConfigurationValues(&addon, configurationValues),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckUpdateAddonActionConfigurationValues verifies that the action applied the configuration values
+func testAccCheckUpdateAddonActionConfigurationValues(addon *types.Addon, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToString(addon.ConfigurationValues); got != expected {
+			return fmt.Errorf("EKS Add-On (%s) configuration values = %s, expected %s", aws.ToString(addon.AddonName), got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccUpdateAddonActionConfig_configurationValues(rName, addonName, configurationValues string) string {
+	return acctest.ConfigCompose(testAccAddonConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = %[2]q
+
+  lifecycle {
+    ignore_changes = [configuration_values]
+  }
+}
+
+action "aws_eks_update_addon" "test" {
+  config {
+    cluster_name         = aws_eks_addon.test.cluster_name
+    addon_name           = aws_eks_addon.test.addon_name
+    configuration_values = %[3]q
+    resolve_conflicts    = "OVERWRITE"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_eks_addon.test.arn
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_eks_update_addon.test]
+    }
+  }
+}
+`, rName, addonName, configurationValues))
+}
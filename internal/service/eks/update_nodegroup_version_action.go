@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action(aws_eks_update_nodegroup_version, name="Update Node Group Version")
+func newUpdateNodegroupVersionAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a updateNodegroupVersionAction
+	a.SetDefaultInvokeTimeout(60 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action = (*updateNodegroupVersionAction)(nil)
+)
+
+type updateNodegroupVersionAction struct {
+	framework.ActionWithModel[updateNodegroupVersionActionModel]
+	framework.ActionWithTimeouts
+}
+
+type updateNodegroupVersionActionModel struct {
+	framework.WithRegionModel
+	ClusterName    types.String                                                      `tfsdk:"cluster_name"`
+	Force          types.Bool                                                        `tfsdk:"force"`
+	LaunchTemplate fwtypes.ListNestedObjectValueOf[launchTemplateSpecificationModel] `tfsdk:"launch_template"`
+	NodegroupName  types.String                                                      `tfsdk:"node_group_name"`
+	ReleaseVersion types.String                                                      `tfsdk:"release_version"`
+	Timeouts       timeouts.Value                                                    `tfsdk:"timeouts"`
+	Version        types.String                                                      `tfsdk:"version"`
+}
+
+type launchTemplateSpecificationModel struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
+
+func (a *updateNodegroupVersionAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Updates the Kubernetes version, AMI release version or launch template version of an EKS managed node group and waits for the rolling update to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Description: "Name of the EKS cluster.",
+				Required:    true,
+			},
+			"force": schema.BoolAttribute{
+				Description: "Force the update even if existing pods cannot be drained due to a pod disruption budget issue.",
+				Optional:    true,
+			},
+			"node_group_name": schema.StringAttribute{
+				Description: "Name of the managed node group to update.",
+				Required:    true,
+			},
+			"release_version": schema.StringAttribute{
+				Description: "AMI release version to update to. Defaults to the latest AMI release version for the node group's Kubernetes version.",
+				Optional:    true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Description: "Kubernetes version to update to. Defaults to the cluster's Kubernetes version.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrLaunchTemplate: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[launchTemplateSpecificationModel](ctx),
+				Description: "Launch template version to update the node group to.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Description: "ID of the launch template. Conflicts with name.",
+							Optional:    true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Description: "Name of the launch template. Conflicts with id.",
+							Optional:    true,
+						},
+						names.AttrVersion: schema.StringAttribute{
+							Description: "Launch template version number.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *updateNodegroupVersionAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	return []action.ConfigValidator{
+		actionvalidator.Conflicting(
+			path.MatchRoot(names.AttrLaunchTemplate),
+			path.MatchRoot(names.AttrVersion),
+		),
+		actionvalidator.Conflicting(
+			path.MatchRoot(names.AttrLaunchTemplate),
+			path.MatchRoot("release_version"),
+		),
+		actionvalidator.Conflicting(
+			path.MatchRoot(names.AttrLaunchTemplate).AtAnyListIndex().AtName(names.AttrID),
+			path.MatchRoot(names.AttrLaunchTemplate).AtAnyListIndex().AtName(names.AttrName),
+		),
+	}
+}
+
+func (a *updateNodegroupVersionAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config updateNodegroupVersionActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+
+	// Get AWS client
+	conn := a.Meta().EKSClient(ctx)
+
+	clusterName := fwflex.StringValueFromFramework(ctx, config.ClusterName)
+	nodeGroupName := fwflex.StringValueFromFramework(ctx, config.NodegroupName)
+
+	ctx = tflog.SetField(ctx, names.AttrClusterName, clusterName)
+	ctx = tflog.SetField(ctx, "node_group_name", nodeGroupName)
+
+	tflog.Info(ctx, "Starting EKS node group version update action", map[string]any{
+		names.AttrVersion: config.Version.ValueString(),
+		"release_version": config.ReleaseVersion.ValueString(),
+		"force":           config.Force.ValueBool(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting version update for EKS node group %s in cluster %s...", nodeGroupName, clusterName)
+
+	var input eks.UpdateNodegroupVersionInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.ClientRequestToken = aws.String(create.UniqueId(ctx))
+
+	output, err := conn.UpdateNodegroupVersion(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Node Group Version",
+			fmt.Sprintf("Could not start version update for EKS node group %s in cluster %s: %s", nodeGroupName, clusterName, err),
+		)
+		return
+	}
+
+	updateID := aws.ToString(output.Update.Id)
+	cb(ctx, "Update %s started for EKS node group %s, waiting for completion...", updateID, nodeGroupName)
+
+	update, err := waitUpdateSuccessfulWithProgress(ctx, cb, func(ctx context.Context) (*awstypes.Update, error) {
+		return findNodegroupUpdateByThreePartKey(ctx, conn, clusterName, nodeGroupName, updateID)
+	}, timeout)
+	if err != nil {
+		addUpdateWaitError(resp, fmt.Sprintf("EKS node group %s version update %s", nodeGroupName, updateID), update, timeout, err)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "EKS node group %s version update %s completed successfully", nodeGroupName, updateID)
+
+	tflog.Info(ctx, "EKS node group version update action completed successfully", map[string]any{
+		"update_id": updateID,
+	})
+}
+
+// waitUpdateSuccessfulWithProgress polls an EKS update until it succeeds, reporting its status and any errors as progress.
+func waitUpdateSuccessfulWithProgress(ctx context.Context, cb fwactions.SendProgressFunc, fetch func(context.Context) (*awstypes.Update, error), timeout time.Duration) (*awstypes.Update, error) {
+	var lastErrors string
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.Update], error) {
+		output, err := fetch(ctx)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.Update]{}, fmt.Errorf("describing update: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.Update]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*awstypes.Update]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(actionwait.DefaultPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates:    []actionwait.Status{actionwait.Status(awstypes.UpdateStatusSuccessful)},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.UpdateStatusInProgress),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.UpdateStatusFailed),
+			actionwait.Status(awstypes.UpdateStatusCancelled),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Update is currently %q (elapsed %s), continuing to wait for completion...", fr.Status, meta.Elapsed.Truncate(time.Second))
+
+			// Only report update errors when they change.
+			if update, ok := fr.Value.(*awstypes.Update); ok && len(update.Errors) > 0 {
+				if v := errorDetailsError(update.Errors).Error(); v != lastErrors {
+					lastErrors = v
+					cb(ctx, "Update reported errors:\n%s", v)
+				}
+			}
+		},
+	})
+
+	return fr.Value, err
+}
+
+// addUpdateWaitError adds an error diagnostic for a failed wait on an EKS update, including any errors reported by the update.
+func addUpdateWaitError(resp *action.InvokeResponse, description string, update *awstypes.Update, timeout time.Duration, err error) {
+	var summary, detail string
+
+	switch {
+	case errs.IsA[*actionwait.TimeoutError](err):
+		summary = "Timeout Waiting for Update to Complete"
+		detail = fmt.Sprintf("%s did not complete within %s: %s", description, timeout, err)
+	case errs.IsA[*actionwait.FailureStateError](err):
+		summary = "Update Failed"
+		detail = fmt.Sprintf("%s failed: %s", description, err)
+	case errs.IsA[*actionwait.UnexpectedStateError](err):
+		summary = "Unexpected Update State"
+		detail = fmt.Sprintf("%s entered unexpected state: %s", description, err)
+	default:
+		summary = "Failed While Waiting for Update to Complete"
+		detail = fmt.Sprintf("Error waiting for %s: %s", description, err)
+	}
+
+	if update != nil && len(update.Errors) > 0 {
+		detail = fmt.Sprintf("%s\n\nUpdate errors:\n%s", detail, errorDetailsError(update.Errors))
+	}
+
+	resp.Diagnostics.AddError(summary, detail)
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSUpdateNodegroupVersionAction_version(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckNodeGroupDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUpdateNodegroupVersionActionConfig_version(rName, clusterVersion130),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUpdateNodegroupVersionAction(ctx, t, rName, clusterVersion130),
+				),
+			},
+			{
+				Config: testAccUpdateNodegroupVersionActionConfig_version(rName, clusterVersion131),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUpdateNodegroupVersionAction(ctx, t, rName, clusterVersion131),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckUpdateNodegroupVersionAction verifies that the node group runs the expected Kubernetes version
+func testAccCheckUpdateNodegroupVersionAction(ctx context.Context, t *testing.T, rName, expectedVersion string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).EKSClient(ctx)
+
+		output, err := tfeks.FindNodegroupByTwoPartKey(ctx, conn, rName, rName)
+		if err != nil {
+			return fmt.Errorf("reading EKS Node Group (%s): %w", rName, err)
+		}
+
+		if got := aws.ToString(output.Version); got != expectedVersion {
+			return fmt.Errorf("EKS Node Group (%s) version = %s, expected %s", rName, got, expectedVersion)
+		}
+
+		return nil
+	}
+}
+
+func testAccUpdateNodegroupVersionActionConfig_version(rName, version string) string {
+	return acctest.ConfigCompose(testAccNodeGroupConfig_versionBase(rName, version), fmt.Sprintf(`
+resource "aws_eks_node_group" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  node_group_name = %[1]q
+  node_role_arn   = aws_iam_role.node.arn
+  subnet_ids      = aws_subnet.test[*].id
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 1
+    min_size     = 1
+  }
+
+  lifecycle {
+    ignore_changes = [release_version, version]
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodeMinimalPolicy,
+  ]
+}
+
+action "aws_eks_update_nodegroup_version" "test" {
+  config {
+    cluster_name    = aws_eks_node_group.test.cluster_name
+    node_group_name = aws_eks_node_group.test.node_group_name
+    version         = aws_eks_cluster.test.version
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_eks_cluster.test.version
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.aws_eks_update_nodegroup_version.test]
+    }
+  }
+}
+`, rName))
+}
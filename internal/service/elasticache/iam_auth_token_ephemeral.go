@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+const (
+	// IAM authentication tokens are valid for 15 minutes.
+	iamAuthTokenExpiration = 15 * time.Minute
+	// SHA-256 hash of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_elasticache_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigValidators = (*iamAuthTokenEphemeralResource)(nil)
+)
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"replication_group_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"serverless_cache_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"user_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) ConfigValidators(context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("replication_group_id"),
+			path.MatchRoot("serverless_cache_name"),
+		),
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := iamAuthTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := e.Meta().CredentialsProvider(ctx).Retrieve(ctx)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("retrieving AWS credentials: %w", err))
+		return
+	}
+
+	cacheName, serverless := data.ReplicationGroupID.ValueString(), false
+	if !data.ServerlessCacheName.IsNull() {
+		cacheName, serverless = data.ServerlessCacheName.ValueString(), true
+	}
+	now := time.Now().UTC()
+
+	token, err := buildIAMAuthToken(ctx, credentials, cacheName, data.UserID.ValueString(), e.Meta().Region(ctx), serverless, now)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	expiresAt := now.Add(iamAuthTokenExpiration)
+	data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	data.Token = fwflex.StringValueToFramework(ctx, token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+// buildIAMAuthToken signs an IAM authentication token for the specified replication group or serverless cache and user.
+// See https://docs.aws.amazon.com/AmazonElastiCache/latest/dg/auth-iam.html.
+func buildIAMAuthToken(ctx context.Context, credentials aws.Credentials, cacheName, userID, region string, serverless bool, signingTime time.Time) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+cacheName+"/", nil)
+	if err != nil {
+		return "", fmt.Errorf("creating ElastiCache IAM authentication request: %w", err)
+	}
+
+	query := url.Values{
+		"Action":        []string{"connect"},
+		"User":          []string{userID},
+		"X-Amz-Expires": []string{strconv.Itoa(int(iamAuthTokenExpiration.Seconds()))},
+	}
+	if serverless {
+		query.Set("ResourceType", "ServerlessCache")
+	}
+	request.URL.RawQuery = query.Encode()
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, "elasticache", region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("signing ElastiCache IAM authentication token: %w", err)
+	}
+
+	return strings.TrimPrefix(signedURI, "http://"), nil
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ExpiresAt           types.String `tfsdk:"expires_at"`
+	ReplicationGroupID  types.String `tfsdk:"replication_group_id"`
+	ServerlessCacheName types.String `tfsdk:"serverless_cache_name"`
+	Token               types.String `tfsdk:"token"`
+	UserID              types.String `tfsdk:"user_id"`
+}
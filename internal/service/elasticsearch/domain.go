@@ -150,10 +150,12 @@ func resourceDomain() *schema.Resource {
 											Type:     schema.TypeString,
 											Optional: true,
 										},
+										// The write-only counterpart is the top-level master_user_password_wo argument.
 										"master_user_password": {
-											Type:      schema.TypeString,
-											Optional:  true,
-											Sensitive: true,
+											Type:          schema.TypeString,
+											Optional:      true,
+											Sensitive:     true,
+											ConflictsWith: []string{"master_user_password_wo"},
 										},
 									},
 								},
@@ -475,6 +477,20 @@ func resourceDomain() *schema.Resource {
 						},
 					},
 				},
+				// Blocks with Computed set to true, such as advanced_security_options, cannot contain write-only attributes.
+				"master_user_password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+					RequiredWith:  []string{"master_user_password_wo_version"},
+				},
+				"master_user_password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"master_user_password_wo"},
+				},
 				"node_to_node_encryption": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -576,6 +592,11 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]any))
 	}
 
+	diags = append(diags, expandMasterUserPasswordWO(d, input.AdvancedSecurityOptions)...)
+	if diags.HasError() {
+		return diags
+	}
+
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]any)) > 0 {
 		input.AutoTuneOptions = expandAutoTuneOptionsInput(v.([]any)[0].(map[string]any))
 	}
@@ -857,8 +878,13 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			input.AdvancedOptions = flex.ExpandStringValueMap(d.Get("advanced_options").(map[string]any))
 		}
 
-		if d.HasChange("advanced_security_options") {
+		if d.HasChanges("advanced_security_options", "master_user_password_wo_version") {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]any))
+
+			diags = append(diags, expandMasterUserPasswordWO(d, input.AdvancedSecurityOptions)...)
+			if diags.HasError() {
+				return diags
+			}
 		}
 
 		if d.HasChange("auto_tune_options") {
@@ -8,7 +8,11 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -50,6 +54,23 @@ func expandAdvancedSecurityOptions(m []any) *awstypes.AdvancedSecurityOptionsInp
 	return &config
 }
 
+// expandMasterUserPasswordWO sets the master user password from the write-only master_user_password_wo argument, if configured.
+// The password can only be set if advanced_security_options.master_user_options is configured.
+func expandMasterUserPasswordWO(d *schema.ResourceData, apiObject *awstypes.AdvancedSecurityOptionsInput) diag.Diagnostics {
+	passwordWO, diags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_user_password_wo"))
+	if diags.HasError() || passwordWO == "" {
+		return diags
+	}
+
+	if apiObject == nil || apiObject.MasterUserOptions == nil {
+		return sdkdiag.AppendErrorf(diags, "master_user_password_wo: advanced_security_options.master_user_options must be configured")
+	}
+
+	apiObject.MasterUserOptions.MasterUserPassword = aws.String(passwordWO)
+
+	return diags
+}
+
 func expandAutoTuneOptions(tfMap map[string]any) *awstypes.AutoTuneOptions {
 	if tfMap == nil {
 		return nil
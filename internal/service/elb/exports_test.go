@@ -23,7 +23,6 @@ var (
 	FindLoadBalancerListenerPolicyByThreePartKey    = findLoadBalancerListenerPolicyByThreePartKey
 	FindLoadBalancerListenerPolicyByTwoPartKey      = findLoadBalancerListenerPolicyByTwoPartKey
 	FindLoadBalancerPolicyByTwoPartKey              = findLoadBalancerPolicyByTwoPartKey
-	HostedZoneIDPerRegionMap                        = hostedZoneIDPerRegionMap
 	LBCookieStickinessPolicyParseResourceID         = lbCookieStickinessPolicyParseResourceID
 	ListenerHash                                    = listenerHash
 	ListenerPolicyParseResourceID                   = listenerPolicyParseResourceID
@@ -151,9 +151,19 @@ func resourceListener() *schema.Resource {
 										},
 										names.AttrClientSecret: {
 											Type:      schema.TypeString,
-											Required:  true,
+											Optional:  true,
 											Sensitive: true,
 										},
+										"client_secret_wo": {
+											Type:      schema.TypeString,
+											Optional:  true,
+											WriteOnly: true,
+											Sensitive: true,
+										},
+										"client_secret_wo_version": {
+											Type:     schema.TypeInt,
+											Optional: true,
+										},
 										names.AttrIssuer: {
 											Type:     schema.TypeString,
 											Required: true,
@@ -637,6 +647,7 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta an
 
 	if v, ok := d.GetOk(names.AttrDefaultAction); ok && len(v.([]any)) > 0 {
 		input.DefaultActions = expandListenerActions(cty.GetAttrPath(names.AttrDefaultAction), v.([]any), &diags)
+		expandListenerActionsWriteOnly(d, cty.GetAttrPath(names.AttrDefaultAction), input.DefaultActions, &diags)
 		if diags.HasError() {
 			return diags
 		}
@@ -802,6 +813,7 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta an
 
 		if d.HasChange(names.AttrDefaultAction) {
 			input.DefaultActions = expandListenerActions(cty.GetAttrPath(names.AttrDefaultAction), d.Get(names.AttrDefaultAction).([]any), &diags)
+			expandListenerActionsWriteOnly(d, cty.GetAttrPath(names.AttrDefaultAction), input.DefaultActions, &diags)
 			if diags.HasError() {
 				return diags
 			}
@@ -1178,6 +1190,23 @@ func expandListenerActions(actionsPath cty.Path, l []any, diags *diag.Diagnostic
 	return actions
 }
 
+// expandListenerActionsWriteOnly sets write-only values from configuration on the expanded actions.
+func expandListenerActionsWriteOnly(d *schema.ResourceData, actionsPath cty.Path, actions []awstypes.Action, diags *diag.Diagnostics) {
+	for i, action := range actions {
+		if action.AuthenticateOidcConfig == nil {
+			continue
+		}
+
+		// get write-only value from configuration
+		clientSecretWO, di := flex.GetWriteOnlyStringValue(d, actionsPath.IndexInt(i).GetAttr("authenticate_oidc").IndexInt(0).GetAttr("client_secret_wo"))
+		*diags = append(*diags, di...)
+
+		if clientSecretWO != "" {
+			actions[i].AuthenticateOidcConfig.ClientSecret = aws.String(clientSecretWO)
+		}
+	}
+}
+
 func expandListenerAction(actionPath cty.Path, i int, tfMap map[string]any, diags *diag.Diagnostics) awstypes.Action {
 	action := awstypes.Action{
 		Order: aws.Int32(int32(i + 1)),
@@ -1280,12 +1309,15 @@ func expandAuthenticateOIDCConfig(l []any) *awstypes.AuthenticateOidcActionConfi
 		AuthenticationRequestExtraParams: flex.ExpandStringValueMap(tfMap["authentication_request_extra_params"].(map[string]any)),
 		AuthorizationEndpoint:            aws.String(tfMap["authorization_endpoint"].(string)),
 		ClientId:                         aws.String(tfMap[names.AttrClientID].(string)),
-		ClientSecret:                     aws.String(tfMap[names.AttrClientSecret].(string)),
 		Issuer:                           aws.String(tfMap[names.AttrIssuer].(string)),
 		TokenEndpoint:                    aws.String(tfMap["token_endpoint"].(string)),
 		UserInfoEndpoint:                 aws.String(tfMap["user_info_endpoint"].(string)),
 	}
 
+	if v, ok := tfMap[names.AttrClientSecret].(string); ok && v != "" {
+		config.ClientSecret = aws.String(v)
+	}
+
 	if v, ok := tfMap["on_unauthenticated_request"].(string); ok && v != "" {
 		config.OnUnauthenticatedRequest = awstypes.AuthenticateOidcActionConditionalBehaviorEnum(v)
 	}
@@ -1551,8 +1583,12 @@ func flattenListenerActions(d *schema.ResourceData, attrName string, apiObjects
 			if v, ok := d.GetOk(attrName + "." + strconv.Itoa(i) + ".authenticate_oidc.0.client_secret"); ok {
 				clientSecret = v.(string)
 			}
+			var clientSecretWOVersion int
+			if v, ok := d.GetOk(attrName + "." + strconv.Itoa(i) + ".authenticate_oidc.0.client_secret_wo_version"); ok {
+				clientSecretWOVersion = v.(int)
+			}
 
-			tfMap["authenticate_oidc"] = flattenAuthenticateOIDCActionConfig(apiObject.AuthenticateOidcConfig, clientSecret)
+			tfMap["authenticate_oidc"] = flattenAuthenticateOIDCActionConfig(apiObject.AuthenticateOidcConfig, clientSecret, clientSecretWOVersion)
 
 		case awstypes.ActionTypeEnumJwtValidation:
 			tfMap["jwt_validation"] = flattenListenerActionJWTValidationConfig(apiObject.JwtValidationConfig)
@@ -1652,7 +1688,7 @@ func flattenMutualAuthenticationAttributes(apiObject *awstypes.MutualAuthenticat
 	}
 }
 
-func flattenAuthenticateOIDCActionConfig(apiObject *awstypes.AuthenticateOidcActionConfig, clientSecret string) []any {
+func flattenAuthenticateOIDCActionConfig(apiObject *awstypes.AuthenticateOidcActionConfig, clientSecret string, clientSecretWOVersion int) []any {
 	if apiObject == nil {
 		return []any{}
 	}
@@ -1671,6 +1707,9 @@ func flattenAuthenticateOIDCActionConfig(apiObject *awstypes.AuthenticateOidcAct
 	if clientSecret != "" {
 		tfMap[names.AttrClientSecret] = clientSecret
 	}
+	if clientSecretWOVersion != 0 {
+		tfMap["client_secret_wo_version"] = clientSecretWOVersion
+	}
 	if apiObject.Issuer != nil {
 		tfMap[names.AttrIssuer] = aws.ToString(apiObject.Issuer)
 	}
@@ -2017,6 +2056,8 @@ func listenerActionPlantimeValidate(actionPath cty.Path, action cty.Value, diags
 					actionPath.GetAttr(names.AttrType),
 					string(actionType),
 				))
+			} else if ao.IsKnown() {
+				authenticateOIDCPlantimeValidate(actionPath.GetAttr("authenticate_oidc").IndexInt(0), ao.Index(cty.NumberIntVal(0)), diags)
 			}
 
 		case awstypes.ActionTypeEnumJwtValidation:
@@ -2031,6 +2072,52 @@ func listenerActionPlantimeValidate(actionPath cty.Path, action cty.Value, diags
 	}
 }
 
+func authenticateOIDCPlantimeValidate(authenticateOIDCPath cty.Path, authenticateOIDC cty.Value, diags *diag.Diagnostics) {
+	if !authenticateOIDC.IsKnown() || authenticateOIDC.IsNull() {
+		return
+	}
+
+	clientSecret := authenticateOIDC.GetAttr(names.AttrClientSecret)
+	clientSecretWO := authenticateOIDC.GetAttr("client_secret_wo")
+	clientSecretWOVersion := authenticateOIDC.GetAttr("client_secret_wo_version")
+	if !clientSecret.IsKnown() || !clientSecretWO.IsKnown() || !clientSecretWOVersion.IsKnown() {
+		return
+	}
+
+	clientSecretPath := authenticateOIDCPath.GetAttr(names.AttrClientSecret)
+	clientSecretWOPath := authenticateOIDCPath.GetAttr("client_secret_wo")
+	clientSecretWOVersionPath := authenticateOIDCPath.GetAttr("client_secret_wo_version")
+
+	switch {
+	case clientSecret.IsNull() && clientSecretWO.IsNull():
+		*diags = append(*diags, errs.NewAttributeErrorDiagnostic(authenticateOIDCPath,
+			"Missing Attribute Configuration",
+			fmt.Sprintf("Exactly one of %q or %q must be specified.",
+				errs.PathString(clientSecretPath),
+				errs.PathString(clientSecretWOPath),
+			),
+		))
+	case !clientSecret.IsNull() && !clientSecretWO.IsNull():
+		*diags = append(*diags, errs.NewAttributeErrorDiagnostic(authenticateOIDCPath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Only one of %q or %q can be specified.",
+				errs.PathString(clientSecretPath),
+				errs.PathString(clientSecretWOPath),
+			),
+		))
+	}
+
+	if clientSecretWO.IsNull() != clientSecretWOVersion.IsNull() {
+		*diags = append(*diags, errs.NewAttributeErrorDiagnostic(authenticateOIDCPath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%q and %q must be specified together.",
+				errs.PathString(clientSecretWOPath),
+				errs.PathString(clientSecretWOVersionPath),
+			),
+		))
+	}
+}
+
 func listenerActionRuntimeValidate(actionPath cty.Path, action map[string]any, diags *diag.Diagnostics) {
 	actionType := awstypes.ActionTypeEnum(action[names.AttrType].(string))
 
@@ -143,9 +143,19 @@ func resourceListenerRule() *schema.Resource {
 										},
 										names.AttrClientSecret: {
 											Type:      schema.TypeString,
-											Required:  true,
+											Optional:  true,
 											Sensitive: true,
 										},
+										"client_secret_wo": {
+											Type:      schema.TypeString,
+											Optional:  true,
+											WriteOnly: true,
+											Sensitive: true,
+										},
+										"client_secret_wo_version": {
+											Type:     schema.TypeInt,
+											Optional: true,
+										},
 										names.AttrIssuer: {
 											Type:     schema.TypeString,
 											Required: true,
@@ -632,6 +642,7 @@ func resourceListenerRuleCreate(ctx context.Context, d *schema.ResourceData, met
 	}
 
 	input.Actions = expandListenerActions(cty.GetAttrPath(names.AttrAction), d.Get(names.AttrAction).([]any), &diags)
+	expandListenerActionsWriteOnly(d, cty.GetAttrPath(names.AttrAction), input.Actions, &diags)
 	if diags.HasError() {
 		return diags
 	}
@@ -809,6 +820,7 @@ func resourceListenerRuleUpdate(ctx context.Context, d *schema.ResourceData, met
 
 		if d.HasChange(names.AttrAction) {
 			input.Actions = expandListenerActions(cty.GetAttrPath(names.AttrAction), d.Get(names.AttrAction).([]any), &diags)
+			expandListenerActionsWriteOnly(d, cty.GetAttrPath(names.AttrAction), input.Actions, &diags)
 			if diags.HasError() {
 				return diags
 			}
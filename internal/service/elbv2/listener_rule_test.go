@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -1285,6 +1286,45 @@ func TestAccELBV2ListenerRule_oidc(t *testing.T) {
 	})
 }
 
+func TestAccELBV2ListenerRule_oidcClientSecretWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Rule
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener_rule.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckListenerRuleDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerRuleConfig_oidcClientSecretWriteOnly(rName, key, certificate, "7Fjfp0ZBr1KtDRbnfVdmIw", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, t, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "action.0.authenticate_oidc.0.client_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "action.0.authenticate_oidc.0.client_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.authenticate_oidc.0.client_secret_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccListenerRuleConfig_oidcClientSecretWriteOnly(rName, key, certificate, "8Gkgq1ACs2LuESconWemJx", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerRuleExists(ctx, t, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "action.0.authenticate_oidc.0.client_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "action.0.authenticate_oidc.0.client_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "action.0.authenticate_oidc.0.client_secret_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2ListenerRule_jwtValidation(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.Rule
@@ -5341,3 +5381,68 @@ resource "aws_lb_listener_rule" "test" {
 }
 `, rName, ipAddressType))
 }
+
+func testAccListenerRuleConfig_oidcClientSecretWriteOnly(rName, key, certificate, clientSecret string, clientSecretVersion int) string {
+	return acctest.ConfigCompose(testAccListenerRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_lb_listener_rule" "test" {
+  listener_arn = aws_lb_listener.test.arn
+  priority     = 100
+
+  action {
+    type = "authenticate-oidc"
+
+    authenticate_oidc {
+      authorization_endpoint = "https://example.com/authorization_endpoint"
+      client_id              = "s6BhdRkqt3"
+      client_secret_wo         = %[4]q
+      client_secret_wo_version = %[5]d
+      issuer                 = "https://example.com"
+      token_endpoint         = "https://example.com/token_endpoint"
+      user_info_endpoint     = "https://example.com/user_info_endpoint"
+
+      authentication_request_extra_params = {
+        param = "test"
+      }
+    }
+  }
+
+  action {
+    type             = "forward"
+    target_group_arn = aws_lb_target_group.test.arn
+  }
+
+  condition {
+    path_pattern {
+      values = ["/static/*"]
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_server_certificate" "test" {
+  name             = %[1]q
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = aws_lb.test.arn
+  protocol          = "HTTPS"
+  port              = "443"
+  ssl_policy        = "ELBSecurityPolicy-2016-08"
+  certificate_arn   = aws_iam_server_certificate.test.arn
+
+  default_action {
+    target_group_arn = aws_lb_target_group.test.arn
+    type             = "forward"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key), clientSecret, clientSecretVersion))
+}
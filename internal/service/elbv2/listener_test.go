@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -2022,6 +2023,45 @@ func TestAccELBV2Listener_oidc(t *testing.T) {
 	})
 }
 
+func TestAccELBV2Listener_oidcClientSecretWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.Listener
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_lb_listener.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckListenerDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccListenerConfig_oidcClientSecretWriteOnly(rName, key, certificate, "7Fjfp0ZBr1KtDRbnfVdmIw", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerExists(ctx, t, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.authenticate_oidc.0.client_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "default_action.0.authenticate_oidc.0.client_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.authenticate_oidc.0.client_secret_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccListenerConfig_oidcClientSecretWriteOnly(rName, key, certificate, "8Gkgq1ACs2LuESconWemJx", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckListenerExists(ctx, t, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.authenticate_oidc.0.client_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "default_action.0.authenticate_oidc.0.client_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "default_action.0.authenticate_oidc.0.client_secret_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccELBV2Listener_jwtValidation(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.Listener
@@ -5099,3 +5139,86 @@ resource "aws_lb_target_group" "test" {
 }
 `, rName))
 }
+
+func testAccListenerConfig_oidcClientSecretWriteOnly(rName, key, certificate, clientSecret string, clientSecretVersion int) string {
+	return acctest.ConfigCompose(testAccListenerConfig_base(rName), fmt.Sprintf(`
+resource "aws_lb" "test" {
+  name                       = %[1]q
+  internal                   = false
+  security_groups            = [aws_security_group.test.id]
+  subnets                    = aws_subnet.test[*].id
+  enable_deletion_protection = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_lb_target_group" "test" {
+  name     = %[1]q
+  port     = 8080
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+
+  health_check {
+    path                = "/health"
+    interval            = 60
+    port                = 8081
+    protocol            = "HTTP"
+    timeout             = 3
+    healthy_threshold   = 3
+    unhealthy_threshold = 3
+    matcher             = "200-299"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_iam_server_certificate" "test" {
+  name             = %[1]q
+  certificate_body = "%[2]s"
+  private_key      = "%[3]s"
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = aws_lb.test.arn
+  protocol          = "HTTPS"
+  port              = "443"
+  ssl_policy        = "ELBSecurityPolicy-2016-08"
+  certificate_arn   = aws_iam_server_certificate.test.arn
+
+  default_action {
+    type = "authenticate-oidc"
+
+    authenticate_oidc {
+      authorization_endpoint = "https://example.com/authorization_endpoint"
+      client_id              = "s6BhdRkqt3"
+      client_secret_wo         = %[4]q
+      client_secret_wo_version = %[5]d
+      issuer                 = "https://example.com"
+      token_endpoint         = "https://example.com/token_endpoint"
+      user_info_endpoint     = "https://example.com/user_info_endpoint"
+
+      authentication_request_extra_params = {
+        param = "test"
+      }
+    }
+  }
+
+  default_action {
+    target_group_arn = aws_lb_target_group.test.arn
+    type             = "forward"
+  }
+}
+`, rName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key), clientSecret, clientSecretVersion))
+}
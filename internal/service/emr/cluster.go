@@ -22,6 +22,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/emr/types"
 	smithyjson "github.com/aws/smithy-go/encoding/json"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -504,10 +505,25 @@ func resourceCluster() *schema.Resource {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"ad_domain_join_password": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
-								ForceNew:  true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ForceNew:      true,
+								ConflictsWith: []string{"kerberos_attributes.0.ad_domain_join_password_wo"},
+							},
+							"ad_domain_join_password_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"kerberos_attributes.0.ad_domain_join_password"},
+								RequiredWith:  []string{"kerberos_attributes.0.ad_domain_join_password_wo_version"},
+							},
+							"ad_domain_join_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								RequiredWith: []string{"kerberos_attributes.0.ad_domain_join_password_wo"},
 							},
 							"ad_domain_join_user": {
 								Type:     schema.TypeString,
@@ -515,16 +531,46 @@ func resourceCluster() *schema.Resource {
 								ForceNew: true,
 							},
 							"cross_realm_trust_principal_password": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
-								ForceNew:  true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ForceNew:      true,
+								ConflictsWith: []string{"kerberos_attributes.0.cross_realm_trust_principal_password_wo"},
+							},
+							"cross_realm_trust_principal_password_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"kerberos_attributes.0.cross_realm_trust_principal_password"},
+								RequiredWith:  []string{"kerberos_attributes.0.cross_realm_trust_principal_password_wo_version"},
+							},
+							"cross_realm_trust_principal_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								RequiredWith: []string{"kerberos_attributes.0.cross_realm_trust_principal_password_wo"},
 							},
 							"kdc_admin_password": {
-								Type:      schema.TypeString,
-								Required:  true,
-								Sensitive: true,
-								ForceNew:  true,
+								Type:         schema.TypeString,
+								Optional:     true,
+								Sensitive:    true,
+								ForceNew:     true,
+								ExactlyOneOf: []string{"kerberos_attributes.0.kdc_admin_password", "kerberos_attributes.0.kdc_admin_password_wo"},
+							},
+							"kdc_admin_password_wo": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								WriteOnly:    true,
+								Sensitive:    true,
+								ExactlyOneOf: []string{"kerberos_attributes.0.kdc_admin_password", "kerberos_attributes.0.kdc_admin_password_wo"},
+								RequiredWith: []string{"kerberos_attributes.0.kdc_admin_password_wo_version"},
+							},
+							"kdc_admin_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								RequiredWith: []string{"kerberos_attributes.0.kdc_admin_password_wo"},
 							},
 							"realm": {
 								Type:     schema.TypeString,
@@ -985,6 +1031,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta any
 
 	if v, ok := d.GetOk("kerberos_attributes"); ok {
 		input.KerberosAttributes = expandKerberosAttributes(v.([]any)[0].(map[string]any))
+
+		diags = append(diags, expandKerberosAttributesWriteOnly(d, input.KerberosAttributes)...)
+		if diags.HasError() {
+			return diags
+		}
 	}
 
 	if v, ok := d.GetOk("log_encryption_kms_key_id"); ok {
@@ -1795,10 +1846,14 @@ func flattenKerberosAttributes(d *schema.ResourceData, apiObject *awstypes.Kerbe
 	// * ad_domain_join_user
 	// * cross_realm_trust_principal_password
 	// * kdc_admin_password
+	// or from the write-only password versions.
 
 	tfMap := map[string]any{
-		"kdc_admin_password": d.Get("kerberos_attributes.0.kdc_admin_password").(string),
-		"realm":              aws.ToString(apiObject.Realm),
+		"ad_domain_join_password_wo_version":              d.Get("kerberos_attributes.0.ad_domain_join_password_wo_version").(int),
+		"cross_realm_trust_principal_password_wo_version": d.Get("kerberos_attributes.0.cross_realm_trust_principal_password_wo_version").(int),
+		"kdc_admin_password":                              d.Get("kerberos_attributes.0.kdc_admin_password").(string),
+		"kdc_admin_password_wo_version":                   d.Get("kerberos_attributes.0.kdc_admin_password_wo_version").(int),
+		"realm":                                           aws.ToString(apiObject.Realm),
 	}
 
 	if v, ok := d.GetOk("kerberos_attributes.0.ad_domain_join_password"); ok {
@@ -1973,10 +2028,12 @@ func expandKeyValues(tfMap map[string]any) []awstypes.KeyValue {
 
 func expandKerberosAttributes(tfMap map[string]any) *awstypes.KerberosAttributes {
 	apiObject := &awstypes.KerberosAttributes{
-		KdcAdminPassword: aws.String(tfMap["kdc_admin_password"].(string)),
-		Realm:            aws.String(tfMap["realm"].(string)),
+		Realm: aws.String(tfMap["realm"].(string)),
 	}
 
+	if v, ok := tfMap["kdc_admin_password"]; ok && v.(string) != "" {
+		apiObject.KdcAdminPassword = aws.String(v.(string))
+	}
 	if v, ok := tfMap["ad_domain_join_password"]; ok && v.(string) != "" {
 		apiObject.ADDomainJoinPassword = aws.String(v.(string))
 	}
@@ -1990,6 +2047,41 @@ func expandKerberosAttributes(tfMap map[string]any) *awstypes.KerberosAttributes
 	return apiObject
 }
 
+// expandKerberosAttributesWriteOnly sets the passwords configured with write-only arguments.
+func expandKerberosAttributesWriteOnly(d *schema.ResourceData, apiObject *awstypes.KerberosAttributes) diag.Diagnostics {
+	var diags diag.Diagnostics
+	path := cty.GetAttrPath("kerberos_attributes").IndexInt(0)
+
+	kdcAdminPasswordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("kdc_admin_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if kdcAdminPasswordWO != "" {
+		apiObject.KdcAdminPassword = aws.String(kdcAdminPasswordWO)
+	}
+
+	adDomainJoinPasswordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("ad_domain_join_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if adDomainJoinPasswordWO != "" {
+		apiObject.ADDomainJoinPassword = aws.String(adDomainJoinPasswordWO)
+	}
+
+	crossRealmTrustPrincipalPasswordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("cross_realm_trust_principal_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+	if crossRealmTrustPrincipalPasswordWO != "" {
+		apiObject.CrossRealmTrustPrincipalPassword = aws.String(crossRealmTrustPrincipalPasswordWO)
+	}
+
+	return diags
+}
+
 func expandStepConfig(tfMap map[string]any) awstypes.StepConfig {
 	apiObject := awstypes.StepConfig{
 		ActionOnFailure: awstypes.ActionOnFailure(tfMap["action_on_failure"].(string)),
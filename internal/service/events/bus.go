@@ -27,6 +27,7 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_event_bus", name="Bus")
+// @ArnFormat("event-bus/{name}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @IdentityAttribute("name")
 // @Testing(idAttrDuplicates="name")
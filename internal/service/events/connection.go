@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -129,11 +131,28 @@ func resourceConnection() *schema.Resource {
 										},
 										names.AttrValue: {
 											Type:      schema.TypeString,
-											Required:  true,
+											Optional:  true,
 											Sensitive: true,
 											ValidateFunc: validation.All(
 												validation.StringLenBetween(1, 512),
 											),
+											ExactlyOneOf: []string{"auth_parameters.0.api_key.0.value", "auth_parameters.0.api_key.0.value_wo"},
+										},
+										"value_wo": {
+											Type:      schema.TypeString,
+											Optional:  true,
+											WriteOnly: true,
+											Sensitive: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 512),
+											),
+											ExactlyOneOf: []string{"auth_parameters.0.api_key.0.value", "auth_parameters.0.api_key.0.value_wo"},
+											RequiredWith: []string{"auth_parameters.0.api_key.0.value_wo_version"},
+										},
+										"value_wo_version": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											RequiredWith: []string{"auth_parameters.0.api_key.0.value_wo"},
 										},
 									},
 								},
@@ -151,11 +170,28 @@ func resourceConnection() *schema.Resource {
 									Schema: map[string]*schema.Schema{
 										names.AttrPassword: {
 											Type:      schema.TypeString,
-											Required:  true,
+											Optional:  true,
 											Sensitive: true,
 											ValidateFunc: validation.All(
 												validation.StringLenBetween(1, 512),
 											),
+											ExactlyOneOf: []string{"auth_parameters.0.basic.0.password", "auth_parameters.0.basic.0.password_wo"},
+										},
+										"password_wo": {
+											Type:      schema.TypeString,
+											Optional:  true,
+											WriteOnly: true,
+											Sensitive: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 512),
+											),
+											ExactlyOneOf: []string{"auth_parameters.0.basic.0.password", "auth_parameters.0.basic.0.password_wo"},
+											RequiredWith: []string{"auth_parameters.0.basic.0.password_wo_version"},
+										},
+										"password_wo_version": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											RequiredWith: []string{"auth_parameters.0.basic.0.password_wo"},
 										},
 										names.AttrUsername: {
 											Type:     schema.TypeString,
@@ -233,11 +269,28 @@ func resourceConnection() *schema.Resource {
 													},
 													names.AttrClientSecret: {
 														Type:      schema.TypeString,
-														Required:  true,
+														Optional:  true,
+														Sensitive: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 512),
+														),
+														ExactlyOneOf: []string{"auth_parameters.0.oauth.0.client_parameters.0.client_secret", "auth_parameters.0.oauth.0.client_parameters.0.client_secret_wo"},
+													},
+													"client_secret_wo": {
+														Type:      schema.TypeString,
+														Optional:  true,
+														WriteOnly: true,
 														Sensitive: true,
 														ValidateFunc: validation.All(
 															validation.StringLenBetween(1, 512),
 														),
+														ExactlyOneOf: []string{"auth_parameters.0.oauth.0.client_parameters.0.client_secret", "auth_parameters.0.oauth.0.client_parameters.0.client_secret_wo"},
+														RequiredWith: []string{"auth_parameters.0.oauth.0.client_parameters.0.client_secret_wo_version"},
+													},
+													"client_secret_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"auth_parameters.0.oauth.0.client_parameters.0.client_secret_wo"},
 													},
 												},
 											},
@@ -345,6 +398,11 @@ func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.KmsKeyIdentifier = aws.String(v.(string))
 	}
 
+	diags = append(diags, expandCreateConnectionAuthRequestParametersWriteOnly(d, input.AuthParameters)...)
+	if diags.HasError() {
+		return diags
+	}
+
 	_, err := conn.CreateConnection(ctx, &input)
 
 	if err != nil {
@@ -426,6 +484,11 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta
 		input.KmsKeyIdentifier = aws.String(v.(string))
 	}
 
+	diags = append(diags, expandUpdateConnectionAuthRequestParametersWriteOnly(d, input.AuthParameters)...)
+	if diags.HasError() {
+		return diags
+	}
+
 	_, err := conn.UpdateConnection(ctx, &input)
 
 	if err != nil {
@@ -603,6 +666,86 @@ func expandCreateConnectionAuthRequestParameters(tfList []any) *types.CreateConn
 	return apiObject
 }
 
+// expandCreateConnectionAuthRequestParametersWriteOnly sets write-only values from configuration on the expanded auth parameters.
+func expandCreateConnectionAuthRequestParametersWriteOnly(d *schema.ResourceData, apiObject *types.CreateConnectionAuthRequestParameters) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return diags
+	}
+
+	path := cty.GetAttrPath("auth_parameters").IndexInt(0)
+
+	if apiObject.ApiKeyAuthParameters != nil {
+		valueWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("api_key").IndexInt(0).GetAttr("value_wo"))
+		diags = append(diags, di...)
+
+		if valueWO != "" {
+			apiObject.ApiKeyAuthParameters.ApiKeyValue = aws.String(valueWO)
+		}
+	}
+
+	if apiObject.BasicAuthParameters != nil {
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("basic").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, di...)
+
+		if passwordWO != "" {
+			apiObject.BasicAuthParameters.Password = aws.String(passwordWO)
+		}
+	}
+
+	if apiObject.OAuthParameters != nil && apiObject.OAuthParameters.ClientParameters != nil {
+		clientSecretWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("oauth").IndexInt(0).GetAttr("client_parameters").IndexInt(0).GetAttr("client_secret_wo"))
+		diags = append(diags, di...)
+
+		if clientSecretWO != "" {
+			apiObject.OAuthParameters.ClientParameters.ClientSecret = aws.String(clientSecretWO)
+		}
+	}
+
+	return diags
+}
+
+// expandUpdateConnectionAuthRequestParametersWriteOnly sets write-only values from configuration on the expanded auth parameters.
+func expandUpdateConnectionAuthRequestParametersWriteOnly(d *schema.ResourceData, apiObject *types.UpdateConnectionAuthRequestParameters) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return diags
+	}
+
+	path := cty.GetAttrPath("auth_parameters").IndexInt(0)
+
+	if apiObject.ApiKeyAuthParameters != nil {
+		valueWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("api_key").IndexInt(0).GetAttr("value_wo"))
+		diags = append(diags, di...)
+
+		if valueWO != "" {
+			apiObject.ApiKeyAuthParameters.ApiKeyValue = aws.String(valueWO)
+		}
+	}
+
+	if apiObject.BasicAuthParameters != nil {
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("basic").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, di...)
+
+		if passwordWO != "" {
+			apiObject.BasicAuthParameters.Password = aws.String(passwordWO)
+		}
+	}
+
+	if apiObject.OAuthParameters != nil && apiObject.OAuthParameters.ClientParameters != nil {
+		clientSecretWO, di := flex.GetWriteOnlyStringValue(d, path.GetAttr("oauth").IndexInt(0).GetAttr("client_parameters").IndexInt(0).GetAttr("client_secret_wo"))
+		diags = append(diags, di...)
+
+		if clientSecretWO != "" {
+			apiObject.OAuthParameters.ClientParameters.ClientSecret = aws.String(clientSecretWO)
+		}
+	}
+
+	return diags
+}
+
 func expandCreateConnectionAPIKeyAuthRequestParameters(tfList []any) *types.CreateConnectionApiKeyAuthRequestParameters {
 	if len(tfList) == 0 {
 		return nil
@@ -848,6 +991,10 @@ func flattenConnectionAPIKeyAuthParameters(apiObject *types.ConnectionApiKeyAuth
 		tfMap[names.AttrValue] = v.(string)
 	}
 
+	if v, ok := d.GetOk("auth_parameters.0.api_key.0.value_wo_version"); ok {
+		tfMap["value_wo_version"] = v.(int)
+	}
+
 	return []map[string]any{tfMap}
 }
 
@@ -865,6 +1012,10 @@ func flattenConnectionBasicAuthParameters(apiObject *types.ConnectionBasicAuthRe
 		tfMap[names.AttrPassword] = v.(string)
 	}
 
+	if v, ok := d.GetOk("auth_parameters.0.basic.0.password_wo_version"); ok {
+		tfMap["password_wo_version"] = v.(int)
+	}
+
 	return []map[string]any{tfMap}
 }
 
@@ -898,6 +1049,10 @@ func flattenConnectionOAuthClientResponseParameters(apiObject *types.ConnectionO
 		tfMap[names.AttrClientSecret] = v.(string)
 	}
 
+	if v, ok := d.GetOk("auth_parameters.0.oauth.0.client_parameters.0.client_secret_wo_version"); ok {
+		tfMap["client_secret_wo_version"] = v.(int)
+	}
+
 	return []map[string]any{tfMap}
 }
 
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -280,6 +281,49 @@ func TestAccEventsConnection_basicAuth(t *testing.T) {
 	})
 }
 
+func TestAccEventsConnection_basicAuthPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 eventbridge.DescribeConnectionOutput
+	name := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	username := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	password := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	passwordModified := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudwatch_event_connection.basic"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckConnectionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionConfig_basicAuthPasswordWriteOnly(name, username, password, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectionExists(ctx, t, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "auth_parameters.0.basic.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.username", username),
+				),
+			},
+			{
+				Config: testAccConnectionConfig_basicAuthPasswordWriteOnly(name, username, passwordModified, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConnectionExists(ctx, t, resourceName, &v2),
+					testAccCheckConnectionNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "auth_parameters.0.basic.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "auth_parameters.0.basic.0.password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEventsConnection_oAuth(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 eventbridge.DescribeConnectionOutput
@@ -1435,3 +1479,19 @@ resource "aws_cloudwatch_event_connection" "test" {
 }
 `, name, kmsKeyIdentifier)
 }
+
+func testAccConnectionConfig_basicAuthPasswordWriteOnly(name, username, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_connection" "basic" {
+  name               = %[1]q
+  authorization_type = "BASIC"
+  auth_parameters {
+    basic {
+      username            = %[2]q
+      password_wo         = %[3]q
+      password_wo_version = %[4]d
+    }
+  }
+}
+`, name, username, password, passwordVersion)
+}
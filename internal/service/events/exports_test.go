@@ -15,22 +15,21 @@ var (
 	ResourceRule           = resourceRule
 	ResourceTarget         = resourceTarget
 
-	FindAPIDestinationByName    = findAPIDestinationByName
-	FindArchiveByName           = findArchiveByName
-	FindConnectionByName        = findConnectionByName
-	FindEndpointByName          = findEndpointByName
-	FindEventBusByName          = findEventBusByName
-	FindEventBusPolicyByName    = findEventBusPolicyByName
-	FindPermissionByTwoPartKey  = findPermissionByTwoPartKey
-	FindRuleByTwoPartKey        = findRuleByTwoPartKey
-	FindTargetByThreePartKey    = findTargetByThreePartKey
-	RuleEventPatternJSONDecoder = ruleEventPatternJSONDecoder
-	RuleCreateResourceID        = ruleCreateResourceID
-	RuleParseResourceID         = ruleParseResourceID
-	EventBusARNPattern          = eventBusARNPattern
-	PartnerEventBusPattern      = partnerEventBusPattern
-	TargetParseImportID         = targetParseImportID
-	TargetStateUpgradeV0        = targetStateUpgradeV0
+	FindAPIDestinationByName   = findAPIDestinationByName
+	FindArchiveByName          = findArchiveByName
+	FindConnectionByName       = findConnectionByName
+	FindEndpointByName         = findEndpointByName
+	FindEventBusByName         = findEventBusByName
+	FindEventBusPolicyByName   = findEventBusPolicyByName
+	FindPermissionByTwoPartKey = findPermissionByTwoPartKey
+	FindRuleByTwoPartKey       = findRuleByTwoPartKey
+	FindTargetByThreePartKey   = findTargetByThreePartKey
+	RuleCreateResourceID       = ruleCreateResourceID
+	RuleParseResourceID        = ruleParseResourceID
+	EventBusARNPattern         = eventBusARNPattern
+	PartnerEventBusPattern     = partnerEventBusPattern
+	TargetParseImportID        = targetParseImportID
+	TargetStateUpgradeV0       = targetStateUpgradeV0
 
 	ValidSourceName         = validSourceName
 	ValidCustomEventBusName = validCustomEventBusName
@@ -6,9 +6,7 @@
 package events
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -26,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfeventpattern "github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -79,7 +78,7 @@ func resourceRule() *schema.Resource {
 					ValidateFunc: validateEventPatternValue(),
 					AtLeastOneOf: []string{names.AttrScheduleExpression, "event_pattern"},
 					StateFunc: func(v any) string {
-						json, _ := ruleEventPatternJSONDecoder(v.(string))
+						json, _ := tfeventpattern.Normalize(v.(string))
 						return json
 					},
 				},
@@ -377,34 +376,6 @@ func ruleParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected EVENTBUSNAME%[2]sRULENAME or RULENAME", id, ruleResourceIDSeparator)
 }
 
-// ruleEventPatternJSONDecoder decodes unicode translation of <,>,&
-func ruleEventPatternJSONDecoder(jsonString any) (string, error) {
-	var j any
-
-	if jsonString == nil || jsonString.(string) == "" {
-		return "", nil
-	}
-
-	s := jsonString.(string)
-
-	err := json.Unmarshal([]byte(s), &j)
-	if err != nil {
-		return s, err
-	}
-
-	b, err := json.Marshal(j)
-	if err != nil {
-		return "", err
-	}
-
-	if bytes.Contains(b, []byte("\\u003c")) || bytes.Contains(b, []byte("\\u003e")) || bytes.Contains(b, []byte("\\u0026")) {
-		b = bytes.Replace(b, []byte("\\u003c"), []byte("<"), -1)
-		b = bytes.Replace(b, []byte("\\u003e"), []byte(">"), -1)
-		b = bytes.Replace(b, []byte("\\u0026"), []byte("&"), -1)
-	}
-	return string(b[:]), nil
-}
-
 func expandPutRuleInput(d *schema.ResourceData, name string) *eventbridge.PutRuleInput {
 	apiObject := &eventbridge.PutRuleInput{
 		Name: aws.String(name),
@@ -419,7 +390,7 @@ func expandPutRuleInput(d *schema.ResourceData, name string) *eventbridge.PutRul
 	}
 
 	if v, ok := d.GetOk("event_pattern"); ok {
-		json, _ := ruleEventPatternJSONDecoder(v.(string))
+		json, _ := tfeventpattern.Normalize(v.(string))
 		apiObject.EventPattern = aws.String(json)
 	}
 
@@ -451,7 +422,7 @@ func expandPutRuleInput(d *schema.ResourceData, name string) *eventbridge.PutRul
 
 func validateEventPatternValue() schema.SchemaValidateFunc {
 	return func(v any, k string) (ws []string, errors []error) {
-		json, err := ruleEventPatternJSONDecoder(v.(string))
+		json, err := tfeventpattern.Normalize(v.(string))
 		if err != nil {
 			errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %w", k, err))
 
@@ -478,7 +449,7 @@ func resourceRuleFlatten(_ context.Context, eventBusName string, d *schema.Resou
 	d.Set(names.AttrDescription, output.Description)
 	d.Set("event_bus_name", eventBusName) // Use event bus name from resource ID as API response may collapse any ARN.
 	if output.EventPattern != nil {
-		pattern, err := ruleEventPatternJSONDecoder(aws.ToString(output.EventPattern))
+		pattern, err := tfeventpattern.Normalize(aws.ToString(output.EventPattern))
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfeventpattern "github.com/hashicorp/terraform-provider-aws/internal/eventpattern"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -36,7 +37,7 @@ func resourceRuleV0() *schema.Resource {
 					Type:     schema.TypeString,
 					Optional: true,
 					StateFunc: func(v any) string {
-						json, _ := ruleEventPatternJSONDecoder(v.(string))
+						json, _ := tfeventpattern.Normalize(v.(string))
 						return json
 					},
 				},
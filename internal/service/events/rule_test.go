@@ -13,7 +13,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
-	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -165,40 +164,6 @@ func TestRuleParseResourceID(t *testing.T) {
 	}
 }
 
-func TestRuleEventPatternJSONDecoder(t *testing.T) {
-	t.Parallel()
-
-	type testCase struct {
-		input    string
-		expected string
-	}
-	tests := map[string]testCase{
-		"lessThanGreaterThan": {
-			input:    `{"detail":{"count":[{"numeric":["\u003e",0,"\u003c",5]}]}}`,
-			expected: `{"detail":{"count":[{"numeric":[">",0,"<",5]}]}}`,
-		},
-		"ampersand": {
-			input:    `{"detail":{"count":[{"numeric":["\u0026",0,"\u0026",5]}]}}`,
-			expected: `{"detail":{"count":[{"numeric":["&",0,"&",5]}]}}`,
-		},
-	}
-
-	for name, test := range tests {
-		t.Run(name, func(t *testing.T) {
-			t.Parallel()
-
-			got, err := tfevents.RuleEventPatternJSONDecoder(test.input)
-			if err != nil {
-				t.Fatal(err)
-			}
-
-			if diff := cmp.Diff(got, test.expected); diff != "" {
-				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
-			}
-		})
-	}
-}
-
 func TestAccEventsRule_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v1, v2, v3 eventbridge.DescribeRuleOutput
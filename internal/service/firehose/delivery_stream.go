@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -816,10 +817,25 @@ func resourceDeliveryStream() *schema.Resource {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							names.AttrAccessKey: {
-								Type:         schema.TypeString,
+								Type:          schema.TypeString,
+								Optional:      true,
+								ValidateFunc:  validation.StringLenBetween(0, 4096),
+								Sensitive:     true,
+								ConflictsWith: []string{"http_endpoint_configuration.0.access_key_wo"},
+							},
+							"access_key_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ValidateFunc:  validation.StringLenBetween(0, 4096),
+								ConflictsWith: []string{"http_endpoint_configuration.0.access_key"},
+								RequiredWith:  []string{"http_endpoint_configuration.0.access_key_wo_version"},
+							},
+							"access_key_wo_version": {
+								Type:         schema.TypeInt,
 								Optional:     true,
-								ValidateFunc: validation.StringLenBetween(0, 4096),
-								Sensitive:    true,
+								RequiredWith: []string{"http_endpoint_configuration.0.access_key_wo"},
 							},
 							"buffering_interval": {
 								Type:         schema.TypeInt,
@@ -1224,9 +1240,23 @@ func resourceDeliveryStream() *schema.Resource {
 								Required: true,
 							},
 							names.AttrPassword: {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"redshift_configuration.0.password_wo"},
+							},
+							"password_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"redshift_configuration.0.password"},
+								RequiredWith:  []string{"redshift_configuration.0.password_wo_version"},
+							},
+							"password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"redshift_configuration.0.password_wo"},
 							},
 							"processing_configuration": processingConfigurationSchema(),
 							"retry_duration": {
@@ -1325,10 +1355,25 @@ func resourceDeliveryStream() *schema.Resource {
 								ValidateFunc: validation.StringLenBetween(1, 255),
 							},
 							"key_passphrase": {
-								Type:         schema.TypeString,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ValidateFunc:  validation.StringLenBetween(7, 255),
+								ConflictsWith: []string{"snowflake_configuration.0.key_passphrase_wo"},
+							},
+							"key_passphrase_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ValidateFunc:  validation.StringLenBetween(7, 255),
+								ConflictsWith: []string{"snowflake_configuration.0.key_passphrase"},
+								RequiredWith:  []string{"snowflake_configuration.0.key_passphrase_wo_version"},
+							},
+							"key_passphrase_wo_version": {
+								Type:         schema.TypeInt,
 								Optional:     true,
-								Sensitive:    true,
-								ValidateFunc: validation.StringLenBetween(7, 255),
+								RequiredWith: []string{"snowflake_configuration.0.key_passphrase_wo"},
 							},
 							"metadata_column_name": {
 								Type:         schema.TypeString,
@@ -1336,9 +1381,23 @@ func resourceDeliveryStream() *schema.Resource {
 								ValidateFunc: validation.StringLenBetween(1, 255),
 							},
 							names.AttrPrivateKey: {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"snowflake_configuration.0.private_key_wo"},
+							},
+							"private_key_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"snowflake_configuration.0.private_key"},
+								RequiredWith:  []string{"snowflake_configuration.0.private_key_wo_version"},
+							},
+							"private_key_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"snowflake_configuration.0.private_key_wo"},
 							},
 							"processing_configuration": processingConfigurationSchema(),
 							"retry_duration": {
@@ -1536,6 +1595,17 @@ func resourceDeliveryStreamCreate(ctx context.Context, d *schema.ResourceData, m
 	case destinationTypeHTTPEndpoint:
 		if v, ok := d.GetOk("http_endpoint_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			input.HttpEndpointDestinationConfiguration = expandHTTPEndpointDestinationConfiguration(v.([]any)[0].(map[string]any))
+
+			// get write-only value from configuration
+			accessKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("http_endpoint_configuration").IndexInt(0).GetAttr("access_key_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if accessKeyWO != "" {
+				input.HttpEndpointDestinationConfiguration.EndpointConfiguration.AccessKey = aws.String(accessKeyWO)
+			}
 		}
 	case destinationTypeIceberg:
 		if v, ok := d.GetOk("iceberg_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -1552,10 +1622,43 @@ func resourceDeliveryStreamCreate(ctx context.Context, d *schema.ResourceData, m
 	case destinationTypeRedshift:
 		if v, ok := d.GetOk("redshift_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			input.RedshiftDestinationConfiguration = expandRedshiftDestinationConfiguration(v.([]any)[0].(map[string]any))
+
+			// get write-only value from configuration
+			passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("redshift_configuration").IndexInt(0).GetAttr("password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if passwordWO != "" {
+				input.RedshiftDestinationConfiguration.Password = aws.String(passwordWO)
+			}
 		}
 	case destinationTypeSnowflake:
 		if v, ok := d.GetOk("snowflake_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			input.SnowflakeDestinationConfiguration = expandSnowflakeDestinationConfiguration(v.([]any)[0].(map[string]any))
+
+			// get write-only value from configuration
+			keyPassphraseWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("snowflake_configuration").IndexInt(0).GetAttr("key_passphrase_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if keyPassphraseWO != "" {
+				input.SnowflakeDestinationConfiguration.KeyPassphrase = aws.String(keyPassphraseWO)
+			}
+
+			// get write-only value from configuration
+			privateKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("snowflake_configuration").IndexInt(0).GetAttr("private_key_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if privateKeyWO != "" {
+				input.SnowflakeDestinationConfiguration.PrivateKey = aws.String(privateKeyWO)
+			}
 		}
 	case destinationTypeSplunk:
 		if v, ok := d.GetOk("splunk_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -1663,6 +1766,17 @@ func resourceDeliveryStreamUpdate(ctx context.Context, d *schema.ResourceData, m
 		case destinationTypeHTTPEndpoint:
 			if v, ok := d.GetOk("http_endpoint_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 				input.HttpEndpointDestinationUpdate = expandHTTPEndpointDestinationUpdate(v.([]any)[0].(map[string]any))
+
+				// get write-only value from configuration
+				accessKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("http_endpoint_configuration").IndexInt(0).GetAttr("access_key_wo"))
+				diags = append(diags, di...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if accessKeyWO != "" {
+					input.HttpEndpointDestinationUpdate.EndpointConfiguration.AccessKey = aws.String(accessKeyWO)
+				}
 			}
 		case destinationTypeIceberg:
 			if v, ok := d.GetOk("iceberg_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -1679,10 +1793,43 @@ func resourceDeliveryStreamUpdate(ctx context.Context, d *schema.ResourceData, m
 		case destinationTypeRedshift:
 			if v, ok := d.GetOk("redshift_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 				input.RedshiftDestinationUpdate = expandRedshiftDestinationUpdate(v.([]any)[0].(map[string]any))
+
+				// get write-only value from configuration
+				passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("redshift_configuration").IndexInt(0).GetAttr("password_wo"))
+				diags = append(diags, di...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if passwordWO != "" {
+					input.RedshiftDestinationUpdate.Password = aws.String(passwordWO)
+				}
 			}
 		case destinationTypeSnowflake:
 			if v, ok := d.GetOk("snowflake_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 				input.SnowflakeDestinationUpdate = expandSnowflakeDestinationUpdate(v.([]any)[0].(map[string]any))
+
+				// get write-only value from configuration
+				keyPassphraseWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("snowflake_configuration").IndexInt(0).GetAttr("key_passphrase_wo"))
+				diags = append(diags, di...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if keyPassphraseWO != "" {
+					input.SnowflakeDestinationUpdate.KeyPassphrase = aws.String(keyPassphraseWO)
+				}
+
+				// get write-only value from configuration
+				privateKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("snowflake_configuration").IndexInt(0).GetAttr("private_key_wo"))
+				diags = append(diags, di...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if privateKeyWO != "" {
+					input.SnowflakeDestinationUpdate.PrivateKey = aws.String(privateKeyWO)
+				}
 			}
 		case destinationTypeSplunk:
 			if v, ok := d.GetOk("splunk_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
@@ -3715,7 +3862,7 @@ func flattenExtendedS3DestinationDescription(apiObject *types.ExtendedS3Destinat
 	return []any{tfMap}
 }
 
-func flattenRedshiftDestinationDescription(apiObject *types.RedshiftDestinationDescription, configuredPassword string) []any {
+func flattenRedshiftDestinationDescription(apiObject *types.RedshiftDestinationDescription, configuredPassword string, configuredPasswordWOVersion int) []any {
 	if apiObject == nil {
 		return []any{}
 	}
@@ -3724,6 +3871,7 @@ func flattenRedshiftDestinationDescription(apiObject *types.RedshiftDestinationD
 		"cloudwatch_logging_options":    flattenCloudWatchLoggingOptions(apiObject.CloudWatchLoggingOptions),
 		"cluster_jdbcurl":               aws.ToString(apiObject.ClusterJDBCURL),
 		names.AttrPassword:              configuredPassword,
+		"password_wo_version":           configuredPasswordWOVersion,
 		"processing_configuration":      flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeRedshift, aws.ToString(apiObject.RoleARN)),
 		names.AttrRoleARN:               aws.ToString(apiObject.RoleARN),
 		"s3_backup_configuration":       flattenS3DestinationDescription(apiObject.S3BackupDescription),
@@ -3746,7 +3894,7 @@ func flattenRedshiftDestinationDescription(apiObject *types.RedshiftDestinationD
 	return []any{tfMap}
 }
 
-func flattenSnowflakeDestinationDescription(apiObject *types.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey string) []any {
+func flattenSnowflakeDestinationDescription(apiObject *types.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey string, configuredKeyPassphraseWOVersion, configuredPrivateKeyWOVersion int) []any {
 	if apiObject == nil {
 		return []any{}
 	}
@@ -3759,8 +3907,10 @@ func flattenSnowflakeDestinationDescription(apiObject *types.SnowflakeDestinatio
 		"data_loading_option":           apiObject.DataLoadingOption,
 		names.AttrDatabase:              aws.ToString(apiObject.Database),
 		"key_passphrase":                configuredKeyPassphrase,
+		"key_passphrase_wo_version":     configuredKeyPassphraseWOVersion,
 		"metadata_column_name":          aws.ToString(apiObject.MetaDataColumnName),
 		names.AttrPrivateKey:            configuredPrivateKey,
+		"private_key_wo_version":        configuredPrivateKeyWOVersion,
 		"processing_configuration":      flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeSnowflake, roleARN),
 		names.AttrRoleARN:               roleARN,
 		"s3_backup_mode":                apiObject.S3BackupMode,
@@ -4185,7 +4335,7 @@ func flattenKinesisStreamSourceDescription(apiObject *types.KinesisStreamSourceD
 	return []any{tfMap}
 }
 
-func flattenHTTPEndpointDestinationDescription(apiObject *types.HttpEndpointDestinationDescription, configuredAccessKey string) []any {
+func flattenHTTPEndpointDestinationDescription(apiObject *types.HttpEndpointDestinationDescription, configuredAccessKey string, configuredAccessKeyWOVersion int) []any {
 	if apiObject == nil {
 		return []any{}
 	}
@@ -4193,6 +4343,7 @@ func flattenHTTPEndpointDestinationDescription(apiObject *types.HttpEndpointDest
 	roleARN := aws.ToString(apiObject.RoleARN)
 	tfMap := map[string]any{
 		names.AttrAccessKey:             configuredAccessKey,
+		"access_key_wo_version":         configuredAccessKeyWOVersion,
 		"cloudwatch_logging_options":    flattenCloudWatchLoggingOptions(apiObject.CloudWatchLoggingOptions),
 		names.AttrName:                  aws.ToString(apiObject.EndpointConfiguration.Name),
 		"processing_configuration":      flattenProcessingConfiguration(apiObject.ProcessingConfiguration, destinationTypeHTTPEndpoint, roleARN),
@@ -4388,7 +4539,8 @@ func resourceDeliveryStreamFlatten(_ context.Context, d *schema.ResourceData, s
 		case destination.HttpEndpointDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeHTTPEndpoint)
 			configuredAccessKey := d.Get("http_endpoint_configuration.0.access_key").(string)
-			if err := d.Set("http_endpoint_configuration", flattenHTTPEndpointDestinationDescription(destination.HttpEndpointDestinationDescription, configuredAccessKey)); err != nil {
+			configuredAccessKeyWOVersion := d.Get("http_endpoint_configuration.0.access_key_wo_version").(int)
+			if err := d.Set("http_endpoint_configuration", flattenHTTPEndpointDestinationDescription(destination.HttpEndpointDestinationDescription, configuredAccessKey, configuredAccessKeyWOVersion)); err != nil {
 				return fmt.Errorf("setting http_endpoint_configuration: %w", err)
 			}
 		case destination.IcebergDestinationDescription != nil:
@@ -4409,14 +4561,17 @@ func resourceDeliveryStreamFlatten(_ context.Context, d *schema.ResourceData, s
 		case destination.RedshiftDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeRedshift)
 			configuredPassword := d.Get("redshift_configuration.0.password").(string)
-			if err := d.Set("redshift_configuration", flattenRedshiftDestinationDescription(destination.RedshiftDestinationDescription, configuredPassword)); err != nil {
+			configuredPasswordWOVersion := d.Get("redshift_configuration.0.password_wo_version").(int)
+			if err := d.Set("redshift_configuration", flattenRedshiftDestinationDescription(destination.RedshiftDestinationDescription, configuredPassword, configuredPasswordWOVersion)); err != nil {
 				return fmt.Errorf("setting redshift_configuration: %w", err)
 			}
 		case destination.SnowflakeDestinationDescription != nil:
 			d.Set(names.AttrDestination, destinationTypeSnowflake)
 			configuredKeyPassphrase := d.Get("snowflake_configuration.0.key_passphrase").(string)
 			configuredPrivateKey := d.Get("snowflake_configuration.0.private_key").(string)
-			if err := d.Set("snowflake_configuration", flattenSnowflakeDestinationDescription(destination.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey)); err != nil {
+			configuredKeyPassphraseWOVersion := d.Get("snowflake_configuration.0.key_passphrase_wo_version").(int)
+			configuredPrivateKeyWOVersion := d.Get("snowflake_configuration.0.private_key_wo_version").(int)
+			if err := d.Set("snowflake_configuration", flattenSnowflakeDestinationDescription(destination.SnowflakeDestinationDescription, configuredKeyPassphrase, configuredPrivateKey, configuredKeyPassphraseWOVersion, configuredPrivateKeyWOVersion)); err != nil {
 				return fmt.Errorf("setting snowflake_configuration: %w", err)
 			}
 		case destination.SplunkDestinationDescription != nil:
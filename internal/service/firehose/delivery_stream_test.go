@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tffirehose "github.com/hashicorp/terraform-provider-aws/internal/service/firehose"
@@ -1273,6 +1274,43 @@ func TestAccFirehoseDeliveryStream_icebergUpgradeV6_7_0(t *testing.T) {
 	})
 }
 
+func TestAccFirehoseDeliveryStream_redshiftPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stream types.DeliveryStreamDescription
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FirehoseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDeliveryStreamDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeliveryStreamConfig_redshiftPasswordWriteOnly(rName, "T3stPass", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, t, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "redshift_configuration.0.password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "redshift_configuration.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "redshift_configuration.0.password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccDeliveryStreamConfig_redshiftPasswordWriteOnly(rName, "T3stPass2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeliveryStreamExists(ctx, t, resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "redshift_configuration.0.password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "redshift_configuration.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "redshift_configuration.0.password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFirehoseDeliveryStream_redshiftUpdates(t *testing.T) {
 	ctx := acctest.Context(t)
 	var stream types.DeliveryStreamDescription
@@ -5846,3 +5884,26 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 }
 `, rName)
 }
+
+func testAccDeliveryStreamConfig_redshiftPasswordWriteOnly(rName, password string, passwordVersion int) string {
+	return acctest.ConfigCompose(testAccDeliveryStreamConfig_baseRedshift(rName), fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  name        = %[1]q
+  destination = "redshift"
+
+  redshift_configuration {
+    role_arn            = aws_iam_role.firehose.arn
+    cluster_jdbcurl     = "jdbc:redshift://${aws_redshift_cluster.test.endpoint}/${aws_redshift_cluster.test.database_name}"
+    username            = "testuser"
+    password_wo         = %[2]q
+    password_wo_version = %[3]d
+    data_table_name     = "test-table"
+
+    s3_configuration {
+      role_arn   = aws_iam_role.firehose.arn
+      bucket_arn = aws_s3_bucket.bucket.arn
+    }
+  }
+}
+`, rName, password, passwordVersion))
+}
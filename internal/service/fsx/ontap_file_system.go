@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/fsx"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -153,10 +154,25 @@ func resourceONTAPFileSystem() *schema.Resource {
 					},
 				},
 				"fsx_admin_password": {
-					Type:         schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(8, 50),
+					ConflictsWith: []string{"fsx_admin_password_wo"},
+				},
+				"fsx_admin_password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(8, 50),
+					ConflictsWith: []string{"fsx_admin_password"},
+					RequiredWith:  []string{"fsx_admin_password_wo_version"},
+				},
+				"fsx_admin_password_wo_version": {
+					Type:         schema.TypeInt,
 					Optional:     true,
-					Sensitive:    true,
-					ValidateFunc: validation.StringLenBetween(8, 50),
+					RequiredWith: []string{"fsx_admin_password_wo"},
 				},
 				"ha_pairs": {
 					Type:         schema.TypeInt,
@@ -329,6 +345,16 @@ func resourceONTAPFileSystemCreate(ctx context.Context, d *schema.ResourceData,
 		input.OntapConfiguration.FsxAdminPassword = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	fsxAdminPasswordWO, getWODiags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("fsx_admin_password_wo"))
+	diags = append(diags, getWODiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if fsxAdminPasswordWO != "" {
+		input.OntapConfiguration.FsxAdminPassword = aws.String(fsxAdminPasswordWO)
+	}
+
 	if v, ok := d.GetOk("ha_pairs"); ok {
 		v := int32(v.(int))
 		input.OntapConfiguration.HAPairs = aws.Int32(v)
@@ -410,6 +436,7 @@ func resourceONTAPFileSystemRead(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "setting endpoints: %s", err)
 	}
 	d.Set("fsx_admin_password", d.Get("fsx_admin_password").(string))
+	d.Set("fsx_admin_password_wo_version", d.Get("fsx_admin_password_wo_version").(int))
 	haPairs := aws.ToInt32(ontapConfig.HAPairs)
 	d.Set("ha_pairs", haPairs)
 	d.Set(names.AttrKMSKeyID, filesystem.KmsKeyId)
@@ -463,6 +490,18 @@ func resourceONTAPFileSystemUpdate(ctx context.Context, d *schema.ResourceData,
 			input.OntapConfiguration.FsxAdminPassword = aws.String(d.Get("fsx_admin_password").(string))
 		}
 
+		if d.HasChange("fsx_admin_password_wo_version") {
+			fsxAdminPasswordWO, getWODiags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("fsx_admin_password_wo"))
+			diags = append(diags, getWODiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if fsxAdminPasswordWO != "" {
+				input.OntapConfiguration.FsxAdminPassword = aws.String(fsxAdminPasswordWO)
+			}
+		}
+
 		if d.HasChange("ha_pairs") {
 			input.OntapConfiguration.HAPairs = aws.Int32(int32(d.Get("ha_pairs").(int)))
 			//for the ONTAP update API the ThroughputCapacityPerHAPair must explicitly be passed when adding ha_pairs even if it hasn't changed.
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/fsx"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -107,8 +108,29 @@ func resourceONTAPStorageVirtualMachine() *schema.Resource {
 										names.AttrPassword: {
 											Type:         schema.TypeString,
 											Sensitive:    true,
-											Required:     true,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 256),
+											ExactlyOneOf: []string{
+												"active_directory_configuration.0.self_managed_active_directory_configuration.0.password",
+												"active_directory_configuration.0.self_managed_active_directory_configuration.0.password_wo",
+											},
+										},
+										"password_wo": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											WriteOnly:    true,
+											Sensitive:    true,
 											ValidateFunc: validation.StringLenBetween(1, 256),
+											ExactlyOneOf: []string{
+												"active_directory_configuration.0.self_managed_active_directory_configuration.0.password",
+												"active_directory_configuration.0.self_managed_active_directory_configuration.0.password_wo",
+											},
+											RequiredWith: []string{"active_directory_configuration.0.self_managed_active_directory_configuration.0.password_wo_version"},
+										},
+										"password_wo_version": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											RequiredWith: []string{"active_directory_configuration.0.self_managed_active_directory_configuration.0.password_wo"},
 										},
 										names.AttrUsername: {
 											Type:         schema.TypeString,
@@ -224,10 +246,25 @@ func resourceONTAPStorageVirtualMachine() *schema.Resource {
 					Computed: true,
 				},
 				"svm_admin_password": {
-					Type:         schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(8, 50),
+					ConflictsWith: []string{"svm_admin_password_wo"},
+				},
+				"svm_admin_password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(8, 50),
+					ConflictsWith: []string{"svm_admin_password"},
+					RequiredWith:  []string{"svm_admin_password_wo_version"},
+				},
+				"svm_admin_password_wo_version": {
+					Type:         schema.TypeInt,
 					Optional:     true,
-					Sensitive:    true,
-					ValidateFunc: validation.StringLenBetween(8, 50),
+					RequiredWith: []string{"svm_admin_password_wo"},
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -252,7 +289,14 @@ func resourceONTAPStorageVirtualMachineCreate(ctx context.Context, d *schema.Res
 	}
 
 	if v, ok := d.GetOk("active_directory_configuration"); ok {
-		input.ActiveDirectoryConfiguration = expandCreateSvmActiveDirectoryConfiguration(v.([]any))
+		// get write-only value from configuration
+		passwordWO, getWODiags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("active_directory_configuration").IndexInt(0).GetAttr("self_managed_active_directory_configuration").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, getWODiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.ActiveDirectoryConfiguration = expandCreateSvmActiveDirectoryConfiguration(v.([]any), passwordWO)
 	}
 
 	if v, ok := d.GetOk("root_volume_security_style"); ok {
@@ -263,6 +307,16 @@ func resourceONTAPStorageVirtualMachineCreate(ctx context.Context, d *schema.Res
 		input.SvmAdminPassword = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	svmAdminPasswordWO, getWODiags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("svm_admin_password_wo"))
+	diags = append(diags, getWODiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if svmAdminPasswordWO != "" {
+		input.SvmAdminPassword = aws.String(svmAdminPasswordWO)
+	}
+
 	output, err := conn.CreateStorageVirtualMachine(ctx, input)
 
 	if err != nil {
@@ -307,6 +361,7 @@ func resourceONTAPStorageVirtualMachineRead(ctx context.Context, d *schema.Resou
 	d.Set("root_volume_security_style", d.Get("root_volume_security_style").(string))
 	d.Set("subtype", storageVirtualMachine.Subtype)
 	d.Set("svm_admin_password", d.Get("svm_admin_password").(string))
+	d.Set("svm_admin_password_wo_version", d.Get("svm_admin_password_wo_version").(int))
 	d.Set("uuid", storageVirtualMachine.UUID)
 
 	// SVM tags aren't set in the Describe response.
@@ -326,13 +381,35 @@ func resourceONTAPStorageVirtualMachineUpdate(ctx context.Context, d *schema.Res
 		}
 
 		if d.HasChange("active_directory_configuration") {
-			input.ActiveDirectoryConfiguration = expandUpdateSvmActiveDirectoryConfiguration(d.Get("active_directory_configuration").([]any))
+			var passwordWO string
+			var getWODiags diag.Diagnostics
+			if d.HasChange("active_directory_configuration.0.self_managed_active_directory_configuration.0.password_wo_version") {
+				passwordWO, getWODiags = flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("active_directory_configuration").IndexInt(0).GetAttr("self_managed_active_directory_configuration").IndexInt(0).GetAttr("password_wo"))
+				diags = append(diags, getWODiags...)
+				if diags.HasError() {
+					return diags
+				}
+			}
+
+			input.ActiveDirectoryConfiguration = expandUpdateSvmActiveDirectoryConfiguration(d.Get("active_directory_configuration").([]any), passwordWO)
 		}
 
 		if d.HasChange("svm_admin_password") {
 			input.SvmAdminPassword = aws.String(d.Get("svm_admin_password").(string))
 		}
 
+		if d.HasChange("svm_admin_password_wo_version") {
+			svmAdminPasswordWO, getWODiags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("svm_admin_password_wo"))
+			diags = append(diags, getWODiags...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if svmAdminPasswordWO != "" {
+				input.SvmAdminPassword = aws.String(svmAdminPasswordWO)
+			}
+		}
+
 		_, err := conn.UpdateStorageVirtualMachine(ctx, input)
 
 		if err != nil {
@@ -499,7 +576,7 @@ func waitStorageVirtualMachineDeleted(ctx context.Context, conn *fsx.Client, id
 	return nil, err
 }
 
-func expandCreateSvmActiveDirectoryConfiguration(cfg []any) *awstypes.CreateSvmActiveDirectoryConfiguration {
+func expandCreateSvmActiveDirectoryConfiguration(cfg []any, passwordWO string) *awstypes.CreateSvmActiveDirectoryConfiguration {
 	if len(cfg) < 1 {
 		return nil
 	}
@@ -513,13 +590,13 @@ func expandCreateSvmActiveDirectoryConfiguration(cfg []any) *awstypes.CreateSvmA
 	}
 
 	if v, ok := conf["self_managed_active_directory_configuration"].([]any); ok {
-		out.SelfManagedActiveDirectoryConfiguration = expandSelfManagedActiveDirectoryConfiguration(v)
+		out.SelfManagedActiveDirectoryConfiguration = expandSelfManagedActiveDirectoryConfiguration(v, passwordWO)
 	}
 
 	return &out
 }
 
-func expandSelfManagedActiveDirectoryConfiguration(cfg []any) *awstypes.SelfManagedActiveDirectoryConfiguration {
+func expandSelfManagedActiveDirectoryConfiguration(cfg []any, passwordWO string) *awstypes.SelfManagedActiveDirectoryConfiguration {
 	if len(cfg) < 1 {
 		return nil
 	}
@@ -548,6 +625,10 @@ func expandSelfManagedActiveDirectoryConfiguration(cfg []any) *awstypes.SelfMana
 		out.Password = aws.String(v)
 	}
 
+	if passwordWO != "" {
+		out.Password = aws.String(passwordWO)
+	}
+
 	if v, ok := conf[names.AttrUsername].(string); ok && len(v) > 0 {
 		out.UserName = aws.String(v)
 	}
@@ -555,7 +636,7 @@ func expandSelfManagedActiveDirectoryConfiguration(cfg []any) *awstypes.SelfMana
 	return &out
 }
 
-func expandUpdateSvmActiveDirectoryConfiguration(cfg []any) *awstypes.UpdateSvmActiveDirectoryConfiguration {
+func expandUpdateSvmActiveDirectoryConfiguration(cfg []any, passwordWO string) *awstypes.UpdateSvmActiveDirectoryConfiguration {
 	if len(cfg) < 1 {
 		return nil
 	}
@@ -569,13 +650,13 @@ func expandUpdateSvmActiveDirectoryConfiguration(cfg []any) *awstypes.UpdateSvmA
 	}
 
 	if v, ok := conf["self_managed_active_directory_configuration"].([]any); ok {
-		out.SelfManagedActiveDirectoryConfiguration = expandSelfManagedActiveDirectoryConfigurationUpdates(v)
+		out.SelfManagedActiveDirectoryConfiguration = expandSelfManagedActiveDirectoryConfigurationUpdates(v, passwordWO)
 	}
 
 	return &out
 }
 
-func expandSelfManagedActiveDirectoryConfigurationUpdates(cfg []any) *awstypes.SelfManagedActiveDirectoryConfigurationUpdates {
+func expandSelfManagedActiveDirectoryConfigurationUpdates(cfg []any, passwordWO string) *awstypes.SelfManagedActiveDirectoryConfigurationUpdates {
 	if len(cfg) < 1 {
 		return nil
 	}
@@ -604,6 +685,10 @@ func expandSelfManagedActiveDirectoryConfigurationUpdates(cfg []any) *awstypes.S
 		out.Password = aws.String(v)
 	}
 
+	if passwordWO != "" {
+		out.Password = aws.String(passwordWO)
+	}
+
 	if v, ok := conf[names.AttrUsername].(string); ok && len(v) > 0 {
 		out.UserName = aws.String(v)
 	}
@@ -663,6 +748,7 @@ func flattenSelfManagedActiveDirectoryAttributes(d *schema.ResourceData, rs *aws
 	if v, ok := d.GetOk("active_directory_configuration.0.self_managed_active_directory_configuration.0.password"); ok {
 		m[names.AttrPassword] = v.(string)
 	}
+	m["password_wo_version"] = d.Get("active_directory_configuration.0.self_managed_active_directory_configuration.0.password_wo_version").(int)
 
 	return []any{m}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tffsx "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
@@ -138,6 +139,44 @@ func TestAccFSxONTAPStorageVirtualMachine_svmAdminPassword(t *testing.T) {
 	})
 }
 
+func TestAccFSxONTAPStorageVirtualMachine_svmAdminPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var storageVirtualMachine1, storageVirtualMachine2 awstypes.StorageVirtualMachine
+	resourceName := "aws_fsx_ontap_storage_virtual_machine.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	pass1 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	pass2 := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.FSxEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.FSxServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckONTAPStorageVirtualMachineDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccONTAPStorageVirtualMachineConfig_svmAdminPasswordWriteOnly(rName, pass1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckONTAPStorageVirtualMachineExists(ctx, t, resourceName, &storageVirtualMachine1),
+					resource.TestCheckNoResourceAttr(resourceName, "svm_admin_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "svm_admin_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccONTAPStorageVirtualMachineConfig_svmAdminPasswordWriteOnly(rName, pass2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckONTAPStorageVirtualMachineExists(ctx, t, resourceName, &storageVirtualMachine2),
+					testAccCheckONTAPStorageVirtualMachineNotRecreated(&storageVirtualMachine1, &storageVirtualMachine2),
+					resource.TestCheckNoResourceAttr(resourceName, "svm_admin_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "svm_admin_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccFSxONTAPStorageVirtualMachine_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var storageVirtualMachine awstypes.StorageVirtualMachine
@@ -463,6 +502,17 @@ resource "aws_fsx_ontap_storage_virtual_machine" "test" {
 `, rName, pass))
 }
 
+func testAccONTAPStorageVirtualMachineConfig_svmAdminPasswordWriteOnly(rName, pass string, passVersion int) string {
+	return acctest.ConfigCompose(testAccONTAPStorageVirtualMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_fsx_ontap_storage_virtual_machine" "test" {
+  file_system_id                = aws_fsx_ontap_file_system.test.id
+  name                          = %[1]q
+  svm_admin_password_wo         = %[2]q
+  svm_admin_password_wo_version = %[3]d
+}
+`, rName, pass, passVersion))
+}
+
 func testAccONTAPStorageVirtualMachineConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccONTAPStorageVirtualMachineConfig_base(rName), fmt.Sprintf(`
 resource "aws_fsx_ontap_storage_virtual_machine" "test" {
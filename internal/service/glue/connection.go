@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -72,9 +73,23 @@ func resourceConnection() *schema.Resource {
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										names.AttrPassword: {
-											Type:      schema.TypeString,
-											Required:  true,
-											Sensitive: true,
+											Type:         schema.TypeString,
+											Optional:     true,
+											Sensitive:    true,
+											ExactlyOneOf: []string{"authentication_configuration.0.basic_authentication_credentials.0.password", "authentication_configuration.0.basic_authentication_credentials.0.password_wo"},
+										},
+										"password_wo": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											WriteOnly:    true,
+											Sensitive:    true,
+											ExactlyOneOf: []string{"authentication_configuration.0.basic_authentication_credentials.0.password", "authentication_configuration.0.basic_authentication_credentials.0.password_wo"},
+											RequiredWith: []string{"authentication_configuration.0.basic_authentication_credentials.0.password_wo_version"},
+										},
+										"password_wo_version": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											RequiredWith: []string{"authentication_configuration.0.basic_authentication_credentials.0.password_wo"},
 										},
 										names.AttrUsername: {
 											Type:     schema.TypeString,
@@ -107,9 +122,23 @@ func resourceConnection() *schema.Resource {
 											Elem: &schema.Resource{
 												Schema: map[string]*schema.Schema{
 													"authorization_code": {
-														Type:      schema.TypeString,
-														Required:  true,
-														Sensitive: true,
+														Type:         schema.TypeString,
+														Optional:     true,
+														Sensitive:    true,
+														ExactlyOneOf: []string{"authentication_configuration.0.oauth2_properties.0.authorization_code_properties.0.authorization_code", "authentication_configuration.0.oauth2_properties.0.authorization_code_properties.0.authorization_code_wo"},
+													},
+													"authorization_code_wo": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														WriteOnly:    true,
+														Sensitive:    true,
+														ExactlyOneOf: []string{"authentication_configuration.0.oauth2_properties.0.authorization_code_properties.0.authorization_code", "authentication_configuration.0.oauth2_properties.0.authorization_code_properties.0.authorization_code_wo"},
+														RequiredWith: []string{"authentication_configuration.0.oauth2_properties.0.authorization_code_properties.0.authorization_code_wo_version"},
+													},
+													"authorization_code_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"authentication_configuration.0.oauth2_properties.0.authorization_code_properties.0.authorization_code_wo"},
 													},
 													"redirect_uri": {
 														Type:     schema.TypeString,
@@ -142,24 +171,80 @@ func resourceConnection() *schema.Resource {
 											Elem: &schema.Resource{
 												Schema: map[string]*schema.Schema{
 													"access_token": {
-														Type:      schema.TypeString,
-														Optional:  true,
-														Sensitive: true,
+														Type:          schema.TypeString,
+														Optional:      true,
+														Sensitive:     true,
+														ConflictsWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.access_token_wo"},
+													},
+													"access_token_wo": {
+														Type:          schema.TypeString,
+														Optional:      true,
+														WriteOnly:     true,
+														Sensitive:     true,
+														ConflictsWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.access_token"},
+														RequiredWith:  []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.access_token_wo_version"},
+													},
+													"access_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.access_token_wo"},
 													},
 													"jwt_token": {
-														Type:      schema.TypeString,
-														Optional:  true,
-														Sensitive: true,
+														Type:          schema.TypeString,
+														Optional:      true,
+														Sensitive:     true,
+														ConflictsWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.jwt_token_wo"},
+													},
+													"jwt_token_wo": {
+														Type:          schema.TypeString,
+														Optional:      true,
+														WriteOnly:     true,
+														Sensitive:     true,
+														ConflictsWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.jwt_token"},
+														RequiredWith:  []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.jwt_token_wo_version"},
+													},
+													"jwt_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.jwt_token_wo"},
 													},
 													"refresh_token": {
-														Type:      schema.TypeString,
-														Optional:  true,
-														Sensitive: true,
+														Type:          schema.TypeString,
+														Optional:      true,
+														Sensitive:     true,
+														ConflictsWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.refresh_token_wo"},
+													},
+													"refresh_token_wo": {
+														Type:          schema.TypeString,
+														Optional:      true,
+														WriteOnly:     true,
+														Sensitive:     true,
+														ConflictsWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.refresh_token"},
+														RequiredWith:  []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.refresh_token_wo_version"},
+													},
+													"refresh_token_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.refresh_token_wo"},
 													},
 													"user_managed_client_application_client_secret": {
-														Type:      schema.TypeString,
-														Optional:  true,
-														Sensitive: true,
+														Type:          schema.TypeString,
+														Optional:      true,
+														Sensitive:     true,
+														ConflictsWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.user_managed_client_application_client_secret_wo"},
+													},
+													"user_managed_client_application_client_secret_wo": {
+														Type:          schema.TypeString,
+														Optional:      true,
+														WriteOnly:     true,
+														Sensitive:     true,
+														ConflictsWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.user_managed_client_application_client_secret"},
+														RequiredWith:  []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.user_managed_client_application_client_secret_wo_version"},
+													},
+													"user_managed_client_application_client_secret_wo_version": {
+														Type:         schema.TypeInt,
+														Optional:     true,
+														RequiredWith: []string{"authentication_configuration.0.oauth2_properties.0.oauth2_credentials.0.user_managed_client_application_client_secret_wo"},
 													},
 												},
 											},
@@ -276,6 +361,11 @@ func resourceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:            getTagsIn(ctx),
 	}
 
+	diags = append(diags, expandConnectionInputWriteOnly(d, input.ConnectionInput)...)
+	if diags.HasError() {
+		return diags
+	}
+
 	_, err := conn.CreateConnection(ctx, &input)
 
 	if err != nil {
@@ -343,6 +433,11 @@ func resourceConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta
 			Name:            aws.String(connectionName),
 		}
 
+		diags = append(diags, expandConnectionInputWriteOnly(d, input.ConnectionInput)...)
+		if diags.HasError() {
+			return diags
+		}
+
 		_, err = conn.UpdateConnection(ctx, &input)
 
 		if err != nil {
@@ -467,6 +562,71 @@ func expandConnectionInput(d *schema.ResourceData) *awstypes.ConnectionInput {
 	return apiObject
 }
 
+// expandConnectionInputWriteOnly sets any write-only secrets from configuration on the connection input.
+func expandConnectionInputWriteOnly(d *schema.ResourceData, apiObject *awstypes.ConnectionInput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil || apiObject.AuthenticationConfiguration == nil {
+		return diags
+	}
+
+	authPath := cty.GetAttrPath("authentication_configuration").IndexInt(0)
+	authConfig := apiObject.AuthenticationConfiguration
+
+	if v := authConfig.BasicAuthenticationCredentials; v != nil {
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, authPath.GetAttr("basic_authentication_credentials").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			v.Password = aws.String(passwordWO)
+		}
+	}
+
+	if authConfig.OAuth2Properties == nil {
+		return diags
+	}
+
+	oauth2Path := authPath.GetAttr("oauth2_properties").IndexInt(0)
+
+	if v := authConfig.OAuth2Properties.AuthorizationCodeProperties; v != nil {
+		authorizationCodeWO, di := flex.GetWriteOnlyStringValue(d, oauth2Path.GetAttr("authorization_code_properties").IndexInt(0).GetAttr("authorization_code_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if authorizationCodeWO != "" {
+			v.AuthorizationCode = aws.String(authorizationCodeWO)
+		}
+	}
+
+	if v := authConfig.OAuth2Properties.OAuth2Credentials; v != nil {
+		credentialsPath := oauth2Path.GetAttr("oauth2_credentials").IndexInt(0)
+
+		for attr, field := range map[string]**string{
+			"access_token_wo":  &v.AccessToken,
+			"jwt_token_wo":     &v.JwtToken,
+			"refresh_token_wo": &v.RefreshToken,
+			"user_managed_client_application_client_secret_wo": &v.UserManagedClientApplicationClientSecret,
+		} {
+			valueWO, di := flex.GetWriteOnlyStringValue(d, credentialsPath.GetAttr(attr))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if valueWO != "" {
+				*field = aws.String(valueWO)
+			}
+		}
+	}
+
+	return diags
+}
+
 func expandPhysicalConnectionRequirements(tfMap map[string]any) *awstypes.PhysicalConnectionRequirements {
 	apiObject := &awstypes.PhysicalConnectionRequirements{}
 
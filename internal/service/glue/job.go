@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -199,9 +200,23 @@ func resourceJob() *schema.Resource {
 								ValidateDiagFunc: enum.Validate[awstypes.SourceControlAuthStrategy](),
 							},
 							"auth_token": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"source_control_details.0.auth_token_wo"},
+							},
+							"auth_token_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"source_control_details.0.auth_token"},
+								RequiredWith:  []string{"source_control_details.0.auth_token_wo_version"},
+							},
+							"auth_token_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"source_control_details.0.auth_token_wo"},
 							},
 							"branch": {
 								Type:     schema.TypeString,
@@ -326,6 +341,17 @@ func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 	if v, ok := d.GetOk("source_control_details"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.SourceControlDetails = expandSourceControlDetails(v.([]any))
+
+		// get write-only value from configuration
+		authTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("source_control_details").IndexInt(0).GetAttr("auth_token_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if authTokenWO != "" {
+			input.SourceControlDetails.AuthToken = aws.String(authTokenWO)
+		}
 	}
 
 	if v, ok := d.GetOk(names.AttrTimeout); ok {
@@ -445,6 +471,17 @@ func resourceJobUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 
 		if v, ok := d.GetOk("source_control_details"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 			jobUpdate.SourceControlDetails = expandSourceControlDetails(v.([]any))
+
+			// get write-only value from configuration
+			authTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("source_control_details").IndexInt(0).GetAttr("auth_token_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if authTokenWO != "" {
+				jobUpdate.SourceControlDetails.AuthToken = aws.String(authTokenWO)
+			}
 		}
 
 		if !strings.EqualFold(jobCommandNameRay, aws.ToString(jobUpdate.Command.Name)) {
@@ -546,7 +583,7 @@ func resourceJobFlatten(ctx context.Context, awsClient *conns.AWSClient, job *aw
 	d.Set("number_of_workers", job.NumberOfWorkers)
 	d.Set(names.AttrRoleARN, job.Role)
 	d.Set("security_configuration", job.SecurityConfiguration)
-	if err := d.Set("source_control_details", flattenSourceControlDetails(job.SourceControlDetails)); err != nil {
+	if err := d.Set("source_control_details", flattenSourceControlDetails(job.SourceControlDetails, d.Get("source_control_details.0.auth_token_wo_version").(int))); err != nil {
 		return fmt.Errorf("setting source_control_details: %w", err)
 	}
 	d.Set(names.AttrTimeout, job.Timeout)
@@ -703,19 +740,20 @@ func expandSourceControlDetails(l []any) *awstypes.SourceControlDetails {
 	return sourceControlDetails
 }
 
-func flattenSourceControlDetails(sourceControlDetails *awstypes.SourceControlDetails) []map[string]any {
+func flattenSourceControlDetails(sourceControlDetails *awstypes.SourceControlDetails, authTokenWOVersion int) []map[string]any {
 	if sourceControlDetails == nil {
 		return []map[string]any{}
 	}
 
 	m := map[string]any{
-		"auth_strategy":  sourceControlDetails.AuthStrategy,
-		"branch":         aws.ToString(sourceControlDetails.Branch),
-		"folder":         aws.ToString(sourceControlDetails.Folder),
-		"last_commit_id": aws.ToString(sourceControlDetails.LastCommitId),
-		names.AttrOwner:  aws.ToString(sourceControlDetails.Owner),
-		"provider":       sourceControlDetails.Provider,
-		"repository":     aws.ToString(sourceControlDetails.Repository),
+		"auth_strategy":         sourceControlDetails.AuthStrategy,
+		"auth_token_wo_version": authTokenWOVersion,
+		"branch":                aws.ToString(sourceControlDetails.Branch),
+		"folder":                aws.ToString(sourceControlDetails.Folder),
+		"last_commit_id":        aws.ToString(sourceControlDetails.LastCommitId),
+		names.AttrOwner:         aws.ToString(sourceControlDetails.Owner),
+		"provider":              sourceControlDetails.Provider,
+		"repository":            aws.ToString(sourceControlDetails.Repository),
 	}
 
 	return []map[string]any{m}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -1151,6 +1152,43 @@ func TestAccGlueJob_sourceControlDetails(t *testing.T) {
 	})
 }
 
+func TestAccGlueJob_sourceControlDetailsAuthTokenWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var job awstypes.Job
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_glue_job.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckJobDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_sourceControlDetailsAuthTokenWriteOnly(rName, "token1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_control_details.0.auth_token_wo"),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.0.auth_token_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccJobConfig_sourceControlDetailsAuthTokenWriteOnly(rName, "token2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(ctx, t, resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.#", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_control_details.0.auth_token_wo"),
+					resource.TestCheckResourceAttr(resourceName, "source_control_details.0.auth_token_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueJob_jobMode(t *testing.T) {
 	ctx := acctest.Context(t)
 	var job awstypes.Job
@@ -1791,3 +1829,29 @@ resource "aws_glue_job" "test" {
 }
 `, rName, jobMode))
 }
+
+func testAccJobConfig_sourceControlDetailsAuthTokenWriteOnly(rName, authToken string, version int) string {
+	return acctest.ConfigCompose(testAccJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_glue_job" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  command {
+    script_location = "testscriptlocation"
+  }
+
+  source_control_details {
+    provider              = "GITHUB"
+    repository            = %[1]q
+    branch                = "test-branch"
+    owner                 = "test-owner"
+    last_commit_id        = "test-commit-id"
+    auth_strategy         = "PERSONAL_ACCESS_TOKEN"
+    auth_token_wo         = %[2]q
+    auth_token_wo_version = %[3]d
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, authToken, version))
+}
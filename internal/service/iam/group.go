@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_iam_group", name="Group")
+// @ArnFormat("group{path}{name}")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -35,6 +35,7 @@ const (
 )
 
 // @SDKResource("aws_iam_instance_profile", name="Instance Profile")
+// @ArnFormat("instance-profile{path}{name}", attribute="arn")
 // @Tags(identifierAttribute="name", resourceType="InstanceProfile")
 // @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.InstanceProfile")
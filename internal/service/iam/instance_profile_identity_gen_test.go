@@ -48,6 +48,7 @@ func TestAccIAMInstanceProfile_Identity_basic(t *testing.T) {
 					testAccCheckInstanceProfileExists(ctx, t, resourceName, &v),
 				),
 				ConfigStateChecks: []statecheck.StateCheck{
+					tfstatecheck.ExpectGlobalARNFormat(resourceName, tfjsonpath.New(names.AttrARN), "iam", "instance-profile{path}{name}"),
 					statecheck.ExpectIdentity(resourceName, map[string]knownvalue.Check{
 						names.AttrAccountID: tfknownvalue.AccountID(),
 						names.AttrName:      knownvalue.NotNull(),
@@ -38,6 +38,7 @@ const (
 )
 
 // @SDKResource("aws_iam_policy", name="Policy")
+// @ArnFormat("policy{path}{name}")
 // @Tags(identifierAttribute="arn", resourceType="Policy")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam/types;types.Policy")
 // @ArnIdentity
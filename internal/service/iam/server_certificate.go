@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -94,11 +96,26 @@ func resourceServerCertificate() *schema.Resource {
 				},
 				names.AttrPrivateKey: {
 					Type:             schema.TypeString,
-					Required:         true,
+					Optional:         true,
 					ForceNew:         true,
 					Sensitive:        true,
 					DiffSuppressFunc: suppressNormalizeCertRemoval,
 					StateFunc:        sdkv2.TrimSpaceSchemaStateFunc,
+					ExactlyOneOf:     []string{"private_key", "private_key_wo"},
+				},
+				"private_key_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"private_key", "private_key_wo"},
+					RequiredWith: []string{"private_key_wo_version"},
+				},
+				"private_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{"private_key_wo"},
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -118,11 +135,25 @@ func resourceServerCertificateCreate(ctx context.Context, d *schema.ResourceData
 	sslCertName := create.Name(ctx, d.Get(names.AttrName).(string), d.Get(names.AttrNamePrefix).(string))
 	input := iam.UploadServerCertificateInput{
 		CertificateBody:       aws.String(d.Get("certificate_body").(string)),
-		PrivateKey:            aws.String(d.Get(names.AttrPrivateKey).(string)),
 		ServerCertificateName: aws.String(sslCertName),
 		Tags:                  getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk(names.AttrPrivateKey); ok {
+		input.PrivateKey = aws.String(v.(string))
+	}
+
+	// get write-only value from configuration
+	privateKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("private_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if privateKeyWO != "" {
+		input.PrivateKey = aws.String(privateKeyWO)
+	}
+
 	if v, ok := d.GetOk(names.AttrCertificateChain); ok {
 		input.CertificateChain = aws.String(v.(string))
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
@@ -158,6 +159,35 @@ func TestAccIAMServerCertificate_namePrefix(t *testing.T) {
 	})
 }
 
+func TestAccIAMServerCertificate_privateKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ServerCertificate
+	resourceName := "aws_iam_server_certificate.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerCertificateDestroy(ctx, t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerCertificateConfig_privateKeyWriteOnly(rName, key, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerCertificateExists(ctx, t, resourceName, &v),
+					resource.TestCheckNoResourceAttr(resourceName, "private_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "private_key_wo_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMServerCertificate_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cert awstypes.ServerCertificate
@@ -409,3 +439,14 @@ EOF
 }
 `, rName, fName)
 }
+
+func testAccServerCertificateConfig_privateKeyWriteOnly(rName, key, certificate string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_server_certificate" "test" {
+  name                   = %[1]q
+  certificate_body       = "%[2]s"
+  private_key_wo         = "%[3]s"
+  private_key_wo_version = 1
+}
+`, rName, acctest.TLSPEMEscapeNewlines(certificate), acctest.TLSPEMEscapeNewlines(key))
+}
@@ -30,6 +30,7 @@ import (
 )
 
 // @SDKResource("aws_iam_user", name="User")
+// @ArnFormat("user{path}{name}", attribute="arn")
 // @IdentityAttribute("name")
 // @MutableIdentity
 // @Tags(identifierAttribute="id", resourceType="User")
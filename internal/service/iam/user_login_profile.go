@@ -17,12 +17,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -32,6 +34,7 @@ func resourceUserLoginProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserLoginProfileCreate,
 		ReadWithoutTimeout:   resourceUserLoginProfileRead,
+		UpdateWithoutTimeout: resourceUserLoginProfileUpdate,
 		DeleteWithoutTimeout: resourceUserLoginProfileDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -50,9 +53,10 @@ func resourceUserLoginProfile() *schema.Resource {
 					ForceNew: true,
 				},
 				"pgp_key": {
-					Type:     schema.TypeString,
-					Optional: true,
-					ForceNew: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					ConflictsWith: []string{"password_wo"},
 				},
 				"password_reset_required": {
 					Type:     schema.TypeBool,
@@ -67,6 +71,19 @@ func resourceUserLoginProfile() *schema.Resource {
 					ForceNew:     true,
 					ValidateFunc: validation.IntBetween(5, 128),
 				},
+				"password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"pgp_key"},
+					RequiredWith:  []string{"password_wo_version"},
+				},
+				"password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"password_wo"},
+				},
 
 				"key_fingerprint": {
 					Type:     schema.TypeString,
@@ -145,10 +162,21 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 	username := d.Get("user").(string)
 
-	passwordLength := d.Get("password_length").(int)
-	initialPassword, err := generatePassword(passwordLength)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	initialPassword := passwordWO
+	if initialPassword == "" {
+		passwordLength := d.Get("password_length").(int)
+		var err error
+		initialPassword, err = generatePassword(passwordLength)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
+		}
 	}
 
 	request := &iam.CreateLoginProfileInput{
@@ -177,7 +205,7 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 
 		d.Set("key_fingerprint", fingerprint)
 		d.Set("encrypted_password", encrypted)
-	} else {
+	} else if passwordWO == "" {
 		d.Set(names.AttrPassword, initialPassword)
 	}
 
@@ -228,6 +256,35 @@ func resourceUserLoginProfileRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func resourceUserLoginProfileUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	if d.HasChange("password_wo_version") {
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input := iam.UpdateLoginProfileInput{
+				Password:              aws.String(passwordWO),
+				PasswordResetRequired: aws.Bool(d.Get("password_reset_required").(bool)),
+				UserName:              aws.String(d.Id()),
+			}
+
+			_, err := conn.UpdateLoginProfile(ctx, &input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IAM User Login Profile (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceUserLoginProfileRead(ctx, d, meta)...)
+}
+
 func resourceUserLoginProfileDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
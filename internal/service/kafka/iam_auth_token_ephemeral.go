@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kafka
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+const (
+	// IAM authentication tokens are valid for 15 minutes.
+	iamAuthTokenExpiration = 15 * time.Minute
+	// SHA-256 hash of an empty request body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// @EphemeralResource(aws_msk_iam_auth_token, name="IAM Auth Token")
+func newIAMAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &iamAuthTokenEphemeralResource{}, nil
+}
+
+type iamAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[iamAuthTokenEphemeralResourceModel]
+}
+
+func (e *iamAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *iamAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := iamAuthTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := e.Meta().CredentialsProvider(ctx).Retrieve(ctx)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("retrieving AWS credentials: %w", err))
+		return
+	}
+
+	now := time.Now().UTC()
+
+	token, err := buildIAMAuthToken(ctx, credentials, e.Meta().RegionalHostname(ctx, "kafka"), e.Meta().Region(ctx), now)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	expiresAt := now.Add(iamAuthTokenExpiration)
+	data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	data.Token = fwflex.StringValueToFramework(ctx, token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+// buildIAMAuthToken signs an MSK IAM authentication token for use with the SASL OAUTHBEARER mechanism.
+// The token is the base64url-encoded presigned kafka-cluster:Connect URL, as generated by aws-msk-iam-sasl-signer-go.
+// See https://docs.aws.amazon.com/msk/latest/developerguide/iam-access-control.html.
+func buildIAMAuthToken(ctx context.Context, credentials aws.Credentials, hostname, region string, signingTime time.Time) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+hostname+"/", nil)
+	if err != nil {
+		return "", fmt.Errorf("creating MSK IAM authentication request: %w", err)
+	}
+
+	query := url.Values{
+		"Action":        []string{"kafka-cluster:Connect"},
+		"X-Amz-Expires": []string{strconv.Itoa(int(iamAuthTokenExpiration.Seconds()))},
+	}
+	request.URL.RawQuery = query.Encode()
+
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, "kafka-cluster", region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("signing MSK IAM authentication token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(signedURI)), nil
+}
+
+type iamAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Token     types.String `tfsdk:"token"`
+}
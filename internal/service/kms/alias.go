@@ -25,6 +25,7 @@ import (
 )
 
 // @SDKResource("aws_kms_alias", name="Alias")
+// @ArnFormat("{name}", attribute="arn", resourceType="alias")
 // @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kms/types;awstypes;awstypes.AliasListEntry")
 // @Testing(preIdentityVersion="v6.10.0")
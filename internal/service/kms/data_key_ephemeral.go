@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_kms_data_key, name="Data Key")
+func newDataKeyEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &dataKeyEphemeralResource{}, nil
+}
+
+type dataKeyEphemeralResource struct {
+	framework.EphemeralResourceWithModel[dataKeyEphemeralResourceModel]
+}
+
+func (e *dataKeyEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"ciphertext_blob": schema.StringAttribute{
+				Computed: true,
+			},
+			"context": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			"key_spec": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataKeySpec](),
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("number_of_bytes")),
+				},
+			},
+			"number_of_bytes": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 1024),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *dataKeyEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data dataKeyEphemeralResourceModel
+	conn := e.Meta().KMSClient(ctx)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input kms.GenerateDataKeyInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.EncryptionContext = fwflex.ExpandFrameworkStringValueMap(ctx, data.Context)
+	if data.KeySpec.IsNull() && data.NumberOfBytes.IsNull() {
+		input.KeySpec = awstypes.DataKeySpecAes256
+	}
+
+	output, err := conn.GenerateDataKey(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.KeyID.ValueString())
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.KeyId)
+	data.CiphertextBlob = fwflex.StringValueToFramework(ctx, inttypes.Base64Encode(output.CiphertextBlob))
+	data.Plaintext = fwflex.StringValueToFramework(ctx, inttypes.Base64Encode(output.Plaintext))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type dataKeyEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ARN            types.String                             `tfsdk:"arn" autoflex:"-"`
+	CiphertextBlob types.String                             `tfsdk:"ciphertext_blob" autoflex:"-"`
+	Context        fwtypes.MapOfString                      `tfsdk:"context" autoflex:"-"`
+	GrantTokens    fwtypes.ListOfString                     `tfsdk:"grant_tokens"`
+	KeyID          types.String                             `tfsdk:"key_id"`
+	KeySpec        fwtypes.StringEnum[awstypes.DataKeySpec] `tfsdk:"key_spec"`
+	NumberOfBytes  types.Int32                              `tfsdk:"number_of_bytes"`
+	Plaintext      types.String                             `tfsdk:"plaintext" autoflex:"-"`
+}
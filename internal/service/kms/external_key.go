@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
@@ -84,10 +86,25 @@ func resourceExternalKey() *schema.Resource {
 					Computed: true,
 				},
 				"key_material_base64": {
-					Type:      schema.TypeString,
-					Optional:  true,
-					ForceNew:  true,
-					Sensitive: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"key_material_base64_wo"},
+				},
+				"key_material_base64_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"key_material_base64"},
+					RequiredWith:  []string{"key_material_base64_wo_version"},
+				},
+				"key_material_base64_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{"key_material_base64_wo"},
 				},
 				"key_spec": {
 					Type:             schema.TypeString,
@@ -130,6 +147,13 @@ func resourceExternalKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
+	// get write-only value from configuration
+	keyMaterialWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("key_material_base64_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	input := kms.CreateKeyInput{
 		BypassPolicyLockoutSafetyCheck: d.Get("bypass_policy_lockout_safety_check").(bool),
 		Origin:                         awstypes.OriginTypeExternal,
@@ -178,10 +202,15 @@ func resourceExternalKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
 
-	if v, ok := d.GetOk("key_material_base64"); ok {
+	keyMaterial := d.Get("key_material_base64").(string)
+	if keyMaterialWO != "" {
+		keyMaterial = keyMaterialWO
+	}
+
+	if keyMaterial != "" {
 		validTo := d.Get("valid_to").(string)
 
-		if err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), v.(string), validTo); err != nil {
+		if err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), keyMaterial, validTo); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -299,7 +328,20 @@ func resourceExternalKeyUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if d.HasChange("valid_to") {
 		validTo := d.Get("valid_to").(string)
 
-		if err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), d.Get("key_material_base64").(string), validTo); err != nil {
+		keyMaterial := d.Get("key_material_base64").(string)
+
+		// get write-only value from configuration
+		keyMaterialWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("key_material_base64_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if keyMaterialWO != "" {
+			keyMaterial = keyMaterialWO
+		}
+
+		if err := importExternalKeyMaterial(ctx, conn, "KMS External Key", d.Id(), keyMaterial, validTo); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
@@ -321,6 +322,55 @@ func TestAccKMSExternalKey_keyMaterialBase64(t *testing.T) {
 	})
 }
 
+func TestAccKMSExternalKey_keyMaterialBase64WriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var key awstypes.KeyMetadata
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_kms_external_key.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckExternalKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				// ACCEPTANCE TESTING ONLY -- NEVER EXPOSE YOUR KEY MATERIAL
+				Config: testAccExternalKeyConfig_materialBase64WriteOnly(rName, "Wblj06fduthWggmsT0cLVoIMOkeLbc2kVfMud77i/JY=", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "key_state", string(awstypes.KeyStateEnabled)),
+					resource.TestCheckNoResourceAttr(resourceName, "key_material_base64_wo"),
+					resource.TestCheckResourceAttr(resourceName, "key_material_base64_wo_version", "1"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				// ACCEPTANCE TESTING ONLY -- NEVER EXPOSE YOUR KEY MATERIAL
+				Config: testAccExternalKeyConfig_materialBase64WriteOnly(rName, "O1zsg06cKRCsZnoT5oizMlwHEtnk0HoOmBLkFtwh2Vw=", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExternalKeyExists(ctx, t, resourceName, &key),
+					resource.TestCheckResourceAttr(resourceName, "key_state", string(awstypes.KeyStateEnabled)),
+					resource.TestCheckNoResourceAttr(resourceName, "key_material_base64_wo"),
+					resource.TestCheckResourceAttr(resourceName, "key_material_base64_wo_version", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccKMSExternalKey_policy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var key1, key2 awstypes.KeyMetadata
@@ -749,3 +799,14 @@ resource "aws_kms_external_key" "test" {
 }
 `, rName, spec)
 }
+
+func testAccExternalKeyConfig_materialBase64WriteOnly(rName, keyMaterialBase64 string, keyMaterialBase64Version int) string {
+	return fmt.Sprintf(`
+resource "aws_kms_external_key" "test" {
+  description                    = %[1]q
+  deletion_window_in_days        = 7
+  key_material_base64_wo         = %[2]q
+  key_material_base64_wo_version = %[3]d
+}
+`, rName, keyMaterialBase64, keyMaterialBase64Version)
+}
@@ -34,6 +34,7 @@ import (
 )
 
 // @SDKResource("aws_kms_key", name="Key")
+// @ArnFormat("key/{key_id}", attribute="arn")
 // @Tags(identifierAttribute="id")
 // @IdentityAttribute("id")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/kms/types;awstypes;awstypes.KeyMetadata")
@@ -451,6 +452,16 @@ func findKeyPolicyByTwoPartKey(ctx context.Context, conn *kms.Client, keyID, pol
 }
 
 func findKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.Client, keyID string) (*bool, *int32, error) {
+	output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return aws.Bool(output.KeyRotationEnabled), output.RotationPeriodInDays, nil
+}
+
+func findKeyRotationStatusByKeyID(ctx context.Context, conn *kms.Client, keyID string) (*kms.GetKeyRotationStatusOutput, error) {
 	input := kms.GetKeyRotationStatusInput{
 		KeyId: aws.String(keyID),
 	}
@@ -458,20 +469,20 @@ func findKeyRotationEnabledByKeyID(ctx context.Context, conn *kms.Client, keyID
 	output, err := conn.GetKeyRotationStatus(ctx, &input)
 
 	if errs.IsA[*awstypes.NotFoundException](err) {
-		return nil, nil, &retry.NotFoundError{
+		return nil, &retry.NotFoundError{
 			LastError: err,
 		}
 	}
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	if output == nil {
-		return nil, nil, tfresource.NewEmptyResultError()
+		return nil, tfresource.NewEmptyResultError()
 	}
 
-	return aws.Bool(output.KeyRotationEnabled), output.RotationPeriodInDays, nil
+	return output, nil
 }
 
 func updateKeyDescription(ctx context.Context, conn *kms.Client, resourceTypeName, keyID, description string) error {
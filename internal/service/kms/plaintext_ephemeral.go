@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_kms_plaintext, name="Plaintext")
+func newPlaintextEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &plaintextEphemeralResource{}, nil
+}
+
+type plaintextEphemeralResource struct {
+	framework.EphemeralResourceWithModel[plaintextEphemeralResourceModel]
+}
+
+func (e *plaintextEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"ciphertext_blob": schema.StringAttribute{
+				Required: true,
+			},
+			"context": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+			},
+			"encryption_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EncryptionAlgorithmSpec](),
+				Optional:   true,
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Optional: true,
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"plaintext_base64": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *plaintextEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data plaintextEphemeralResourceModel
+	conn := e.Meta().KMSClient(ctx)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input kms.DecryptInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.EncryptionContext = fwflex.ExpandFrameworkStringValueMap(ctx, data.Context)
+
+	ciphertextBlob, err := inttypes.Base64Decode(data.CiphertextBlob.ValueString())
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("decoding ciphertext_blob: %w", err))
+		return
+	}
+	input.CiphertextBlob = ciphertextBlob
+
+	output, err := conn.Decrypt(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.KeyID.ValueString())
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.KeyId)
+	// Binary plaintext is only available base64-encoded.
+	if utf8.Valid(output.Plaintext) {
+		data.Plaintext = fwflex.StringValueToFramework(ctx, string(output.Plaintext))
+	} else {
+		data.Plaintext = types.StringNull()
+	}
+	data.PlaintextBase64 = fwflex.StringValueToFramework(ctx, inttypes.Base64Encode(output.Plaintext))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type plaintextEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ARN                 types.String                                         `tfsdk:"arn" autoflex:"-"`
+	CiphertextBlob      types.String                                         `tfsdk:"ciphertext_blob" autoflex:"-"`
+	Context             fwtypes.MapOfString                                  `tfsdk:"context" autoflex:"-"`
+	EncryptionAlgorithm fwtypes.StringEnum[awstypes.EncryptionAlgorithmSpec] `tfsdk:"encryption_algorithm"`
+	GrantTokens         fwtypes.ListOfString                                 `tfsdk:"grant_tokens"`
+	KeyID               types.String                                         `tfsdk:"key_id"`
+	Plaintext           types.String                                         `tfsdk:"plaintext" autoflex:"-"`
+	PlaintextBase64     types.String                                         `tfsdk:"plaintext_base64" autoflex:"-"`
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
@@ -83,10 +85,25 @@ func resourceReplicaExternalKey() *schema.Resource {
 					Computed: true,
 				},
 				"key_material_base64": {
-					Type:      schema.TypeString,
-					Optional:  true,
-					ForceNew:  true,
-					Sensitive: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					ForceNew:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"key_material_base64_wo"},
+				},
+				"key_material_base64_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"key_material_base64"},
+					RequiredWith:  []string{"key_material_base64_wo_version"},
+				},
+				"key_material_base64_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{"key_material_base64_wo"},
 				},
 				"key_state": {
 					Type:     schema.TypeString,
@@ -119,6 +136,13 @@ func resourceReplicaExternalKeyCreate(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
+	// get write-only value from configuration
+	keyMaterialWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("key_material_base64_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
 	// e.g. arn:aws:kms:us-east-2:111122223333:key/mrk-1234abcd12ab34cd56ef1234567890ab
 	primaryKeyARN, err := arn.Parse(d.Get("primary_key_arn").(string))
 	if err != nil {
@@ -162,10 +186,15 @@ func resourceReplicaExternalKeyCreate(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "waiting for KMS Replica External Key (%s) create: %s", d.Id(), err)
 	}
 
-	if v, ok := d.GetOk("key_material_base64"); ok {
+	keyMaterial := d.Get("key_material_base64").(string)
+	if keyMaterialWO != "" {
+		keyMaterial = keyMaterialWO
+	}
+
+	if keyMaterial != "" {
 		validTo := d.Get("valid_to").(string)
 
-		if err := importExternalKeyMaterial(ctx, conn, "KMS Replica External Key", d.Id(), v.(string), validTo); err != nil {
+		if err := importExternalKeyMaterial(ctx, conn, "KMS Replica External Key", d.Id(), keyMaterial, validTo); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -284,7 +313,20 @@ func resourceReplicaExternalKeyUpdate(ctx context.Context, d *schema.ResourceDat
 	if d.HasChange("valid_to") {
 		validTo := d.Get("valid_to").(string)
 
-		if err := importExternalKeyMaterial(ctx, conn, "KMS Replica External Key", d.Id(), d.Get("key_material_base64").(string), validTo); err != nil {
+		keyMaterial := d.Get("key_material_base64").(string)
+
+		// get write-only value from configuration
+		keyMaterialWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("key_material_base64_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if keyMaterialWO != "" {
+			keyMaterial = keyMaterialWO
+		}
+
+		if err := importExternalKeyMaterial(ctx, conn, "KMS Replica External Key", d.Id(), keyMaterial, validTo); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	onDemandRotationStatusInProgress = "IN_PROGRESS"
+	onDemandRotationStatusCompleted  = "COMPLETED"
+)
+
+// @Action(aws_kms_rotate_key_on_demand, name="Rotate Key On Demand")
+func newRotateKeyOnDemandAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a rotateKeyOnDemandAction
+	a.SetDefaultInvokeTimeout(30 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action = (*rotateKeyOnDemandAction)(nil)
+)
+
+type rotateKeyOnDemandAction struct {
+	framework.ActionWithModel[rotateKeyOnDemandActionModel]
+	framework.ActionWithTimeouts
+}
+
+type rotateKeyOnDemandActionModel struct {
+	framework.WithRegionModel
+	KeyID    types.String   `tfsdk:"key_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (a *rotateKeyOnDemandAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Immediately rotates the key material of a KMS key and waits for the rotation to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrKeyID: schema.StringAttribute{
+				Description: "ID or ARN of the KMS key to rotate.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *rotateKeyOnDemandAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateKeyOnDemandActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+
+	// Get AWS client
+	conn := a.Meta().KMSClient(ctx)
+
+	keyID := fwflex.StringValueFromFramework(ctx, config.KeyID)
+
+	ctx = tflog.SetField(ctx, names.AttrKeyID, keyID)
+
+	tflog.Info(ctx, "Starting KMS rotate key on demand action", map[string]any{
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting on-demand rotation for KMS key %s...", keyID)
+
+	// A completed on-demand rotation is only observable as an additional entry in the key's rotation history.
+	rotations, err := findOnDemandKeyRotationsByKeyID(ctx, conn, keyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Key",
+			fmt.Sprintf("Could not list rotations for KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+	previousRotations := len(rotations)
+
+	input := kms.RotateKeyOnDemandInput{
+		KeyId: aws.String(keyID),
+	}
+
+	_, err = conn.RotateKeyOnDemand(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Key",
+			fmt.Sprintf("Could not start on-demand rotation for KMS key %s: %s", keyID, err),
+		)
+		return
+	}
+
+	cb(ctx, "On-demand rotation started for KMS key %s, waiting for completion...", keyID)
+
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*kms.GetKeyRotationStatusOutput], error) {
+		output, err := findKeyRotationStatusByKeyID(ctx, conn, keyID)
+		if err != nil {
+			return actionwait.FetchResult[*kms.GetKeyRotationStatusOutput]{}, fmt.Errorf("reading key rotation status: %w", err)
+		}
+
+		// KMS removes the on-demand rotation start date once the rotation is complete,
+		// but the start date may not be visible yet immediately after the rotation is requested.
+		// The rotation is only complete once it appears in the key's rotation history.
+		status := onDemandRotationStatusInProgress
+		if output.OnDemandRotationStartDate == nil {
+			rotations, err := findOnDemandKeyRotationsByKeyID(ctx, conn, keyID)
+			if err != nil {
+				return actionwait.FetchResult[*kms.GetKeyRotationStatusOutput]{}, fmt.Errorf("listing key rotations: %w", err)
+			}
+
+			if len(rotations) > previousRotations {
+				status = onDemandRotationStatusCompleted
+			}
+		}
+
+		return actionwait.FetchResult[*kms.GetKeyRotationStatusOutput]{Status: actionwait.Status(status), Value: output}, nil
+	}, actionwait.Options[*kms.GetKeyRotationStatusOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(actionwait.DefaultPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates: []actionwait.Status{
+			onDemandRotationStatusCompleted,
+		},
+		TransitionalStates: []actionwait.Status{
+			onDemandRotationStatusInProgress,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Rotation is currently %q (elapsed %s), continuing to wait for completion...", fr.Status, meta.Elapsed.Truncate(time.Second))
+		},
+	})
+
+	if err != nil {
+		switch {
+		case errs.IsA[*actionwait.TimeoutError](err):
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Key Rotation to Complete",
+				fmt.Sprintf("On-demand rotation of KMS key %s did not complete within %s: %s", keyID, timeout, err),
+			)
+		case errs.IsA[*actionwait.UnexpectedStateError](err):
+			resp.Diagnostics.AddError(
+				"Unexpected Key Rotation State",
+				fmt.Sprintf("On-demand rotation of KMS key %s entered unexpected state: %s", keyID, err),
+			)
+		default:
+			resp.Diagnostics.AddError(
+				"Failed While Waiting for Key Rotation to Complete",
+				fmt.Sprintf("Error waiting for on-demand rotation of KMS key %s: %s", keyID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "On-demand rotation of KMS key %s completed successfully", keyID)
+
+	tflog.Info(ctx, "KMS rotate key on demand action completed successfully")
+}
+
+func findOnDemandKeyRotationsByKeyID(ctx context.Context, conn *kms.Client, keyID string) ([]awstypes.RotationsListEntry, error) {
+	input := kms.ListKeyRotationsInput{
+		KeyId: aws.String(keyID),
+	}
+
+	return findKeyRotations(ctx, conn, &input, func(v *awstypes.RotationsListEntry) bool {
+		return v.RotationType == awstypes.RotationTypeOnDemand
+	})
+}
+
+func findKeyRotations(ctx context.Context, conn *kms.Client, input *kms.ListKeyRotationsInput, filter tfslices.Predicate[*awstypes.RotationsListEntry]) ([]awstypes.RotationsListEntry, error) {
+	var output []awstypes.RotationsListEntry
+
+	pages := kms.NewListKeyRotationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return output, err
+		}
+
+		for _, v := range page.Rotations {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
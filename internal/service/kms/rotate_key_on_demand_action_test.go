@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSRotateKeyOnDemandAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_kms_key.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateKeyOnDemandActionConfig_basic(rName, awstypes.KeySpecSymmetricDefault),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotateKeyOnDemandActionRotated(ctx, t, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKMSRotateKeyOnDemandAction_unsupportedKeySpec(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckKeyDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateKeyOnDemandActionConfig_basic(rName, awstypes.KeySpecRsa2048),
+				ExpectError: regexache.MustCompile(`Failed to Rotate Key`),
+			},
+		},
+	})
+}
+
+// testAccCheckRotateKeyOnDemandActionRotated verifies that the key has a completed on-demand rotation
+func testAccCheckRotateKeyOnDemandActionRotated(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).KMSClient(ctx)
+
+		input := kms.ListKeyRotationsInput{
+			KeyId: aws.String(rs.Primary.ID),
+		}
+		pages := kms.NewListKeyRotationsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				return fmt.Errorf("listing KMS Key (%s) rotations: %w", rs.Primary.ID, err)
+			}
+
+			for _, v := range page.Rotations {
+				if v.RotationType == awstypes.RotationTypeOnDemand {
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("KMS Key (%s) has no on-demand rotation", rs.Primary.ID)
+	}
+}
+
+func testAccRotateKeyOnDemandActionConfig_basic(rName string, keySpec awstypes.KeySpec) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description              = %[1]q
+  customer_master_key_spec = %[2]q
+  deletion_window_in_days  = 7
+  enable_key_rotation      = false
+}
+
+action "aws_kms_rotate_key_on_demand" "test" {
+  config {
+    key_id = aws_kms_key.test.key_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_kms_key.test.key_id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_kms_rotate_key_on_demand.test]
+    }
+  }
+}
+`, rName, keySpec)
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_kms_signature, name="Signature")
+func newSignatureEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &signatureEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigValidators = (*signatureEphemeralResource)(nil)
+)
+
+type signatureEphemeralResource struct {
+	framework.EphemeralResourceWithModel[signatureEphemeralResourceModel]
+}
+
+func (e *signatureEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"message_base64": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"message_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MessageType](),
+				Optional:   true,
+			},
+			names.AttrPublicKey: schema.StringAttribute{
+				Computed: true,
+			},
+			"public_key_pem": schema.StringAttribute{
+				Computed: true,
+			},
+			"signature": schema.StringAttribute{
+				Computed: true,
+			},
+			"signing_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.SigningAlgorithmSpec](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (e *signatureEphemeralResource) ConfigValidators(context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot(names.AttrMessage),
+			path.MatchRoot("message_base64"),
+		),
+	}
+}
+
+func (e *signatureEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data signatureEphemeralResourceModel
+	conn := e.Meta().KMSClient(ctx)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input kms.SignInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.MessageBase64.IsNull() {
+		input.Message = []byte(data.Message.ValueString())
+	} else {
+		message, err := inttypes.Base64Decode(data.MessageBase64.ValueString())
+		if err != nil {
+			smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("decoding message_base64: %w", err))
+			return
+		}
+		input.Message = message
+	}
+
+	output, err := conn.Sign(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.KeyID.ValueString())
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.KeyId)
+	data.Signature = fwflex.StringValueToFramework(ctx, inttypes.Base64Encode(output.Signature))
+
+	// Return the public key so that the signature can be verified without a separate lookup.
+	publicKeyInput := kms.GetPublicKeyInput{
+		GrantTokens: input.GrantTokens,
+		KeyId:       output.KeyId,
+	}
+	publicKeyOutput, err := conn.GetPublicKey(ctx, &publicKeyInput)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.ARN.ValueString())
+		return
+	}
+
+	data.PublicKey = fwflex.StringValueToFramework(ctx, inttypes.Base64Encode(publicKeyOutput.PublicKey))
+	data.PublicKeyPEM = fwflex.StringValueToFramework(ctx, string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: publicKeyOutput.PublicKey,
+	})))
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type signatureEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                      `tfsdk:"arn" autoflex:"-"`
+	GrantTokens      fwtypes.ListOfString                              `tfsdk:"grant_tokens"`
+	KeyID            types.String                                      `tfsdk:"key_id"`
+	Message          types.String                                      `tfsdk:"message" autoflex:"-"`
+	MessageBase64    types.String                                      `tfsdk:"message_base64" autoflex:"-"`
+	MessageType      fwtypes.StringEnum[awstypes.MessageType]          `tfsdk:"message_type"`
+	PublicKey        types.String                                      `tfsdk:"public_key" autoflex:"-"`
+	PublicKeyPEM     types.String                                      `tfsdk:"public_key_pem" autoflex:"-"`
+	Signature        types.String                                      `tfsdk:"signature" autoflex:"-"`
+	SigningAlgorithm fwtypes.StringEnum[awstypes.SigningAlgorithmSpec] `tfsdk:"signing_algorithm"`
+}
@@ -43,6 +43,7 @@ const (
 )
 
 // @SDKResource("aws_lambda_function", name="Function")
+// @ArnFormat("function:{function_name}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetFunctionOutput")
 // @Testing(importIgnore="filename;last_modified;publish")
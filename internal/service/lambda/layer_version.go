@@ -34,6 +34,7 @@ import (
 const mutexLayerKey = `aws_lambda_layer_version`
 
 // @SDKResource("aws_lambda_layer_version", name="Layer Version")
+// @ArnFormat("layer:{layer_name}:{version}", attribute="arn")
 // @IdentityAttribute("layer_name")
 // @IdentityAttribute("version")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/lambda;lambda.GetLayerVersionOutput")
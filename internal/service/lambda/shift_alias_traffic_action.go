@@ -0,0 +1,394 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	shiftAliasTrafficStatusBaking actionwait.Status = "BAKING"
+	shiftAliasTrafficStatusBaked  actionwait.Status = "BAKED"
+	shiftAliasTrafficStatusAlarm  actionwait.Status = "ALARM"
+
+	shiftAliasTrafficDefaultStepPercentage = 10
+	shiftAliasTrafficDefaultStepInterval   = 60 * time.Second
+	shiftAliasTrafficAlarmPollInterval     = 10 * time.Second
+)
+
+// @Action(aws_lambda_shift_alias_traffic, name="Shift Alias Traffic")
+func newShiftAliasTrafficAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a shiftAliasTrafficAction
+	a.SetDefaultInvokeTimeout(1 * time.Hour)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action = (*shiftAliasTrafficAction)(nil)
+)
+
+type shiftAliasTrafficAction struct {
+	framework.ActionWithModel[shiftAliasTrafficActionModel]
+	framework.ActionWithTimeouts
+}
+
+type shiftAliasTrafficActionModel struct {
+	framework.WithRegionModel
+	AlarmNames      fwtypes.ListOfString `tfsdk:"alarm_names"`
+	AliasName       types.String         `tfsdk:"alias_name"`
+	Description     types.String         `tfsdk:"description"`
+	FunctionName    types.String         `tfsdk:"function_name"`
+	FunctionVersion types.String         `tfsdk:"function_version"`
+	StepInterval    types.Int64          `tfsdk:"step_interval"`
+	StepPercentage  types.Int64          `tfsdk:"step_percentage"`
+	Timeouts        timeouts.Value       `tfsdk:"timeouts"`
+}
+
+func (a *shiftAliasTrafficAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Publishes a new version of an AWS Lambda function and gradually shifts an alias to it using weighted routing, rolling back automatically if any monitored CloudWatch alarm fires.",
+		Attributes: map[string]schema.Attribute{
+			"alarm_names": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "Names of CloudWatch metric or composite alarms to monitor between steps. If any alarm enters the ALARM state, the alias is rolled back to its original version.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 100),
+				},
+			},
+			"alias_name": schema.StringAttribute{
+				Description: "The name of the alias whose traffic is shifted.",
+				Required:    true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "Description of the version to publish. Ignored when function_version is set.",
+				Optional:    true,
+			},
+			"function_name": schema.StringAttribute{
+				Description: "The name or ARN of the Lambda function.",
+				Required:    true,
+			},
+			"function_version": schema.StringAttribute{
+				Description: "An already published version to shift traffic to. If not specified, a new version is published from $LATEST.",
+				Optional:    true,
+			},
+			"step_interval": schema.Int64Attribute{
+				Description: "Time in seconds to wait, monitoring alarms, after each traffic shift step (default: 60).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AtMost(3600),
+				},
+			},
+			"step_percentage": schema.Int64Attribute{
+				Description: "Percentage of traffic shifted to the new version on each step (default: 10).",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *shiftAliasTrafficAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config shiftAliasTrafficActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Get AWS clients
+	conn := a.Meta().LambdaClient(ctx)
+	cwConn := a.Meta().CloudWatchClient(ctx)
+
+	functionName := fwflex.StringValueFromFramework(ctx, config.FunctionName)
+	aliasName := fwflex.StringValueFromFramework(ctx, config.AliasName)
+	alarmNames := fwflex.ExpandFrameworkStringValueList(ctx, config.AlarmNames)
+	stepPercentage := shiftAliasTrafficDefaultStepPercentage
+	if !config.StepPercentage.IsNull() {
+		stepPercentage = int(config.StepPercentage.ValueInt64())
+	}
+	stepInterval := fwactions.TimeoutOr(config.StepInterval, shiftAliasTrafficDefaultStepInterval)
+
+	ctx = tflog.SetField(ctx, "function_name", functionName)
+	ctx = tflog.SetField(ctx, "alias_name", aliasName)
+
+	tflog.Info(ctx, "Starting Lambda alias traffic shift action", map[string]any{
+		"alarm_names":     alarmNames,
+		"step_percentage": stepPercentage,
+		"step_interval":   stepInterval.String(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting traffic shift for Lambda function %s alias %s...", functionName, aliasName)
+
+	alias, err := findAliasByTwoPartKey(ctx, conn, functionName, aliasName)
+	if retry.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Alias Not Found",
+			fmt.Sprintf("Lambda function %s alias %s was not found", functionName, aliasName),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Alias",
+			fmt.Sprintf("Could not read Lambda function %s alias %s: %s", functionName, aliasName, err),
+		)
+		return
+	}
+
+	if alias.RoutingConfig != nil && len(alias.RoutingConfig.AdditionalVersionWeights) > 0 {
+		resp.Diagnostics.AddError(
+			"Traffic Shift Already In Progress",
+			fmt.Sprintf("Lambda function %s alias %s already routes traffic to additional versions %v. Clear the alias routing configuration before starting a new traffic shift.", functionName, aliasName, alias.RoutingConfig.AdditionalVersionWeights),
+		)
+		return
+	}
+
+	currentVersion := aws.ToString(alias.FunctionVersion)
+
+	targetVersion := fwflex.StringValueFromFramework(ctx, config.FunctionVersion)
+	if targetVersion == "" {
+		cb(ctx, "Publishing new version of Lambda function %s...", functionName)
+
+		input := lambda.PublishVersionInput{
+			Description:  fwflex.StringFromFramework(ctx, config.Description),
+			FunctionName: aws.String(functionName),
+		}
+		output, err := tfresource.RetryWhenIsAErrorMessageContains[*lambda.PublishVersionOutput, *awstypes.ResourceConflictException](ctx, lambdaPropagationTimeout, func(ctx context.Context) (*lambda.PublishVersionOutput, error) {
+			return conn.PublishVersion(ctx, &input)
+		}, "in progress")
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Publish Version",
+				fmt.Sprintf("Could not publish a new version of Lambda function %s: %s", functionName, err),
+			)
+			return
+		}
+
+		targetVersion = aws.ToString(output.Version)
+
+		if _, err := waitFunctionConfigurationUpdated(ctx, conn, aws.ToString(output.FunctionArn), targetVersion, timeout); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed While Waiting for Version to Publish",
+				fmt.Sprintf("Error waiting for Lambda function %s version %s to publish: %s", functionName, targetVersion, err),
+			)
+			return
+		}
+
+		cb(ctx, "Published version %s of Lambda function %s", targetVersion, functionName)
+	}
+
+	if targetVersion == currentVersion {
+		cb(ctx, "Lambda function %s alias %s already points to version %s, nothing to shift", functionName, aliasName, targetVersion)
+		return
+	}
+
+	cb(ctx, "Shifting Lambda function %s alias %s from version %s to version %s in %d%% steps...", functionName, aliasName, currentVersion, targetVersion, stepPercentage)
+
+	for weight := stepPercentage; weight < 100; weight += stepPercentage {
+		routingConfig := &awstypes.AliasRoutingConfiguration{
+			AdditionalVersionWeights: map[string]float64{
+				targetVersion: float64(weight) / 100,
+			},
+		}
+		if err := updateAliasRouting(ctx, conn, functionName, aliasName, currentVersion, routingConfig); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Shift Alias Traffic",
+				fmt.Sprintf("Could not route %d%% of Lambda function %s alias %s traffic to version %s: %s", weight, functionName, aliasName, targetVersion, err),
+			)
+			a.rollback(ctx, cb, resp, conn, functionName, aliasName, currentVersion)
+			return
+		}
+
+		cb(ctx, "Routing %d%% of alias %s traffic to version %s", weight, aliasName, targetVersion)
+
+		firing, err := waitAliasTrafficShiftStepBaked(ctx, cwConn, alarmNames, stepInterval, func(meta actionwait.ProgressMeta) {
+			cb(ctx, "Monitoring alias %s at %d%% on version %s, %s remaining in step...", aliasName, weight, targetVersion, max(0, stepInterval-meta.Elapsed).Truncate(time.Second))
+		})
+		if err != nil {
+			if errs.IsA[*actionwait.FailureStateError](err) {
+				resp.Diagnostics.AddError(
+					"Alarm Fired During Traffic Shift",
+					fmt.Sprintf("CloudWatch alarm(s) %s entered the ALARM state while routing %d%% of Lambda function %s alias %s traffic to version %s. The alias has been rolled back to version %s.", strings.Join(firing, ", "), weight, functionName, aliasName, targetVersion, currentVersion),
+				)
+			} else {
+				resp.Diagnostics.AddError(
+					"Failed While Monitoring Traffic Shift",
+					fmt.Sprintf("Error monitoring Lambda function %s alias %s traffic shift to version %s: %s", functionName, aliasName, targetVersion, err),
+				)
+			}
+			a.rollback(ctx, cb, resp, conn, functionName, aliasName, currentVersion)
+			return
+		}
+	}
+
+	// Complete the shift by pointing the alias at the new version with no additional weights.
+	if err := updateAliasRouting(ctx, conn, functionName, aliasName, targetVersion, &awstypes.AliasRoutingConfiguration{}); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Complete Alias Traffic Shift",
+			fmt.Sprintf("Could not point Lambda function %s alias %s at version %s: %s", functionName, aliasName, targetVersion, err),
+		)
+		a.rollback(ctx, cb, resp, conn, functionName, aliasName, currentVersion)
+		return
+	}
+
+	if err := waitAliasRoutingWeightsCleared(ctx, conn, functionName, aliasName, timeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed While Waiting for Alias Routing to Clear",
+			fmt.Sprintf("Error waiting for Lambda function %s alias %s routing weights to clear: %s", functionName, aliasName, err),
+		)
+		return
+	}
+
+	// Final success message
+	cb(ctx, "Lambda function %s alias %s now routes 100%% of traffic to version %s", functionName, aliasName, targetVersion)
+
+	tflog.Info(ctx, "Lambda alias traffic shift action completed successfully", map[string]any{
+		"previous_version": currentVersion,
+		"function_version": targetVersion,
+	})
+}
+
+// rollback points the alias back at its original version and removes any additional version weights.
+func (a *shiftAliasTrafficAction) rollback(ctx context.Context, cb fwactions.SendProgressFunc, resp *action.InvokeResponse, conn *lambda.Client, functionName, aliasName, version string) {
+	cb(ctx, "Rolling back Lambda function %s alias %s to version %s...", functionName, aliasName, version)
+
+	// The invocation context may already have expired, so roll back using a fresh context.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), lambdaPropagationTimeout)
+	defer cancel()
+
+	if err := updateAliasRouting(ctx, conn, functionName, aliasName, version, &awstypes.AliasRoutingConfiguration{}); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Roll Back Alias",
+			fmt.Sprintf("Could not roll back Lambda function %s alias %s to version %s: %s", functionName, aliasName, version, err),
+		)
+		return
+	}
+
+	cb(ctx, "Lambda function %s alias %s rolled back to version %s", functionName, aliasName, version)
+}
+
+func updateAliasRouting(ctx context.Context, conn *lambda.Client, functionName, aliasName, version string, routingConfig *awstypes.AliasRoutingConfiguration) error {
+	input := lambda.UpdateAliasInput{
+		FunctionName:    aws.String(functionName),
+		FunctionVersion: aws.String(version),
+		Name:            aws.String(aliasName),
+		RoutingConfig:   routingConfig,
+	}
+
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*lambda.UpdateAliasOutput, *awstypes.ResourceConflictException](ctx, lambdaPropagationTimeout, func(ctx context.Context) (*lambda.UpdateAliasOutput, error) {
+		return conn.UpdateAlias(ctx, &input)
+	}, "in progress")
+
+	return err
+}
+
+// waitAliasTrafficShiftStepBaked waits for stepInterval to elapse, failing early if any of the named alarms fires.
+// The names of any firing alarms are returned.
+func waitAliasTrafficShiftStepBaked(ctx context.Context, conn *cloudwatch.Client, alarmNames []string, stepInterval time.Duration, progress func(actionwait.ProgressMeta)) ([]string, error) {
+	start := time.Now()
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[[]string], error) {
+		firing, err := findFiringAlarmNames(ctx, conn, alarmNames)
+		if err != nil {
+			return actionwait.FetchResult[[]string]{}, fmt.Errorf("describing CloudWatch alarms: %w", err)
+		}
+		if len(firing) > 0 {
+			return actionwait.FetchResult[[]string]{Status: shiftAliasTrafficStatusAlarm, Value: firing}, nil
+		}
+		if time.Since(start) >= stepInterval {
+			return actionwait.FetchResult[[]string]{Status: shiftAliasTrafficStatusBaked}, nil
+		}
+		return actionwait.FetchResult[[]string]{Status: shiftAliasTrafficStatusBaking}, nil
+	}, actionwait.Options[[]string]{
+		// Allow one extra poll beyond the bake time before timing out.
+		Timeout:            stepInterval + 2*shiftAliasTrafficAlarmPollInterval,
+		Interval:           actionwait.FixedInterval(min(stepInterval, shiftAliasTrafficAlarmPollInterval)),
+		ProgressInterval:   30 * time.Second,
+		SuccessStates:      []actionwait.Status{shiftAliasTrafficStatusBaked},
+		TransitionalStates: []actionwait.Status{shiftAliasTrafficStatusBaking},
+		FailureStates:      []actionwait.Status{shiftAliasTrafficStatusAlarm},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			progress(meta)
+		},
+	})
+
+	return fr.Value, err
+}
+
+// findFiringAlarmNames returns the names of any of the specified alarms that are in the ALARM state.
+func findFiringAlarmNames(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) ([]string, error) {
+	if len(alarmNames) == 0 {
+		return nil, nil
+	}
+
+	input := cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: []cloudwatchtypes.AlarmType{cloudwatchtypes.AlarmTypeCompositeAlarm, cloudwatchtypes.AlarmTypeMetricAlarm},
+		StateValue: cloudwatchtypes.StateValueAlarm,
+	}
+	var firing []string
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricAlarms {
+			firing = append(firing, aws.ToString(v.AlarmName))
+		}
+		for _, v := range page.CompositeAlarms {
+			firing = append(firing, aws.ToString(v.AlarmName))
+		}
+	}
+
+	slices.Sort(firing)
+
+	return firing, nil
+}
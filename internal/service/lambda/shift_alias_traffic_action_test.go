@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaShiftAliasTrafficAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShiftAliasTrafficActionConfig_basic(rName, "v1", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShiftAliasTrafficAction(ctx, t, rName, "1"),
+				),
+			},
+			{
+				Config: testAccShiftAliasTrafficActionConfig_basic(rName, "v2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShiftAliasTrafficAction(ctx, t, rName, "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaShiftAliasTrafficAction_alarmRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.LambdaEndpointID)
+			acctest.PreCheckPartitionHasService(t, names.CloudWatchEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShiftAliasTrafficActionConfig_alarm(rName, "v1", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShiftAliasTrafficAction(ctx, t, rName, "1"),
+				),
+			},
+			{
+				Config:      testAccShiftAliasTrafficActionConfig_alarm(rName, "v2", false, true),
+				ExpectError: regexache.MustCompile(`Alarm Fired During Traffic Shift`),
+			},
+			{
+				Config: testAccShiftAliasTrafficActionConfig_alarm(rName, "v2", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckShiftAliasTrafficAction(ctx, t, rName, "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckShiftAliasTrafficAction verifies that the alias routes all traffic to the expected version
+func testAccCheckShiftAliasTrafficAction(ctx context.Context, t *testing.T, rName, expectedVersion string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).LambdaClient(ctx)
+
+		output, err := tflambda.FindAliasByTwoPartKey(ctx, conn, rName, rName)
+		if err != nil {
+			return fmt.Errorf("reading Lambda Alias (%s): %w", rName, err)
+		}
+
+		if got := aws.ToString(output.FunctionVersion); got != expectedVersion {
+			return fmt.Errorf("Lambda Alias (%s) version = %s, expected %s", rName, got, expectedVersion)
+		}
+
+		if output.RoutingConfig != nil && len(output.RoutingConfig.AdditionalVersionWeights) > 0 {
+			return fmt.Errorf("Lambda Alias (%s) has unexpected additional version weights: %v", rName, output.RoutingConfig.AdditionalVersionWeights)
+		}
+
+		return nil
+	}
+}
+
+func testAccShiftAliasTrafficActionConfig_function(rName, testData string, publish bool) string {
+	return acctest.ConfigCompose(
+		testAccInvokeActionConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  depends_on = [aws_iam_role_policy_attachment.test]
+
+  filename      = "test-fixtures/lambda_invocation.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.test.arn
+  handler       = "lambda_invocation.handler"
+  runtime       = "nodejs24.x"
+  publish       = %[3]t
+
+  environment {
+    variables = {
+      TEST_DATA = %[2]q
+    }
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "1"
+
+  lifecycle {
+    ignore_changes = [function_version]
+  }
+}
+`, rName, testData, publish))
+}
+
+func testAccShiftAliasTrafficActionConfig_basic(rName, testData string, publish bool) string {
+	return acctest.ConfigCompose(
+		testAccShiftAliasTrafficActionConfig_function(rName, testData, publish),
+		`
+action "aws_lambda_shift_alias_traffic" "test" {
+  config {
+    function_name   = aws_lambda_alias.test.function_name
+    alias_name      = aws_lambda_alias.test.name
+    step_percentage = 50
+    step_interval   = 5
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lambda_function.test.environment[0].variables["TEST_DATA"]
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.aws_lambda_shift_alias_traffic.test]
+    }
+  }
+}
+`)
+}
+
+func testAccShiftAliasTrafficActionConfig_alarm(rName, testData string, publish, alarming bool) string {
+	// Treating missing data as breaching forces the alarm into the ALARM state as soon as it is evaluated.
+	treatMissingData := "notBreaching"
+	if alarming {
+		treatMissingData = "breaching"
+	}
+
+	return acctest.ConfigCompose(
+		testAccShiftAliasTrafficActionConfig_function(rName, testData, publish),
+		fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Invocations"
+  namespace           = "AWS/Lambda"
+  period              = 60
+  statistic           = "Sum"
+  threshold           = 1000000
+  treat_missing_data  = %[2]q
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+  }
+}
+
+action "aws_lambda_shift_alias_traffic" "test" {
+  config {
+    function_name   = aws_lambda_alias.test.function_name
+    alias_name      = aws_lambda_alias.test.name
+    alarm_names     = [aws_cloudwatch_metric_alarm.test.alarm_name]
+    step_percentage = 50
+    step_interval   = 120
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_lambda_function.test.environment[0].variables["TEST_DATA"]
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.aws_lambda_shift_alias_traffic.test]
+    }
+  }
+}
+`, rName, treatMissingData))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
@@ -331,6 +332,47 @@ func testAccDatabase_masterPassword(t *testing.T, semaphore tfsync.Semaphore) {
 	})
 }
 
+func testAccDatabase_masterPasswordWriteOnly(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lightsail_database.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckLightsailSynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, strings.ToLower(lightsail.ServiceID))
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, strings.ToLower(lightsail.ServiceID)),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckDatabaseDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseConfig_masterPasswordWriteOnly(rName, "testpassword1", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, t, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password"),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccDatabaseConfig_masterPasswordWriteOnly(rName, "testpassword2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDatabaseExists(ctx, t, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password"),
+					resource.TestCheckNoResourceAttr(resourceName, "master_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDatabase_preferredBackupWindow(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
@@ -971,6 +1013,25 @@ resource "aws_lightsail_database" "test" {
 `, rName, masterPassword))
 }
 
+func testAccDatabaseConfig_masterPasswordWriteOnly(rName, masterPassword string, masterPasswordVersion int) string {
+	return acctest.ConfigCompose(
+		testAccDatabaseConfig_base(),
+		fmt.Sprintf(`
+resource "aws_lightsail_database" "test" {
+  relational_database_name   = %[1]q
+  availability_zone          = data.aws_availability_zones.available.names[0]
+  master_database_name       = "testdatabasename"
+  master_password_wo         = %[2]q
+  master_password_wo_version = %[3]d
+  master_username            = "testusername"
+  blueprint_id               = "mysql_8_0"
+  bundle_id                  = "micro_2_0"
+  apply_immediately          = true
+  skip_final_snapshot        = true
+}
+`, rName, masterPassword, masterPasswordVersion))
+}
+
 func testAccDatabaseConfig_preferredBackupWindow(rName, preferredBackupWindow string) string {
 	return acctest.ConfigCompose(
 		testAccDatabaseConfig_base(),
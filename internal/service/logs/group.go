@@ -31,6 +31,7 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
+// @ArnFormat("log-group:{name}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @IdentityAttribute("name")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types;awstypes;awstypes.LogGroup")
@@ -28,6 +28,7 @@ import (
 )
 
 // @SDKResource("aws_cloudwatch_log_stream", name="Stream")
+// @ArnFormat("log-group:{log_group_name}:log-stream:{name}", attribute="arn", resourceType="log-stream")
 // @IdentityAttribute("log_group_name")
 // @IdentityAttribute("name")
 // @ImportIDHandler("streamImportID")
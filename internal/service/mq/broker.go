@@ -8,6 +8,7 @@ package mq
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
@@ -24,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/mq/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -229,9 +231,23 @@ func resourceBroker() *schema.Resource {
 								Optional: true,
 							},
 							"service_account_password": {
-								Type:      schema.TypeString,
-								Optional:  true,
-								Sensitive: true,
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"ldap_server_metadata.0.service_account_password_wo"},
+							},
+							"service_account_password_wo": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								WriteOnly:     true,
+								Sensitive:     true,
+								ConflictsWith: []string{"ldap_server_metadata.0.service_account_password"},
+								RequiredWith:  []string{"ldap_server_metadata.0.service_account_password_wo_version"},
+							},
+							"service_account_password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"ldap_server_metadata.0.service_account_password_wo"},
 							},
 							"service_account_username": {
 								Type:     schema.TypeString,
@@ -383,9 +399,11 @@ func resourceBroker() *schema.Resource {
 									ValidateFunc: validation.StringLenBetween(2, 100),
 								},
 							},
+							// Set blocks cannot contain write-only attributes.
+							// The write-only counterpart is the top-level user_passwords_wo argument.
 							names.AttrPassword: {
 								Type:         schema.TypeString,
-								Required:     true,
+								Optional:     true,
 								Sensitive:    true,
 								ValidateFunc: validBrokerPassword,
 							},
@@ -402,6 +420,19 @@ func resourceBroker() *schema.Resource {
 						},
 					},
 				},
+				"user_passwords_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ValidateFunc: validation.StringIsJSON,
+					RequiredWith: []string{"user_passwords_wo_version"},
+				},
+				"user_passwords_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"user_passwords_wo"},
+				},
 			}
 		},
 
@@ -445,6 +476,22 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		Users:                   expandUsers(d.Get("user").(*schema.Set).List()),
 	}
 
+	// get write-only value from configuration
+	userPasswordsWO, di := getUserPasswordsWO(d)
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, user := range input.Users {
+		if v, ok := userPasswordsWO[aws.ToString(user.Username)]; ok {
+			input.Users[i].Password = aws.String(v)
+		}
+		if aws.ToString(input.Users[i].Password) == "" {
+			return sdkdiag.AppendErrorf(diags, "MQ Broker user (%s): one of password or user_passwords_wo is required", aws.ToString(user.Username))
+		}
+	}
+
 	if v, ok := d.GetOk("authentication_strategy"); ok {
 		input.AuthenticationStrategy = types.AuthenticationStrategy(v.(string))
 	}
@@ -465,6 +512,17 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	if v, ok := d.GetOk("ldap_server_metadata"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.LdapServerMetadata = expandLDAPServerMetadata(v.([]any))
+
+		// get write-only value from configuration
+		serviceAccountPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("ldap_server_metadata").IndexInt(0).GetAttr("service_account_password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if serviceAccountPasswordWO != "" {
+			input.LdapServerMetadata.ServiceAccountPassword = aws.String(serviceAccountPasswordWO)
+		}
 	}
 	if v, ok := d.GetOk("logs"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		input.Logs = expandLogs(engineType, v.([]any))
@@ -561,7 +619,11 @@ func resourceBrokerRead(ctx context.Context, d *schema.ResourceData, meta any) d
 	if v, ok := d.GetOk("ldap_server_metadata.0.service_account_password"); ok {
 		password = v.(string)
 	}
-	if err := d.Set("ldap_server_metadata", flattenLDAPServerMetadata(output.LdapServerMetadata, password)); err != nil {
+	ldapServerMetadata := flattenLDAPServerMetadata(output.LdapServerMetadata, password)
+	if v, ok := d.GetOk("ldap_server_metadata.0.service_account_password_wo_version"); ok && len(ldapServerMetadata) > 0 {
+		ldapServerMetadata[0].(map[string]any)["service_account_password_wo_version"] = v
+	}
+	if err := d.Set("ldap_server_metadata", ldapServerMetadata); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ldap_server_metadata: %s", err)
 	}
 	if err := d.Set("logs", flattenLogs(output.Logs)); err != nil {
@@ -655,13 +717,21 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		requiresReboot = true
 	}
 
-	if d.HasChange("user") {
+	if d.HasChanges("user", "user_passwords_wo_version") {
 		o, n := d.GetChange("user")
+
+		// get write-only value from configuration
+		userPasswordsWO, di := getUserPasswordsWO(d)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
 		var err error
 		// d.HasChange("user") always reports a change when running resourceBrokerUpdate
 		// updateBrokerUsers needs to be called to know if changes to user are actually made
 		var usersUpdated bool
-		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List())
+		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List(), userPasswordsWO, d.HasChange("user_passwords_wo_version"))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) users: %s", d.Id(), err)
@@ -998,11 +1068,37 @@ func resourceUserHash(v any) int {
 	return create.StringHashcode(buf.String())
 }
 
-func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers, newUsers []any) (bool, error) {
+// getUserPasswordsWO returns the user_passwords_wo write-only value from the configuration, a map of username to password.
+func getUserPasswordsWO(d *schema.ResourceData) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("user_passwords_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() || v == "" {
+		return nil, diags
+	}
+
+	passwords := make(map[string]string)
+	if err := json.Unmarshal([]byte(v), &passwords); err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "user_passwords_wo: must be a JSON object of usernames to passwords: %s", err)
+	}
+
+	for username, password := range passwords {
+		if _, errs := validBrokerPassword(password, fmt.Sprintf("user_passwords_wo[%q]", username)); len(errs) > 0 {
+			return nil, sdkdiag.AppendFromErr(diags, errors.Join(errs...))
+		}
+	}
+
+	return passwords, diags
+}
+
+// updateBrokerUsers creates, deletes and updates users.
+// Passwords in passwordsWO take precedence over user passwords. If rotatePasswordsWO is true, all users in passwordsWO are updated.
+func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers, newUsers []any, passwordsWO map[string]string, rotatePasswordsWO bool) (bool, error) {
 	// If there are any user creates/deletes/updates, updatedUsers will be set to true
 	updatedUsers := false
 
-	createL, deleteL, updateL, err := diffBrokerUsers(id, oldUsers, newUsers)
+	createL, deleteL, updateL, err := diffBrokerUsers(id, oldUsers, newUsers, passwordsWO, rotatePasswordsWO)
 	if err != nil {
 		return updatedUsers, err
 	}
@@ -1032,7 +1128,7 @@ func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers
 	return updatedUsers, nil
 }
 
-func diffBrokerUsers(bId string, oldUsers, newUsers []any) (cr []*mq.CreateUserInput, di []*mq.DeleteUserInput, ur []*mq.UpdateUserInput, e error) {
+func diffBrokerUsers(bId string, oldUsers, newUsers []any, passwordsWO map[string]string, rotatePasswordsWO bool) (cr []*mq.CreateUserInput, di []*mq.DeleteUserInput, ur []*mq.UpdateUserInput, e error) {
 	existingUsers := make(map[string]any)
 	for _, ou := range oldUsers {
 		u := ou.(map[string]any)
@@ -1068,18 +1164,27 @@ func diffBrokerUsers(bId string, oldUsers, newUsers []any) (cr []*mq.CreateUserI
 			newUserMap["groups"] = ng
 		}
 
+		password, _ := newUserMap[names.AttrPassword].(string)
+		passwordWO, hasPasswordWO := passwordsWO[username]
+		if hasPasswordWO {
+			password = passwordWO
+		}
+
 		if eu, ok := existingUsers[username]; ok {
 			existingUserMap := eu.(map[string]any)
 
-			if !reflect.DeepEqual(existingUserMap, newUserMap) {
-				ur = append(ur, &mq.UpdateUserInput{
+			if !reflect.DeepEqual(existingUserMap, newUserMap) || (hasPasswordWO && rotatePasswordsWO) {
+				uur := &mq.UpdateUserInput{
 					BrokerId:        aws.String(bId),
 					ConsoleAccess:   aws.Bool(newUserMap["console_access"].(bool)),
 					Groups:          flex.ExpandStringValueList(ng),
 					ReplicationUser: aws.Bool(newUserMap["replication_user"].(bool)),
-					Password:        aws.String(newUserMap[names.AttrPassword].(string)),
 					Username:        aws.String(username),
-				})
+				}
+				if password != "" {
+					uur.Password = aws.String(password)
+				}
+				ur = append(ur, uur)
 			}
 
 			// Delete after processing, so we know what's left for deletion
@@ -1088,7 +1193,7 @@ func diffBrokerUsers(bId string, oldUsers, newUsers []any) (cr []*mq.CreateUserI
 			cur := &mq.CreateUserInput{
 				BrokerId:        aws.String(bId),
 				ConsoleAccess:   aws.Bool(newUserMap["console_access"].(bool)),
-				Password:        aws.String(newUserMap[names.AttrPassword].(string)),
+				Password:        aws.String(password),
 				ReplicationUser: aws.Bool(newUserMap["replication_user"].(bool)),
 				Username:        aws.String(username),
 			}
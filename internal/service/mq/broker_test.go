@@ -117,8 +117,10 @@ func TestDiffUsers(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
-		OldUsers []any
-		NewUsers []any
+		OldUsers          []any
+		NewUsers          []any
+		PasswordsWO       map[string]string
+		RotatePasswordsWO bool
 
 		Creations []*mq.CreateUserInput
 		Deletions []*mq.DeleteUserInput
@@ -218,10 +220,59 @@ func TestDiffUsers(t *testing.T) {
 				},
 			},
 		},
+		{
+			OldUsers: []any{
+				map[string]any{
+					"console_access":   false,
+					names.AttrUsername: "first",
+					names.AttrPassword: "",
+					"replication_user": false,
+				},
+			},
+			NewUsers: []any{
+				map[string]any{
+					"console_access":   false,
+					names.AttrUsername: "first",
+					names.AttrPassword: "",
+					"replication_user": false,
+				},
+				map[string]any{
+					"console_access":   false,
+					names.AttrUsername: "second",
+					names.AttrPassword: "",
+					"replication_user": false,
+				},
+			},
+			PasswordsWO: map[string]string{
+				"first":  "TestTest1111rotated",
+				"second": "TestTest2222",
+			},
+			RotatePasswordsWO: true,
+			Creations: []*mq.CreateUserInput{
+				{
+					BrokerId:        aws.String("test"),
+					ConsoleAccess:   aws.Bool(false),
+					Username:        aws.String("second"),
+					Password:        aws.String("TestTest2222"),
+					ReplicationUser: aws.Bool(false),
+				},
+			},
+			Deletions: nil,
+			Updates: []*mq.UpdateUserInput{
+				{
+					BrokerId:        aws.String("test"),
+					ConsoleAccess:   aws.Bool(false),
+					Username:        aws.String("first"),
+					Password:        aws.String("TestTest1111rotated"),
+					Groups:          []string{},
+					ReplicationUser: aws.Bool(false),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
-		creations, deletions, updates, err := tfmq.DiffBrokerUsers("test", tc.OldUsers, tc.NewUsers)
+		creations, deletions, updates, err := tfmq.DiffBrokerUsers("test", tc.OldUsers, tc.NewUsers, tc.PasswordsWO, tc.RotatePasswordsWO)
 		if err != nil {
 			t.Fatal(err)
 		}
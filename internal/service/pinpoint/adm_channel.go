@@ -12,11 +12,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -46,9 +48,23 @@ func resourceADMChannel() *schema.Resource {
 					Sensitive: true,
 				},
 				names.AttrClientSecret: {
-					Type:      schema.TypeString,
-					Required:  true,
-					Sensitive: true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"client_secret", "client_secret_wo"},
+				},
+				"client_secret_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"client_secret", "client_secret_wo"},
+					RequiredWith: []string{"client_secret_wo_version"},
+				},
+				"client_secret_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"client_secret_wo"},
 				},
 				names.AttrEnabled: {
 					Type:     schema.TypeBool,
@@ -70,6 +86,17 @@ func resourceADMChannelUpsert(ctx context.Context, d *schema.ResourceData, meta
 
 	params.ClientId = aws.String(d.Get(names.AttrClientID).(string))
 	params.ClientSecret = aws.String(d.Get(names.AttrClientSecret).(string))
+
+	// get write-only value from configuration
+	clientSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("client_secret_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if clientSecretWO != "" {
+		params.ClientSecret = aws.String(clientSecretWO)
+	}
 	params.Enabled = aws.Bool(d.Get(names.AttrEnabled).(bool))
 
 	req := pinpoint.UpdateAdmChannelInput{
@@ -12,11 +12,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -60,9 +62,23 @@ func resourceAPNSChannel() *schema.Resource {
 					Default:  true,
 				},
 				names.AttrPrivateKey: {
-					Type:      schema.TypeString,
-					Optional:  true,
-					Sensitive: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"private_key_wo"},
+				},
+				"private_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"private_key"},
+					RequiredWith:  []string{"private_key_wo_version"},
+				},
+				"private_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"private_key_wo"},
 				},
 				"team_id": {
 					Type:      schema.TypeString,
@@ -70,9 +86,23 @@ func resourceAPNSChannel() *schema.Resource {
 					Sensitive: true,
 				},
 				"token_key": {
-					Type:      schema.TypeString,
-					Optional:  true,
-					Sensitive: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"token_key_wo"},
+				},
+				"token_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"token_key"},
+					RequiredWith:  []string{"token_key_wo_version"},
+				},
+				"token_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"token_key_wo"},
 				},
 				"token_key_id": {
 					Type:      schema.TypeString,
@@ -94,8 +124,29 @@ func resourceAPNSChannelUpsert(ctx context.Context, d *schema.ResourceData, meta
 	tokenKey, tokenKeyOk := d.GetOk("token_key")
 	tokenKeyId, tokenKeyIdOk := d.GetOk("token_key_id")
 
+	// get write-only values from configuration
+	privateKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("private_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if privateKeyWO != "" {
+		privateKey, privateKeyOk = privateKeyWO, true
+	}
+
+	tokenKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("token_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if tokenKeyWO != "" {
+		tokenKey, tokenKeyOk = tokenKeyWO, true
+	}
+
 	if !(certificateOk && privateKeyOk) && !(bundleIdOk && teamIdOk && tokenKeyOk && tokenKeyIdOk) {
-		return sdkdiag.AppendErrorf(diags, "At least one set of credentials is required; either [certificate, private_key] or [bundle_id, team_id, token_key, token_key_id]")
+		return sdkdiag.AppendErrorf(diags, "At least one set of credentials is required; either [certificate, private_key or private_key_wo] or [bundle_id, team_id, token_key or token_key_wo, token_key_id]")
 	}
 
 	conn := meta.(*conns.AWSClient).PinpointClient(ctx)
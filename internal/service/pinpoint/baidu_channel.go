@@ -12,11 +12,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -46,14 +48,42 @@ func resourceBaiduChannel() *schema.Resource {
 					Default:  true,
 				},
 				"api_key": {
-					Type:      schema.TypeString,
-					Required:  true,
-					Sensitive: true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"api_key", "api_key_wo"},
+				},
+				"api_key_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"api_key", "api_key_wo"},
+					RequiredWith: []string{"api_key_wo_version"},
+				},
+				"api_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"api_key_wo"},
 				},
 				names.AttrSecretKey: {
-					Type:      schema.TypeString,
-					Required:  true,
-					Sensitive: true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"secret_key", "secret_key_wo"},
+				},
+				"secret_key_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"secret_key", "secret_key_wo"},
+					RequiredWith: []string{"secret_key_wo_version"},
+				},
+				"secret_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"secret_key_wo"},
 				},
 			}
 		},
@@ -70,8 +100,30 @@ func resourceBaiduChannelUpsert(ctx context.Context, d *schema.ResourceData, met
 
 	params.Enabled = aws.Bool(d.Get(names.AttrEnabled).(bool))
 	params.ApiKey = aws.String(d.Get("api_key").(string))
+
+	// get write-only value from configuration
+	apiKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("api_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if apiKeyWO != "" {
+		params.ApiKey = aws.String(apiKeyWO)
+	}
 	params.SecretKey = aws.String(d.Get(names.AttrSecretKey).(string))
 
+	// get write-only value from configuration
+	secretKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("secret_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if secretKeyWO != "" {
+		params.SecretKey = aws.String(secretKeyWO)
+	}
+
 	req := pinpoint.UpdateBaiduChannelInput{
 		ApplicationId:       aws.String(applicationId),
 		BaiduChannelRequest: params,
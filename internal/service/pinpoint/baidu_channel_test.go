@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
@@ -60,6 +61,48 @@ func TestAccPinpointBaiduChannel_basic(t *testing.T) {
 	})
 }
 
+func TestAccPinpointBaiduChannel_writeOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.BaiduChannelResponse
+	resourceName := "aws_pinpoint_baidu_channel.channel"
+
+	apiKey := "123"
+	apikeyUpdated := "234"
+	secretKey := "456"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckBaiduChannelDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBaiduChannelConfig_writeOnly(apiKey, secretKey, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduChannelExists(ctx, t, resourceName, &channel),
+					resource.TestCheckNoResourceAttr(resourceName, "api_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "api_key_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_key_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccBaiduChannelConfig_writeOnly(apikeyUpdated, secretKey, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduChannelExists(ctx, t, resourceName, &channel),
+					resource.TestCheckNoResourceAttr(resourceName, "api_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "api_key_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBaiduChannelExists(ctx context.Context, t *testing.T, n string, channel *awstypes.BaiduChannelResponse) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -124,3 +167,19 @@ resource "aws_pinpoint_baidu_channel" "channel" {
 }
 `, apiKey, secretKey)
 }
+
+func testAccBaiduChannelConfig_writeOnly(apiKey, secretKey string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test_app" {}
+
+resource "aws_pinpoint_baidu_channel" "channel" {
+  application_id = aws_pinpoint_app.test_app.application_id
+
+  enabled               = "false"
+  api_key_wo            = %[1]q
+  api_key_wo_version    = %[3]d
+  secret_key_wo         = %[2]q
+  secret_key_wo_version = %[3]d
+}
+`, apiKey, secretKey, version)
+}
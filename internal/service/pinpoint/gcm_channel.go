@@ -12,12 +12,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/pinpoint"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -63,13 +65,39 @@ func resourceGCMChannel() *schema.Resource {
 					Type:         schema.TypeString,
 					Optional:     true,
 					Sensitive:    true,
-					ExactlyOneOf: []string{"api_key", "service_json"},
+					ExactlyOneOf: []string{"api_key", "api_key_wo", "service_json", "service_json_wo"},
+				},
+				"api_key_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"api_key", "api_key_wo", "service_json", "service_json_wo"},
+					RequiredWith: []string{"api_key_wo_version"},
+				},
+				"api_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"api_key_wo"},
 				},
 				"service_json": {
 					Type:         schema.TypeString,
 					Optional:     true,
 					Sensitive:    true,
-					ExactlyOneOf: []string{"api_key", "service_json"},
+					ExactlyOneOf: []string{"api_key", "api_key_wo", "service_json", "service_json_wo"},
+				},
+				"service_json_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"api_key", "api_key_wo", "service_json", "service_json_wo"},
+					RequiredWith: []string{"service_json_wo_version"},
+				},
+				"service_json_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"service_json_wo"},
 				},
 				names.AttrEnabled: {
 					Type:     schema.TypeBool,
@@ -93,9 +121,31 @@ func resourceGCMChannelUpsert(ctx context.Context, d *schema.ResourceData, meta
 	params.Enabled = aws.Bool(d.Get(names.AttrEnabled).(bool))
 	if d.Get("default_authentication_method") == defaultAuthenticationMethodKey {
 		params.ApiKey = aws.String(d.Get("api_key").(string))
+
+		// get write-only value from configuration
+		apiKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("api_key_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if apiKeyWO != "" {
+			params.ApiKey = aws.String(apiKeyWO)
+		}
 	}
 	if d.Get("default_authentication_method") == defaultAuthenticationMethodToken {
 		params.ServiceJson = aws.String(d.Get("service_json").(string))
+
+		// get write-only value from configuration
+		serviceJSONWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("service_json_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if serviceJSONWO != "" {
+			params.ServiceJson = aws.String(serviceJSONWO)
+		}
 	}
 
 	req := pinpoint.UpdateGcmChannelInput{
@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/pinpoint/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfpinpoint "github.com/hashicorp/terraform-provider-aws/internal/service/pinpoint"
@@ -105,6 +106,40 @@ func TestAccPinpointGCMChannel_apiKeyAuthMethod(t *testing.T) {
 	})
 }
 
+func TestAccPinpointGCMChannel_apiKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.GCMChannelResponse
+	resourceName := "aws_pinpoint_gcm_channel.test_gcm_channel"
+
+	if os.Getenv("GCM_API_KEY") == "" {
+		t.Skipf("GCM_API_KEY env missing, skip test")
+	}
+
+	apiKey := os.Getenv("GCM_API_KEY")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckApp(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PinpointServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckGCMChannelDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGCMChannelConfig_apiKeyWriteOnly(apiKey, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGCMChannelExists(ctx, t, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "default_authentication_method", tfpinpoint.DefaultAuthenticationMethodKey),
+					resource.TestCheckNoResourceAttr(resourceName, "api_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "api_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "api_key_wo_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPinpointGCMChannel_tokenAuthMethod(t *testing.T) {
 	ctx := acctest.Context(t)
 	var channel awstypes.GCMChannelResponse
@@ -233,3 +268,16 @@ resource "aws_pinpoint_gcm_channel" "test_gcm_channel" {
 }
 `, serviceJsonFile)
 }
+
+func testAccGCMChannelConfig_apiKeyWriteOnly(apiKey string, apiKeyVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test_app" {}
+
+resource "aws_pinpoint_gcm_channel" "test_gcm_channel" {
+  application_id     = aws_pinpoint_app.test_app.application_id
+  enabled            = "false"
+  api_key_wo         = %[1]q
+  api_key_wo_version = %[2]d
+}
+`, apiKey, apiKeyVersion)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/quicksight"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/quicksight/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	quicksightschema "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight/schema"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -115,6 +117,11 @@ func resourceDataSourceCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if v, ok := d.GetOk("credentials"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		diags = append(diags, expandDataSourceCredentialsWriteOnly(d, v.([]any))...)
+		if diags.HasError() {
+			return diags
+		}
+
 		input.Credentials = quicksightschema.ExpandDataSourceCredentials(v.([]any))
 	}
 
@@ -239,6 +246,11 @@ func resourceDataSourceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if v, ok := d.GetOk("credentials"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+			diags = append(diags, expandDataSourceCredentialsWriteOnly(d, v.([]any))...)
+			if diags.HasError() {
+				return diags
+			}
+
 			input.Credentials = quicksightschema.ExpandDataSourceCredentials(v.([]any))
 		}
 
@@ -484,3 +496,22 @@ func dataSourceError(apiObject *awstypes.DataSourceErrorInfo) error {
 
 	return fmt.Errorf("%s: %s", apiObject.Type, aws.ToString(apiObject.Message))
 }
+
+// expandDataSourceCredentialsWriteOnly copies the write-only credential pair password from
+// configuration into the credentials before they are expanded.
+func expandDataSourceCredentialsWriteOnly(d *schema.ResourceData, tfList []any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("credentials").IndexInt(0).GetAttr("credential_pair").IndexInt(0).GetAttr("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() || passwordWO == "" {
+		return diags
+	}
+
+	if v, ok := tfList[0].(map[string]any)["credential_pair"].([]any); ok && len(v) > 0 && v[0] != nil {
+		v[0].(map[string]any)[names.AttrPassword] = passwordWO
+	}
+
+	return diags
+}
@@ -34,12 +34,30 @@ func DataSourceCredentialsSchema() *schema.Schema {
 						Schema: map[string]*schema.Schema{
 							names.AttrPassword: {
 								Type:     schema.TypeString,
-								Required: true,
+								Optional: true,
 								ValidateFunc: validation.All(
 									validation.NoZeroValues,
 									validation.StringLenBetween(1, 1024),
 								),
+								Sensitive:    true,
+								ExactlyOneOf: []string{"credentials.0.credential_pair.0.password", "credentials.0.credential_pair.0.password_wo"},
+							},
+							"password_wo": {
+								Type:      schema.TypeString,
+								Optional:  true,
+								WriteOnly: true,
 								Sensitive: true,
+								ValidateFunc: validation.All(
+									validation.NoZeroValues,
+									validation.StringLenBetween(1, 1024),
+								),
+								ExactlyOneOf: []string{"credentials.0.credential_pair.0.password", "credentials.0.credential_pair.0.password_wo"},
+								RequiredWith: []string{"credentials.0.credential_pair.0.password_wo_version"},
+							},
+							"password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"credentials.0.credential_pair.0.password_wo"},
 							},
 							names.AttrUsername: {
 								Type:     schema.TypeString,
@@ -45,6 +45,7 @@ const (
 )
 
 // @SDKResource("aws_rds_cluster", name="Cluster")
+// @ArnFormat("cluster:{cluster_identifier}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @IdentityAttribute("cluster_identifier")
 // @CustomImport
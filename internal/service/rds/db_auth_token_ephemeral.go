@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// IAM database authentication tokens are valid for 15 minutes.
+	dbAuthTokenExpiration = 15 * time.Minute
+)
+
+// @EphemeralResource(aws_rds_db_auth_token, name="DB Auth Token")
+func newDBAuthTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &dbAuthTokenEphemeralResource{}, nil
+}
+
+type dbAuthTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[dbAuthTokenEphemeralResourceModel]
+}
+
+func (e *dbAuthTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrPort: schema.Int32Attribute{
+				Required: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrUsername: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (e *dbAuthTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	data := dbAuthTokenEphemeralResourceModel{}
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// See https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.html.
+	endpoint := net.JoinHostPort(data.Hostname.ValueString(), strconv.Itoa(int(data.Port.ValueInt32())))
+	now := time.Now().UTC()
+
+	token, err := auth.BuildAuthToken(ctx, endpoint, e.Meta().Region(ctx), data.Username.ValueString(), e.Meta().CredentialsProvider(ctx))
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, fmt.Errorf("building RDS IAM authentication token: %w", err))
+		return
+	}
+
+	expiresAt := now.Add(dbAuthTokenExpiration)
+	data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	data.Token = fwflex.StringValueToFramework(ctx, token)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type dbAuthTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Hostname  types.String `tfsdk:"hostname"`
+	Port      types.Int32  `tfsdk:"port"`
+	Token     types.String `tfsdk:"token"`
+	Username  types.String `tfsdk:"username"`
+}
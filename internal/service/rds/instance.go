@@ -55,6 +55,7 @@ import (
 //    - called "identifier" in the schema/state (previously was also "id")
 
 // @SDKResource("aws_db_instance", name="DB Instance")
+// @ArnFormat("db:{identifier}", attribute="arn")
 // @Tags(identifierAttribute="arn")
 // @IdentityAttribute("identifier")
 // @MutableIdentity
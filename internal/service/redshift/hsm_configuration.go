@@ -12,11 +12,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -63,10 +65,25 @@ func resourceHSMConfiguration() *schema.Resource {
 					ForceNew: true,
 				},
 				"hsm_partition_password": {
-					Type:      schema.TypeString,
-					Required:  true,
-					ForceNew:  true,
-					Sensitive: true,
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"hsm_partition_password", "hsm_partition_password_wo"},
+				},
+				"hsm_partition_password_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"hsm_partition_password", "hsm_partition_password_wo"},
+					RequiredWith: []string{"hsm_partition_password_wo_version"},
+				},
+				"hsm_partition_password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{"hsm_partition_password_wo"},
 				},
 				"hsm_server_public_certificate": {
 					Type:     schema.TypeString,
@@ -90,11 +107,25 @@ func resourceHSMConfigurationCreate(ctx context.Context, d *schema.ResourceData,
 		HsmConfigurationIdentifier: aws.String(hsmConfigurationID),
 		HsmIpAddress:               aws.String(d.Get("hsm_ip_address").(string)),
 		HsmPartitionName:           aws.String(d.Get("hsm_partition_name").(string)),
-		HsmPartitionPassword:       aws.String(d.Get("hsm_partition_password").(string)),
 		HsmServerPublicCertificate: aws.String(d.Get("hsm_server_public_certificate").(string)),
 		Tags:                       getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("hsm_partition_password"); ok {
+		input.HsmPartitionPassword = aws.String(v.(string))
+	}
+
+	// get write-only value from configuration
+	hsmPartitionPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("hsm_partition_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if hsmPartitionPasswordWO != "" {
+		input.HsmPartitionPassword = aws.String(hsmPartitionPasswordWO)
+	}
+
 	output, err := conn.CreateHsmConfiguration(ctx, &input)
 
 	if err != nil {
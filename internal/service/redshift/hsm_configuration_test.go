@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
@@ -79,6 +80,45 @@ func TestAccRedshiftHSMConfiguration_disappears(t *testing.T) {
 	})
 }
 
+func TestAccRedshiftHSMConfiguration_hsmPartitionPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_redshift_hsm_configuration.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckHSMConfigurationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHSMConfigurationConfig_hsmPartitionPasswordWriteOnly(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHSMConfigurationExists(ctx, t, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "hsm_partition_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "hsm_partition_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccHSMConfigurationConfig_hsmPartitionPasswordWriteOnly(rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHSMConfigurationExists(ctx, t, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "hsm_partition_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "hsm_partition_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckHSMConfigurationDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).RedshiftClient(ctx)
@@ -136,3 +176,17 @@ resource "aws_redshift_hsm_configuration" "test" {
 }
 `, rName)
 }
+
+func testAccHSMConfigurationConfig_hsmPartitionPasswordWriteOnly(rName string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_redshift_hsm_configuration" "test" {
+  description                       = %[1]q
+  hsm_configuration_identifier      = %[1]q
+  hsm_ip_address                    = "10.0.0.1"
+  hsm_partition_name                = "aws"
+  hsm_partition_password_wo         = %[1]q
+  hsm_partition_password_wo_version = %[2]d
+  hsm_server_public_certificate     = %[1]q
+}
+`, rName, version)
+}
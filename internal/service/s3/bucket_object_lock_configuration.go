@@ -14,12 +14,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -94,9 +96,23 @@ func resourceBucketObjectLockConfiguration() *schema.Resource {
 					},
 				},
 				"token": {
-					Type:      schema.TypeString,
-					Optional:  true,
-					Sensitive: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"token_wo"},
+				},
+				"token_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"token"},
+					RequiredWith:  []string{"token_wo_version"},
+				},
+				"token_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"token_wo"},
 				},
 			}
 		},
@@ -133,6 +149,17 @@ func resourceBucketObjectLockConfigurationCreate(ctx context.Context, d *schema.
 		input.Token = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	tokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("token_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if tokenWO != "" {
+		input.Token = aws.String(tokenWO)
+	}
+
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func(ctx context.Context) (any, error) {
 		return conn.PutObjectLockConfiguration(ctx, &input)
 	}, errCodeNoSuchBucket)
@@ -227,6 +254,17 @@ func resourceBucketObjectLockConfigurationUpdate(ctx context.Context, d *schema.
 		input.Token = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	tokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("token_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if tokenWO != "" {
+		input.Token = aws.String(tokenWO)
+	}
+
 	_, err = conn.PutObjectLockConfiguration(ctx, &input)
 
 	if err != nil {
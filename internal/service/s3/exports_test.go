@@ -63,6 +63,7 @@ var (
 	ObjectListTags                              = objectListTags
 	ObjectUpdateTags                            = objectUpdateTags
 	SDKv1CompatibleCleanKey                     = sdkv1CompatibleCleanKey
+	SyncFileChecksums                           = syncFileChecksums
 	ValidBucketName                             = validBucketName
 
 	BucketPropagationTimeout       = bucketPropagationTimeout
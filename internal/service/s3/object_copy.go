@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -147,9 +148,23 @@ func resourceObjectCopy() *schema.Resource {
 					Computed: true,
 				},
 				"customer_key": {
-					Type:      schema.TypeString,
-					Optional:  true,
-					Sensitive: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"customer_key_wo"},
+				},
+				"customer_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"customer_key"},
+					RequiredWith:  []string{"customer_key_wo_version"},
+				},
+				"customer_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"customer_key_wo"},
 				},
 				"customer_key_md5": {
 					Type:     schema.TypeString,
@@ -327,9 +342,23 @@ func resourceObjectCopy() *schema.Resource {
 					Optional: true,
 				},
 				"source_customer_key": {
-					Type:      schema.TypeString,
-					Optional:  true,
-					Sensitive: true,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"source_customer_key_wo"},
+				},
+				"source_customer_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"source_customer_key"},
+					RequiredWith:  []string{"source_customer_key_wo_version"},
+				},
+				"source_customer_key_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"source_customer_key_wo"},
 				},
 				"source_customer_key_md5": {
 					Type:     schema.TypeString,
@@ -468,6 +497,7 @@ func resourceObjectCopyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		"customer_algorithm",
 		"customer_key",
 		"customer_key_md5",
+		"customer_key_wo_version",
 		names.AttrExpectedBucketOwner,
 		"expected_source_bucket_owner",
 		"expires",
@@ -486,6 +516,7 @@ func resourceObjectCopyUpdate(ctx context.Context, d *schema.ResourceData, meta
 		"source_customer_algorithm",
 		"source_customer_key",
 		"source_customer_key_md5",
+		"source_customer_key_wo_version",
 		names.AttrStorageClass,
 		"tagging_directive",
 		"website_redirect",
@@ -604,6 +635,17 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 		input.SSECustomerKey = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	customerKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("customer_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if customerKeyWO != "" {
+		input.SSECustomerKey = aws.String(customerKeyWO)
+	}
+
 	if v, ok := d.GetOk("customer_key_md5"); ok {
 		input.SSECustomerKeyMD5 = aws.String(v.(string))
 	}
@@ -674,6 +716,17 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 		input.CopySourceSSECustomerKey = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	sourceCustomerKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("source_customer_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if sourceCustomerKeyWO != "" {
+		input.CopySourceSSECustomerKey = aws.String(sourceCustomerKeyWO)
+	}
+
 	if v, ok := d.GetOk("source_customer_key_md5"); ok {
 		input.CopySourceSSECustomerKeyMD5 = aws.String(v.(string))
 	}
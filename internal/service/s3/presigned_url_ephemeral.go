@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	presignedURLDefaultExpiresIn = 900 // 15 minutes.
+	presignedURLMaxExpiresIn     = 604800
+)
+
+// @EphemeralResource(aws_s3_presigned_url, name="Presigned URL")
+func newPresignedURLEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &presignedURLEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithValidateConfig = (*presignedURLEphemeralResource)(nil)
+)
+
+type presignedURLEphemeralResource struct {
+	framework.EphemeralResourceWithModel[presignedURLEphemeralResourceModel]
+}
+
+func (e *presignedURLEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"checksum": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("checksum_algorithm")),
+				},
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ChecksumAlgorithm](),
+				Optional:   true,
+			},
+			names.AttrContentType: schema.StringAttribute{
+				Optional: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"expires_in": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, presignedURLMaxExpiresIn),
+				},
+			},
+			names.AttrKey: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodGet, http.MethodPut),
+				},
+			},
+			"server_side_encryption": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ServerSideEncryption](),
+				Optional:   true,
+			},
+			"signed_headers": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Computed:   true,
+			},
+			"sse_kms_key_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("server_side_encryption")),
+				},
+			},
+			names.AttrURL: schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"version_id": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *presignedURLEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	var data presignedURLEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() || data.Method.IsUnknown() {
+		return
+	}
+
+	switch data.Method.ValueString() {
+	case http.MethodPut:
+		if !data.VersionID.IsNull() {
+			response.Diagnostics.AddAttributeError(path.Root("version_id"), "Invalid Attribute Combination", `"version_id" can only be set when "method" is "GET".`)
+		}
+	default:
+		for _, v := range []struct {
+			name  string
+			value interface{ IsNull() bool }
+		}{
+			{"checksum", data.Checksum},
+			{"checksum_algorithm", data.ChecksumAlgorithm},
+			{names.AttrContentType, data.ContentType},
+			{"server_side_encryption", data.ServerSideEncryption},
+			{"sse_kms_key_id", data.SSEKMSKeyID},
+		} {
+			if !v.value.IsNull() {
+				response.Diagnostics.AddAttributeError(path.Root(v.name), "Invalid Attribute Combination", fmt.Sprintf("%q can only be set when \"method\" is \"PUT\".", v.name))
+			}
+		}
+	}
+}
+
+func (e *presignedURLEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data presignedURLEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().S3Client(ctx)
+	bucket := data.Bucket.ValueString()
+	if isDirectoryBucket(bucket) {
+		conn = e.Meta().S3ExpressClient(ctx)
+	}
+
+	expiresIn := time.Duration(presignedURLDefaultExpiresIn) * time.Second
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt32()) * time.Second
+	}
+	optFn := s3.WithPresignExpires(expiresIn)
+	now := time.Now().UTC()
+
+	presignClient := s3.NewPresignClient(conn)
+	var (
+		output *v4.PresignedHTTPRequest
+		err    error
+	)
+	switch data.Method.ValueString() {
+	case http.MethodPut:
+		input := s3.PutObjectInput{
+			Bucket:      aws.String(bucket),
+			ContentType: fwflex.StringFromFramework(ctx, data.ContentType),
+			Key:         fwflex.StringFromFramework(ctx, data.Key),
+		}
+		if v := data.ServerSideEncryption.ValueEnum(); v != "" {
+			input.ServerSideEncryption = v
+			input.SSEKMSKeyId = fwflex.StringFromFramework(ctx, data.SSEKMSKeyID)
+		}
+		if v := data.ChecksumAlgorithm.ValueEnum(); v != "" {
+			input.ChecksumAlgorithm = v
+			if err := expandPresignedURLChecksum(&input, v, fwflex.StringFromFramework(ctx, data.Checksum)); err != nil {
+				smerr.AddError(ctx, &response.Diagnostics, err)
+				return
+			}
+		}
+
+		output, err = presignClient.PresignPutObject(ctx, &input, optFn)
+	default:
+		input := s3.GetObjectInput{
+			Bucket:    aws.String(bucket),
+			Key:       fwflex.StringFromFramework(ctx, data.Key),
+			VersionId: fwflex.StringFromFramework(ctx, data.VersionID),
+		}
+
+		output, err = presignClient.PresignGetObject(ctx, &input, optFn)
+	}
+
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, bucket+"/"+data.Key.ValueString())
+		return
+	}
+
+	expiresAt := now.Add(expiresIn)
+	data.ExpiresAt = types.StringValue(expiresAt.Format(time.RFC3339))
+	data.SignedHeaders = fwflex.FlattenFrameworkStringValueMapOfString(ctx, flattenSignedHeaders(output.SignedHeader))
+	data.URL = fwflex.StringValueToFramework(ctx, output.URL)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+func expandPresignedURLChecksum(input *s3.PutObjectInput, algorithm awstypes.ChecksumAlgorithm, checksum *string) error {
+	if checksum == nil {
+		return nil
+	}
+
+	switch algorithm {
+	case awstypes.ChecksumAlgorithmCrc32:
+		input.ChecksumCRC32 = checksum
+	case awstypes.ChecksumAlgorithmCrc32c:
+		input.ChecksumCRC32C = checksum
+	case awstypes.ChecksumAlgorithmCrc64nvme:
+		input.ChecksumCRC64NVME = checksum
+	case awstypes.ChecksumAlgorithmSha1:
+		input.ChecksumSHA1 = checksum
+	case awstypes.ChecksumAlgorithmSha256:
+		input.ChecksumSHA256 = checksum
+	default:
+		return fmt.Errorf("unsupported checksum algorithm: %s", algorithm)
+	}
+
+	return nil
+}
+
+// flattenSignedHeaders returns the headers, other than Host, that a client must send with a presigned request.
+func flattenSignedHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for k, v := range header {
+		if strings.EqualFold(k, "Host") {
+			continue
+		}
+		headers[k] = strings.Join(v, ",")
+	}
+
+	return headers
+}
+
+type presignedURLEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Bucket               types.String                                      `tfsdk:"bucket"`
+	Checksum             types.String                                      `tfsdk:"checksum"`
+	ChecksumAlgorithm    fwtypes.StringEnum[awstypes.ChecksumAlgorithm]    `tfsdk:"checksum_algorithm"`
+	ContentType          types.String                                      `tfsdk:"content_type"`
+	ExpiresAt            types.String                                      `tfsdk:"expires_at"`
+	ExpiresIn            types.Int32                                       `tfsdk:"expires_in"`
+	Key                  types.String                                      `tfsdk:"key"`
+	Method               types.String                                      `tfsdk:"method"`
+	ServerSideEncryption fwtypes.StringEnum[awstypes.ServerSideEncryption] `tfsdk:"server_side_encryption"`
+	SignedHeaders        fwtypes.MapOfString                               `tfsdk:"signed_headers"`
+	SSEKMSKeyID          types.String                                      `tfsdk:"sse_kms_key_id"`
+	URL                  types.String                                      `tfsdk:"url"`
+	VersionID            types.String                                      `tfsdk:"version_id"`
+}
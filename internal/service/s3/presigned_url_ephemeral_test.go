@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3PresignedURLEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.S3ServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLEphemeralConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.StringRegexp(regexache.MustCompile(`/test-key\?.*X-Amz-Expires=300&.*X-Amz-Signature=`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3PresignedURLEphemeral_put(t *testing.T) {
+	ctx := acctest.Context(t)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.S3ServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresignedURLEphemeralConfig_put(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("signed_headers").AtMapKey("X-Amz-Server-Side-Encryption"), knownvalue.StringExact("AES256")),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey(names.AttrURL), knownvalue.StringRegexp(regexache.MustCompile(`/upload/artifact\.tar\.gz\?.*X-Amz-Signature=`))),
+				},
+			},
+		},
+	})
+}
+
+func testAccPresignedURLEphemeralConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccObjectConfig_content(rName, "test"),
+		acctest.ConfigWithEchoProvider("ephemeral.aws_s3_presigned_url.test"),
+		`
+ephemeral "aws_s3_presigned_url" "test" {
+  bucket     = aws_s3_object.object.bucket
+  key        = aws_s3_object.object.key
+  expires_in = 300
+}
+`)
+}
+
+func testAccPresignedURLEphemeralConfig_put(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_s3_presigned_url.test"),
+		fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+ephemeral "aws_s3_presigned_url" "test" {
+  bucket                 = aws_s3_bucket.test.bucket
+  key                    = "upload/artifact.tar.gz"
+  method                 = "PUT"
+  content_type           = "application/gzip"
+  server_side_encryption = "AES256"
+}
+`, rName))
+}
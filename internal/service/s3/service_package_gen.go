@@ -22,6 +22,28 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newSyncAction,
+			TypeName: "aws_s3_sync",
+			Name:     "Sync",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
+func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
+	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newPresignedURLEphemeralResource,
+			TypeName: "aws_s3_presigned_url",
+			Name:     "Presigned URL",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
 		{
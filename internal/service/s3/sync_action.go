@@ -0,0 +1,508 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	syncDefaultConcurrency = 10
+	syncDefaultContentType = "application/octet-stream"
+	syncProgressInterval   = 30 * time.Second
+	// syncDeleteBatchSize is the maximum number of keys accepted by a single DeleteObjects call.
+	syncDeleteBatchSize = 1000
+)
+
+// @Action(aws_s3_sync, name="Sync")
+func newSyncAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a syncAction
+	a.SetDefaultInvokeTimeout(60 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action = (*syncAction)(nil)
+)
+
+type syncAction struct {
+	framework.ActionWithModel[syncActionModel]
+	framework.ActionWithTimeouts
+}
+
+type syncActionModel struct {
+	framework.WithRegionModel
+	Bucket       types.String         `tfsdk:"bucket"`
+	CacheControl types.String         `tfsdk:"cache_control"`
+	Concurrency  types.Int64          `tfsdk:"concurrency"`
+	Delete       types.Bool           `tfsdk:"delete"`
+	Exclude      fwtypes.ListOfString `tfsdk:"exclude"`
+	KeyPrefix    types.String         `tfsdk:"key_prefix"`
+	Source       types.String         `tfsdk:"source"`
+	Timeouts     timeouts.Value       `tfsdk:"timeouts"`
+}
+
+func (a *syncAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads the contents of a local directory to an S3 bucket, only transferring files whose size or SHA-256 checksum has changed and optionally deleting objects that no longer exist locally.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Description: "Name of the bucket to upload to.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cache_control": schema.StringAttribute{
+				Description: "Caching behavior set on every uploaded object.",
+				Optional:    true,
+			},
+			"concurrency": schema.Int64Attribute{
+				Description: "Maximum number of files compared or uploaded in parallel. Defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"delete": schema.BoolAttribute{
+				Description: "Delete objects under the key prefix that do not exist in the source directory.",
+				Optional:    true,
+			},
+			"exclude": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Description: "Glob patterns, relative to the source directory, of files to skip. Excluded keys are never deleted.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+			},
+			"key_prefix": schema.StringAttribute{
+				Description: "Key prefix under which files are uploaded.",
+				Optional:    true,
+			},
+			names.AttrSource: schema.StringAttribute{
+				Description: "Path to the local directory to upload.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *syncAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config syncActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	bucket := fwflex.StringValueFromFramework(ctx, config.Bucket)
+	prefix := strings.TrimSuffix(sdkv1CompatibleCleanKey(fwflex.StringValueFromFramework(ctx, config.KeyPrefix)), "/")
+	exclude := fwflex.ExpandFrameworkStringValueList(ctx, config.Exclude)
+	concurrency := syncDefaultConcurrency
+	if !config.Concurrency.IsNull() {
+		concurrency = int(config.Concurrency.ValueInt64())
+	}
+
+	// Get AWS client
+	conn := a.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = a.Meta().S3ExpressClient(ctx)
+	}
+
+	ctx = tflog.SetField(ctx, names.AttrBucket, bucket)
+	ctx = tflog.SetField(ctx, "key_prefix", prefix)
+
+	source, err := homedir.Expand(fwflex.StringValueFromFramework(ctx, config.Source))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Source Directory",
+			fmt.Sprintf("Could not expand home directory in source (%s): %s", config.Source.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Starting S3 sync action", map[string]any{
+		names.AttrSource:  source,
+		"concurrency":     concurrency,
+		"delete":          config.Delete.ValueBool(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Comparing %s with s3://%s/%s...", source, bucket, prefix)
+
+	uploader := manager.NewUploader(conn)
+
+	local, err := findSyncLocalFiles(source, prefix, exclude, uploader.PartSize)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Source Directory",
+			fmt.Sprintf("Could not read source directory %s: %s", source, err),
+		)
+		return
+	}
+
+	remote, err := findSyncRemoteSizes(ctx, conn, bucket, prefix)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Objects",
+			fmt.Sprintf("Could not list objects in S3 bucket %s: %s", bucket, err),
+		)
+		return
+	}
+
+	toUpload, err := findSyncChangedKeys(ctx, conn, bucket, local, remote, concurrency)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Compare Objects",
+			fmt.Sprintf("Could not compare files with objects in S3 bucket %s: %s", bucket, err),
+		)
+		return
+	}
+
+	var toDelete []awstypes.ObjectIdentifier
+	if config.Delete.ValueBool() {
+		for key := range remote {
+			if _, ok := local[key]; ok || syncExcluded(strings.TrimPrefix(key, syncListPrefix(prefix)), exclude) {
+				continue
+			}
+			toDelete = append(toDelete, awstypes.ObjectIdentifier{Key: aws.String(key)})
+		}
+		slices.SortFunc(toDelete, func(a, b awstypes.ObjectIdentifier) int {
+			return strings.Compare(aws.ToString(a.Key), aws.ToString(b.Key))
+		})
+	}
+
+	cb(ctx, "Found %d local files: %d to upload, %d unchanged, %d to delete", len(local), len(toUpload), len(local)-len(toUpload), len(toDelete))
+
+	if len(toUpload) > 0 {
+		if err := syncUploadFiles(ctx, cb, uploader, bucket, local, toUpload, config.CacheControl.ValueString(), concurrency); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Upload Objects",
+				fmt.Sprintf("Could not upload files to S3 bucket %s: %s", bucket, err),
+			)
+			return
+		}
+	}
+
+	for chunk := range slices.Chunk(toDelete, syncDeleteBatchSize) {
+		if _, err := deletePage(ctx, conn, bucket, false, chunk); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Delete Objects",
+				fmt.Sprintf("Could not delete extraneous objects from S3 bucket %s: %s", bucket, err),
+			)
+			return
+		}
+	}
+
+	// Final success message
+	cb(ctx, "Sync to s3://%s/%s completed: %d uploaded, %d deleted", bucket, prefix, len(toUpload), len(toDelete))
+
+	tflog.Info(ctx, "S3 sync action completed successfully", map[string]any{
+		"uploaded": len(toUpload),
+		"deleted":  len(toDelete),
+	})
+}
+
+// syncLocalFile is a file to sync.
+// Files are compared with objects by size and SHA-256 checksum, as ETags are not content digests
+// for objects encrypted with SSE-KMS or SSE-C or stored in directory buckets.
+type syncLocalFile struct {
+	path string
+	size int64
+	// sha256 is the base64 encoded SHA-256 checksum of the file, which is the checksum of an object uploaded in a single part.
+	sha256 string
+	// multipartSHA256 is the composite SHA-256 checksum of the object when uploaded in parts, or empty if it fits in a single part.
+	multipartSHA256 string
+}
+
+func (f syncLocalFile) matches(checksum string) bool {
+	return checksum == f.sha256 || (f.multipartSHA256 != "" && checksum == f.multipartSHA256)
+}
+
+func syncListPrefix(prefix string) string {
+	if prefix == "" {
+		return ""
+	}
+
+	return prefix + "/"
+}
+
+func syncExcluded(name string, patterns []string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		matched, _ := path.Match(pattern, name)
+		return matched
+	})
+}
+
+// findSyncLocalFiles walks the source directory and returns the files to sync, keyed by object key.
+func findSyncLocalFiles(source, prefix string, exclude []string, partSize int64) (map[string]syncLocalFile, error) {
+	files := make(map[string]syncLocalFile)
+
+	err := filepath.WalkDir(source, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		// Follow symbolic links to regular files.
+		info, err := os.Stat(p)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if syncExcluded(rel, exclude) {
+			return nil
+		}
+
+		sum, multipartSum, err := syncFileChecksums(p, partSize)
+		if err != nil {
+			return err
+		}
+
+		files[syncListPrefix(prefix)+rel] = syncLocalFile{
+			path:            p,
+			size:            info.Size(),
+			sha256:          sum,
+			multipartSHA256: multipartSum,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// syncFileChecksums returns the SHA-256 checksums S3 records for a file uploaded in a single part and,
+// if the file is larger than partSize, for the file uploaded in parts of partSize.
+func syncFileChecksums(name string, partSize int64) (string, string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", "", err
+	}
+	defer f.Close()
+
+	whole := sha256.New()
+	var partSums []byte
+	var nParts int
+
+	for {
+		part := sha256.New()
+		n, err := io.CopyN(io.MultiWriter(whole, part), f, partSize)
+		if n > 0 {
+			partSums = part.Sum(partSums)
+			nParts++
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("reading %s: %w", name, err)
+		}
+	}
+
+	var multipartSum string
+	if nParts > 1 {
+		sum := sha256.Sum256(partSums)
+		multipartSum = fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(sum[:]), nParts)
+	}
+
+	return base64.StdEncoding.EncodeToString(whole.Sum(nil)), multipartSum, nil
+}
+
+// findSyncRemoteSizes returns the sizes of all objects under the key prefix, keyed by object key.
+func findSyncRemoteSizes(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]int64, error) {
+	input := s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(syncListPrefix(prefix))
+	}
+
+	sizes := make(map[string]int64)
+	for item, err := range listObjects(ctx, conn, &input) {
+		if err != nil {
+			return nil, err
+		}
+
+		sizes[aws.ToString(item.Key)] = aws.ToInt64(item.Size)
+	}
+
+	return sizes, nil
+}
+
+// findSyncChangedKeys returns the sorted keys of the local files that are missing from the bucket or differ from their object.
+// Objects of the same size are compared by their SHA-256 checksum. Objects without one, for example uploaded by another tool, are treated as changed.
+func findSyncChangedKeys(ctx context.Context, conn *s3.Client, bucket string, local map[string]syncLocalFile, remote map[string]int64, concurrency int) ([]string, error) {
+	var (
+		mutex   sync.Mutex
+		changed []string
+	)
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	for key, file := range local {
+		if size, ok := remote[key]; !ok || size != file.size {
+			changed = append(changed, key)
+			continue
+		}
+
+		g.Go(func() error {
+			output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", string(awstypes.ChecksumAlgorithmSha256))
+
+			if retry.NotFound(err) {
+				err = nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("reading %s: %w", key, err)
+			}
+
+			if output == nil || !file.matches(aws.ToString(output.ChecksumSHA256)) {
+				mutex.Lock()
+				changed = append(changed, key)
+				mutex.Unlock()
+			}
+
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	slices.Sort(changed)
+
+	return changed, nil
+}
+
+func syncUploadFiles(ctx context.Context, cb fwactions.SendProgressFunc, uploader *manager.Uploader, bucket string, files map[string]syncLocalFile, keys []string, cacheControl string, concurrency int) error {
+	var (
+		mutex      sync.Mutex
+		nUploaded  int
+		lastReport = time.Now()
+	)
+	// The first failed upload cancels the remaining uploads.
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(concurrency)
+
+	for _, key := range keys {
+		g.Go(func() error {
+			if err := syncUploadFile(ctx, uploader, bucket, key, files[key].path, cacheControl); err != nil {
+				return fmt.Errorf("uploading %s: %w", key, err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			nUploaded++
+			if time.Since(lastReport) >= syncProgressInterval {
+				lastReport = time.Now()
+				cb(ctx, "Uploaded %d of %d files...", nUploaded, len(keys))
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
+func syncUploadFile(ctx context.Context, uploader *manager.Uploader, bucket, key, name, cacheControl string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = syncDefaultContentType
+	}
+
+	input := s3.PutObjectInput{
+		Body:              f,
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: awstypes.ChecksumAlgorithmSha256,
+		ContentType:       aws.String(contentType),
+		Key:               aws.String(key),
+	}
+	if cacheControl != "" {
+		input.CacheControl = aws.String(cacheControl)
+	}
+
+	_, err = uploader.Upload(ctx, &input)
+
+	return err
+}
@@ -0,0 +1,271 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSyncFileChecksums(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content             string
+		partSize            int64
+		wantSHA256          string
+		wantMultipartSHA256 string
+	}{
+		"empty": {
+			content:    "",
+			partSize:   5,
+			wantSHA256: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+		},
+		"single part": {
+			content:    "hello",
+			partSize:   5,
+			wantSHA256: "LPJNul+wow4m6DsqxbninhsWHlwfp0JecwQzYpOLmCQ=",
+		},
+		"multipart": {
+			content:             "helloworld",
+			partSize:            5,
+			wantSHA256:          "k2oYXKqiZrucvpgengXLeM1zKwsygOuURBK7b4+PB68=",
+			wantMultipartSHA256: "cwXbmyq8zXBsJW2z2X5f9I1nfP5NOlkEr7faDjlQ4eI=-2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			name := filepath.Join(t.TempDir(), "file")
+			if err := os.WriteFile(name, []byte(testCase.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			gotSHA256, gotMultipartSHA256, err := tfs3.SyncFileChecksums(name, testCase.partSize)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotSHA256 != testCase.wantSHA256 {
+				t.Errorf("SHA-256 = %s, want %s", gotSHA256, testCase.wantSHA256)
+			}
+			if gotMultipartSHA256 != testCase.wantMultipartSHA256 {
+				t.Errorf("multipart SHA-256 = %s, want %s", gotMultipartSHA256, testCase.wantMultipartSHA256)
+			}
+		})
+	}
+}
+
+func TestAccS3SyncAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	source := testAccSyncActionSource(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/style.css": "body {}",
+		"data.unknown":  "data",
+	})
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckBucketDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncActionConfig_basic(rName, source, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSyncActionObjects(ctx, t, rName, "site", map[string]string{
+						"site/css/style.css": "text/css; charset=utf-8",
+						"site/data.unknown":  "application/octet-stream",
+						"site/index.html":    "text/html; charset=utf-8",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3SyncAction_delete(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	source := testAccSyncActionSource(t, map[string]string{
+		"a.txt":       "a",
+		"b.txt":       "b",
+		"keep/c.txt":  "c",
+		"skip/d.json": "{}",
+	})
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckBucketDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSyncActionConfig_delete(rName, source, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSyncActionObjects(ctx, t, rName, "", map[string]string{
+						"a.txt":      "text/plain; charset=utf-8",
+						"b.txt":      "text/plain; charset=utf-8",
+						"keep/c.txt": "text/plain; charset=utf-8",
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "b.txt")); err != nil {
+						t.Fatal(err)
+					}
+					if err := os.WriteFile(filepath.Join(source, "a.txt"), []byte("changed"), 0600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccSyncActionConfig_delete(rName, source, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSyncActionObjects(ctx, t, rName, "", map[string]string{
+						"a.txt":      "text/plain; charset=utf-8",
+						"keep/c.txt": "text/plain; charset=utf-8",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccSyncActionSource(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		name := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+// testAccCheckSyncActionObjects verifies that the objects under the prefix are exactly the expected keys with the expected content types
+func testAccCheckSyncActionObjects(ctx context.Context, t *testing.T, bucket, prefix string, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).S3Client(ctx)
+
+		input := s3.ListObjectsV2Input{
+			Bucket: aws.String(bucket),
+			Prefix: aws.String(prefix),
+		}
+		var got []string
+		pages := s3.NewListObjectsV2Paginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, v := range page.Contents {
+				got = append(got, aws.ToString(v.Key))
+			}
+		}
+
+		if wantKeys := slices.Sorted(maps.Keys(want)); !slices.Equal(got, wantKeys) {
+			return fmt.Errorf("S3 Bucket (%s) objects = %s, expected %s", bucket, strings.Join(got, ", "), strings.Join(wantKeys, ", "))
+		}
+
+		for key, contentType := range want {
+			output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+			if err != nil {
+				return fmt.Errorf("reading S3 Object (%s): %w", key, err)
+			}
+
+			if got := aws.ToString(output.ContentType); got != contentType {
+				return fmt.Errorf("S3 Object (%s) content type = %s, expected %s", key, got, contentType)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccSyncActionConfig_basic(rName, source, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+action "aws_s3_sync" "test" {
+  config {
+    bucket     = aws_s3_bucket.test.bucket
+    source     = %[2]q
+    key_prefix = "site/"
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[3]q
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_s3_sync.test]
+    }
+  }
+}
+`, rName, source, trigger)
+}
+
+func testAccSyncActionConfig_delete(rName, source, trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+action "aws_s3_sync" "test" {
+  config {
+    bucket      = aws_s3_bucket.test.bucket
+    source      = %[2]q
+    delete      = true
+    exclude     = ["skip/*"]
+    concurrency = 2
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = %[3]q
+  lifecycle {
+    action_trigger {
+      events  = [before_create, before_update]
+      actions = [action.aws_s3_sync.test]
+    }
+  }
+}
+`, rName, source, trigger)
+}
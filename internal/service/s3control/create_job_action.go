@@ -0,0 +1,516 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/actionvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	createJobDefaultPriority = 10
+)
+
+// @Action(aws_s3control_create_job, name="Create Job")
+func newCreateJobAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a createJobAction
+	a.SetDefaultInvokeTimeout(60 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action                     = (*createJobAction)(nil)
+	_ action.ActionWithConfigValidators = (*createJobAction)(nil)
+)
+
+type createJobAction struct {
+	framework.ActionWithModel[createJobActionModel]
+	framework.ActionWithTimeouts
+}
+
+type createJobActionModel struct {
+	framework.WithRegionModel
+	AccountID   types.String                                       `tfsdk:"account_id"`
+	Description types.String                                       `tfsdk:"description"`
+	Manifest    fwtypes.ListNestedObjectValueOf[jobManifestModel]  `tfsdk:"manifest"`
+	Operation   fwtypes.ListNestedObjectValueOf[jobOperationModel] `tfsdk:"operation"`
+	Priority    types.Int32                                        `tfsdk:"priority"`
+	Report      fwtypes.ListNestedObjectValueOf[jobReportModel]    `tfsdk:"report"`
+	RoleARN     fwtypes.ARN                                        `tfsdk:"role_arn"`
+	Timeouts    timeouts.Value                                     `tfsdk:"timeouts"`
+}
+
+type jobManifestModel struct {
+	Location fwtypes.ListNestedObjectValueOf[jobManifestLocationModel] `tfsdk:"location"`
+	Spec     fwtypes.ListNestedObjectValueOf[jobManifestSpecModel]     `tfsdk:"spec"`
+}
+
+type jobManifestLocationModel struct {
+	ETag            types.String `tfsdk:"etag"`
+	ObjectARN       fwtypes.ARN  `tfsdk:"object_arn"`
+	ObjectVersionID types.String `tfsdk:"object_version_id"`
+}
+
+type jobManifestSpecModel struct {
+	Fields fwtypes.ListOfStringEnum[awstypes.JobManifestFieldName] `tfsdk:"fields"`
+	Format fwtypes.StringEnum[awstypes.JobManifestFormat]          `tfsdk:"format"`
+}
+
+type jobOperationModel struct {
+	S3InitiateRestoreObject fwtypes.ListNestedObjectValueOf[s3InitiateRestoreObjectOperationModel] `tfsdk:"s3_initiate_restore_object"`
+	S3PutObjectCopy         fwtypes.ListNestedObjectValueOf[s3CopyObjectOperationModel]            `tfsdk:"s3_put_object_copy"`
+	S3PutObjectTagging      fwtypes.ListNestedObjectValueOf[s3SetObjectTaggingOperationModel]      `tfsdk:"s3_put_object_tagging"`
+}
+
+type s3InitiateRestoreObjectOperationModel struct {
+	ExpirationInDays types.Int32                                   `tfsdk:"expiration_in_days"`
+	GlacierJobTier   fwtypes.StringEnum[awstypes.S3GlacierJobTier] `tfsdk:"glacier_job_tier"`
+}
+
+type s3CopyObjectOperationModel struct {
+	StorageClass    fwtypes.StringEnum[awstypes.S3StorageClass] `tfsdk:"storage_class"`
+	TargetKeyPrefix types.String                                `tfsdk:"target_key_prefix"`
+	TargetResource  fwtypes.ARN                                 `tfsdk:"target_resource"`
+}
+
+type s3SetObjectTaggingOperationModel struct {
+	Tags fwtypes.MapOfString `tfsdk:"tags" autoflex:"-"`
+}
+
+type jobReportModel struct {
+	Bucket      fwtypes.ARN                                  `tfsdk:"bucket"`
+	Enabled     types.Bool                                   `tfsdk:"enabled"`
+	Format      fwtypes.StringEnum[awstypes.JobReportFormat] `tfsdk:"format"`
+	Prefix      types.String                                 `tfsdk:"prefix"`
+	ReportScope fwtypes.StringEnum[awstypes.JobReportScope]  `tfsdk:"report_scope"`
+}
+
+func (a *createJobAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Creates an S3 Batch Operations job that copies, tags or restores the objects listed in a manifest, and waits for the job to complete.",
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Description: "ID of the AWS account that creates the job. Defaults to the account of the provider.",
+				Optional:    true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "Description of the job.",
+				Optional:    true,
+			},
+			names.AttrPriority: schema.Int32Attribute{
+				Description: "Numerical priority of the job. Higher numbers indicate higher priority. Defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "ARN of the IAM role that Batch Operations assumes to run the operation on every object in the manifest.",
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"manifest": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[jobManifestModel](ctx),
+				Description: "Manifest listing the objects the job operates on.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrLocation: schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[jobManifestLocationModel](ctx),
+							Description: "Location of the manifest object.",
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"etag": schema.StringAttribute{
+										Description: "ETag of the manifest object.",
+										Required:    true,
+									},
+									"object_arn": schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "ARN of the manifest object.",
+										Required:    true,
+									},
+									"object_version_id": schema.StringAttribute{
+										Description: "Version ID of the manifest object.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"spec": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[jobManifestSpecModel](ctx),
+							Description: "Format and fields of the manifest.",
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"fields": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringEnumType[awstypes.JobManifestFieldName](),
+										Description: "Fields of a CSV manifest, in column order. Required when format is 'S3BatchOperations_CSV_20180820'.",
+										Optional:    true,
+									},
+									names.AttrFormat: schema.StringAttribute{
+										CustomType:  fwtypes.StringEnumType[awstypes.JobManifestFormat](),
+										Description: "Format of the manifest. Valid values are 'S3BatchOperations_CSV_20180820' and 'S3InventoryReport_CSV_20161130'.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[jobOperationModel](ctx),
+				Description: "Operation to run on every object in the manifest. Exactly one operation must be specified.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_initiate_restore_object": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[s3InitiateRestoreObjectOperationModel](ctx),
+							Description: "Restores archived objects.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"expiration_in_days": schema.Int32Attribute{
+										Description: "Number of days the restored copy is available for.",
+										Optional:    true,
+										Validators: []validator.Int32{
+											int32validator.AtLeast(1),
+										},
+									},
+									"glacier_job_tier": schema.StringAttribute{
+										CustomType:  fwtypes.StringEnumType[awstypes.S3GlacierJobTier](),
+										Description: "Retrieval tier of the restore. Valid values are 'BULK' and 'STANDARD'.",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[s3CopyObjectOperationModel](ctx),
+							Description: "Copies objects to a destination bucket.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrStorageClass: schema.StringAttribute{
+										CustomType:  fwtypes.StringEnumType[awstypes.S3StorageClass](),
+										Description: "Storage class of the copied objects.",
+										Optional:    true,
+									},
+									"target_key_prefix": schema.StringAttribute{
+										Description: "Key prefix prepended to the key of each copied object.",
+										Optional:    true,
+									},
+									"target_resource": schema.StringAttribute{
+										CustomType:  fwtypes.ARNType,
+										Description: "ARN of the destination bucket.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[s3SetObjectTaggingOperationModel](ctx),
+							Description: "Replaces the tag set of objects.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrTags: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										Description: "Tags to set on each object. An empty map removes all tags.",
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"report": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[jobReportModel](ctx),
+				Description: "Configuration of the job completion report.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrBucket: schema.StringAttribute{
+							CustomType:  fwtypes.ARNType,
+							Description: "ARN of the bucket the report is written to. Required when enabled is true.",
+							Optional:    true,
+						},
+						names.AttrEnabled: schema.BoolAttribute{
+							Description: "Whether a completion report is generated.",
+							Required:    true,
+						},
+						names.AttrFormat: schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.JobReportFormat](),
+							Description: "Format of the report. Valid value is 'Report_CSV_20180820'.",
+							Optional:    true,
+						},
+						names.AttrPrefix: schema.StringAttribute{
+							Description: "Key prefix of the report.",
+							Optional:    true,
+						},
+						"report_scope": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.JobReportScope](),
+							Description: "Tasks included in the report. Valid values are 'AllTasks' and 'FailedTasksOnly'.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *createJobAction) ConfigValidators(context.Context) []action.ConfigValidator {
+	operation := path.MatchRoot("operation").AtAnyListIndex()
+
+	return []action.ConfigValidator{
+		actionvalidator.ExactlyOneOf(
+			operation.AtName("s3_initiate_restore_object"),
+			operation.AtName("s3_put_object_copy"),
+			operation.AtName("s3_put_object_tagging"),
+		),
+	}
+}
+
+func (a *createJobAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config createJobActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+
+	// Get AWS client
+	conn := a.Meta().S3ControlClient(ctx)
+
+	accountID := fwflex.StringValueFromFramework(ctx, config.AccountID)
+	if accountID == "" {
+		accountID = a.Meta().AccountID(ctx)
+	}
+
+	ctx = tflog.SetField(ctx, names.AttrAccountID, accountID)
+
+	tflog.Info(ctx, "Starting S3 Batch Operations create job action", map[string]any{
+		names.AttrRoleARN: config.RoleARN.ValueString(),
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Creating S3 Batch Operations job in account %s...", accountID)
+
+	var input s3control.CreateJobInput
+	resp.Diagnostics.Append(fwflex.Expand(ctx, config, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.AccountId = aws.String(accountID)
+	input.ClientRequestToken = aws.String(create.UniqueId(ctx))
+	input.ConfirmationRequired = aws.Bool(false)
+	if config.Priority.IsNull() {
+		input.Priority = aws.Int32(createJobDefaultPriority)
+	}
+
+	// The tag set is a map in configuration but a list of key/value pairs in the API.
+	if operation, diags := config.Operation.ToPtr(ctx); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	} else if tagging, diags := operation.S3PutObjectTagging.ToPtr(ctx); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	} else if tagging != nil {
+		input.Operation.S3PutObjectTagging.TagSet = []awstypes.S3Tag{}
+		for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, tagging.Tags) {
+			input.Operation.S3PutObjectTagging.TagSet = append(input.Operation.S3PutObjectTagging.TagSet, awstypes.S3Tag{
+				Key:   aws.String(k),
+				Value: aws.String(v),
+			})
+		}
+	}
+
+	output, err := conn.CreateJob(ctx, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Job",
+			fmt.Sprintf("Could not create S3 Batch Operations job in account %s: %s", accountID, err),
+		)
+		return
+	}
+
+	jobID := aws.ToString(output.JobId)
+	ctx = tflog.SetField(ctx, "job_id", jobID)
+	cb(ctx, "S3 Batch Operations job %s created, waiting for completion...", jobID)
+
+	fr, err := actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*awstypes.JobDescriptor], error) {
+		output, err := findJobByTwoPartKey(ctx, conn, accountID, jobID)
+		if err != nil {
+			return actionwait.FetchResult[*awstypes.JobDescriptor]{}, fmt.Errorf("describing job: %w", err)
+		}
+		return actionwait.FetchResult[*awstypes.JobDescriptor]{Status: actionwait.Status(output.Status), Value: output}, nil
+	}, actionwait.Options[*awstypes.JobDescriptor]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(actionwait.DefaultPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusComplete),
+		},
+		TransitionalStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusNew),
+			actionwait.Status(awstypes.JobStatusPreparing),
+			actionwait.Status(awstypes.JobStatusSuspended),
+			actionwait.Status(awstypes.JobStatusReady),
+			actionwait.Status(awstypes.JobStatusActive),
+			actionwait.Status(awstypes.JobStatusPausing),
+			actionwait.Status(awstypes.JobStatusPaused),
+			actionwait.Status(awstypes.JobStatusCompleting),
+			actionwait.Status(awstypes.JobStatusCancelling),
+			actionwait.Status(awstypes.JobStatusFailing),
+		},
+		FailureStates: []actionwait.Status{
+			actionwait.Status(awstypes.JobStatusCancelled),
+			actionwait.Status(awstypes.JobStatusFailed),
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			if job, ok := fr.Value.(*awstypes.JobDescriptor); ok && job.ProgressSummary != nil {
+				cb(ctx, "Job is currently %q (elapsed %s): %s", fr.Status, meta.Elapsed.Truncate(time.Second), jobProgressString(job.ProgressSummary))
+				return
+			}
+			cb(ctx, "Job is currently %q (elapsed %s), continuing to wait for completion...", fr.Status, meta.Elapsed.Truncate(time.Second))
+		},
+	})
+
+	if err != nil {
+		switch {
+		case errs.IsA[*actionwait.TimeoutError](err):
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Job to Complete",
+				fmt.Sprintf("S3 Batch Operations job %s did not complete within %s: %s", jobID, timeout, err),
+			)
+		case errs.IsA[*actionwait.FailureStateError](err):
+			detail := fmt.Sprintf("S3 Batch Operations job %s failed: %s", jobID, err)
+			if job := fr.Value; job != nil && len(job.FailureReasons) > 0 {
+				detail = fmt.Sprintf("%s\n\n%s", detail, jobFailuresError(job.FailureReasons))
+			}
+			resp.Diagnostics.AddError(
+				"Job Failed",
+				detail,
+			)
+		case errs.IsA[*actionwait.UnexpectedStateError](err):
+			resp.Diagnostics.AddError(
+				"Unexpected Job State",
+				fmt.Sprintf("S3 Batch Operations job %s entered unexpected state: %s", jobID, err),
+			)
+		default:
+			resp.Diagnostics.AddError(
+				"Failed While Waiting for Job to Complete",
+				fmt.Sprintf("Error waiting for S3 Batch Operations job %s: %s", jobID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	if job := fr.Value; job != nil && job.ProgressSummary != nil {
+		cb(ctx, "S3 Batch Operations job %s completed: %s", jobID, jobProgressString(job.ProgressSummary))
+	} else {
+		cb(ctx, "S3 Batch Operations job %s completed successfully", jobID)
+	}
+
+	tflog.Info(ctx, "S3 Batch Operations create job action completed successfully")
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *s3control.Client, accountID, jobID string) (*awstypes.JobDescriptor, error) {
+	input := s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	}
+
+	output, err := conn.DescribeJob(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Job == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.Job, nil
+}
+
+func jobProgressString(summary *awstypes.JobProgressSummary) string {
+	return fmt.Sprintf("%d of %d tasks succeeded, %d failed",
+		aws.ToInt64(summary.NumberOfTasksSucceeded),
+		aws.ToInt64(summary.TotalNumberOfTasks),
+		aws.ToInt64(summary.NumberOfTasksFailed),
+	)
+}
+
+func jobFailuresError(failures []awstypes.JobFailure) error {
+	return errors.New(strings.Join(tfslices.ApplyToAll(failures, func(v awstypes.JobFailure) string {
+		return fmt.Sprintf("%s: %s", aws.ToString(v.FailureCode), aws.ToString(v.FailureReason))
+	}), "\n"))
+}
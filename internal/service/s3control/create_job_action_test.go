@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3ControlCreateJobAction_tagging(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateJobActionConfig_tagging(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCreateJobActionObjectTagged(ctx, t, rName, "object1", "batch", rName),
+					testAccCheckCreateJobActionObjectTagged(ctx, t, rName, "object2", "batch", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlCreateJobAction_failed(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				// The manifest ETag does not match the manifest object, so the job fails while preparing.
+				Config:      testAccCreateJobActionConfig_badManifestETag(rName),
+				ExpectError: regexache.MustCompile(`Job Failed`),
+			},
+		},
+	})
+}
+
+// testAccCheckCreateJobActionObjectTagged verifies that the Batch Operations job set the expected tag on the object
+func testAccCheckCreateJobActionObjectTagged(ctx context.Context, t *testing.T, bucket, key, tagKey, tagValue string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).S3Client(ctx)
+
+		input := s3.GetObjectTaggingInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		}
+		output, err := conn.GetObjectTagging(ctx, &input)
+		if err != nil {
+			return fmt.Errorf("reading S3 Object (%s) tags: %w", key, err)
+		}
+
+		for _, v := range output.TagSet {
+			if aws.ToString(v.Key) == tagKey {
+				if got := aws.ToString(v.Value); got != tagValue {
+					return fmt.Errorf("S3 Object (%s) tag %s = %s, expected %s", key, tagKey, got, tagValue)
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("S3 Object (%s) tag %s not found", key, tagKey)
+	}
+}
+
+func testAccCreateJobActionConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "object1" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "object1"
+  content = "object1"
+}
+
+resource "aws_s3_object" "object2" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "object2"
+  content = "object2"
+}
+
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = "${aws_s3_bucket.test.bucket},${aws_s3_object.object1.key}\n${aws_s3_bucket.test.bucket},${aws_s3_object.object2.key}\n"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "batchoperations.s3.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:GetObjectVersion", "s3:PutObjectTagging", "s3:PutObjectVersionTagging", "s3:PutObject"]
+      Effect   = "Allow"
+      Resource = ["${aws_s3_bucket.test.arn}/*"]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccCreateJobActionConfig_action(rName, etag string) string {
+	return fmt.Sprintf(`
+action "aws_s3control_create_job" "test" {
+  config {
+    role_arn = aws_iam_role.test.arn
+
+    manifest {
+      location {
+        object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+        etag       = %[2]s
+      }
+      spec {
+        format = "S3BatchOperations_CSV_20180820"
+        fields = ["Bucket", "Key"]
+      }
+    }
+
+    operation {
+      s3_put_object_tagging {
+        tags = {
+          batch = %[1]q
+        }
+      }
+    }
+
+    report {
+      enabled = false
+    }
+  }
+}
+
+resource "terraform_data" "trigger" {
+  depends_on = [aws_iam_role_policy.test]
+
+  input = aws_s3_object.manifest.etag
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_s3control_create_job.test]
+    }
+  }
+}
+`, rName, etag)
+}
+
+func testAccCreateJobActionConfig_tagging(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCreateJobActionConfig_base(rName),
+		testAccCreateJobActionConfig_action(rName, "aws_s3_object.manifest.etag"),
+	)
+}
+
+func testAccCreateJobActionConfig_badManifestETag(rName string) string {
+	return acctest.ConfigCompose(
+		testAccCreateJobActionConfig_base(rName),
+		testAccCreateJobActionConfig_action(rName, `"00000000000000000000000000000000"`),
+	)
+}
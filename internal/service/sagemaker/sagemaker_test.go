@@ -130,6 +130,7 @@ func TestAccSageMaker_serial(t *testing.T) {
 			"CognitoConfig":      testAccWorkforce_cognitoConfig,
 			"OidcConfig":         testAccWorkforce_oidcConfig,
 			"OidcConfig_full":    testAccWorkforce_oidcConfig_full,
+			"OidcConfig_wo":      testAccWorkforce_oidcConfigClientSecretWriteOnly,
 			"SourceIpConfig":     testAccWorkforce_sourceIPConfig,
 			"VPC":                testAccWorkforce_vpc,
 		},
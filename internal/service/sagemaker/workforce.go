@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -88,9 +89,24 @@ func resourceWorkforce() *schema.Resource {
 							},
 							names.AttrClientSecret: {
 								Type:         schema.TypeString,
-								Required:     true,
+								Optional:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+								ExactlyOneOf: []string{"oidc_config.0.client_secret", "oidc_config.0.client_secret_wo"},
+							},
+							"client_secret_wo": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								WriteOnly:    true,
 								Sensitive:    true,
 								ValidateFunc: validation.StringLenBetween(1, 1024),
+								ExactlyOneOf: []string{"oidc_config.0.client_secret", "oidc_config.0.client_secret_wo"},
+								RequiredWith: []string{"oidc_config.0.client_secret_wo_version"},
+							},
+							"client_secret_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"oidc_config.0.client_secret_wo"},
 							},
 							names.AttrIssuer: {
 								Type:     schema.TypeString,
@@ -216,6 +232,17 @@ func resourceWorkforceCreate(ctx context.Context, d *schema.ResourceData, meta a
 
 	if v, ok := d.GetOk("oidc_config"); ok {
 		input.OidcConfig = expandWorkforceOIDCConfig(v.([]any))
+
+		// get write-only value from configuration
+		clientSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("oidc_config").IndexInt(0).GetAttr("client_secret_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if clientSecretWO != "" {
+			input.OidcConfig.ClientSecret = aws.String(clientSecretWO)
+		}
 	}
 
 	if v, ok := d.GetOk("source_ip_config"); ok {
@@ -266,7 +293,7 @@ func resourceWorkforceRead(ctx context.Context, d *schema.ResourceData, meta any
 	}
 
 	if workforce.OidcConfig != nil {
-		if err := d.Set("oidc_config", flattenWorkforceOIDCConfig(workforce.OidcConfig, d.Get("oidc_config.0.client_secret").(string))); err != nil {
+		if err := d.Set("oidc_config", flattenWorkforceOIDCConfig(workforce.OidcConfig, d.Get("oidc_config.0.client_secret").(string), d.Get("oidc_config.0.client_secret_wo_version").(int))); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting oidc_config: %s", err)
 		}
 	}
@@ -296,6 +323,17 @@ func resourceWorkforceUpdate(ctx context.Context, d *schema.ResourceData, meta a
 
 	if d.HasChange("oidc_config") {
 		input.OidcConfig = expandWorkforceOIDCConfig(d.Get("oidc_config").([]any))
+
+		// get write-only value from configuration
+		clientSecretWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("oidc_config").IndexInt(0).GetAttr("client_secret_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if clientSecretWO != "" {
+			input.OidcConfig.ClientSecret = aws.String(clientSecretWO)
+		}
 	}
 
 	if d.HasChange("workforce_vpc_config") {
@@ -427,7 +465,6 @@ func expandWorkforceOIDCConfig(l []any) *awstypes.OidcConfig {
 	config := &awstypes.OidcConfig{
 		AuthorizationEndpoint: aws.String(m["authorization_endpoint"].(string)),
 		ClientId:              aws.String(m[names.AttrClientID].(string)),
-		ClientSecret:          aws.String(m[names.AttrClientSecret].(string)),
 		Issuer:                aws.String(m[names.AttrIssuer].(string)),
 		JwksUri:               aws.String(m["jwks_uri"].(string)),
 		LogoutEndpoint:        aws.String(m["logout_endpoint"].(string)),
@@ -439,6 +476,10 @@ func expandWorkforceOIDCConfig(l []any) *awstypes.OidcConfig {
 		config.AuthenticationRequestExtraParams = flex.ExpandStringValueMap(v)
 	}
 
+	if v, ok := m[names.AttrClientSecret].(string); ok && v != "" {
+		config.ClientSecret = aws.String(v)
+	}
+
 	if v, ok := m[names.AttrScope].(string); ok && v != "" {
 		config.Scope = aws.String(v)
 	}
@@ -446,7 +487,7 @@ func expandWorkforceOIDCConfig(l []any) *awstypes.OidcConfig {
 	return config
 }
 
-func flattenWorkforceOIDCConfig(config *awstypes.OidcConfigForResponse, clientSecret string) []map[string]any {
+func flattenWorkforceOIDCConfig(config *awstypes.OidcConfigForResponse, clientSecret string, clientSecretWOVersion int) []map[string]any {
 	if config == nil {
 		return []map[string]any{}
 	}
@@ -456,6 +497,7 @@ func flattenWorkforceOIDCConfig(config *awstypes.OidcConfigForResponse, clientSe
 		"authorization_endpoint":              aws.ToString(config.AuthorizationEndpoint),
 		names.AttrClientID:                    aws.ToString(config.ClientId),
 		names.AttrClientSecret:                clientSecret,
+		"client_secret_wo_version":            clientSecretWOVersion,
 		names.AttrIssuer:                      aws.ToString(config.Issuer),
 		"jwks_uri":                            aws.ToString(config.JwksUri),
 		"logout_endpoint":                     aws.ToString(config.LogoutEndpoint),
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
@@ -194,6 +195,46 @@ func testAccWorkforce_oidcConfig_full(t *testing.T) {
 	})
 }
 
+func testAccWorkforce_oidcConfigClientSecretWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var workforce awstypes.Workforce
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_workforce.test"
+	endpoint := "https://example.com"
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckWorkforceDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkforceConfig_oidcClientSecretWriteOnly(rName, endpoint, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkforceExists(ctx, t, resourceName, &workforce),
+					resource.TestCheckResourceAttr(resourceName, "oidc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_config.0.client_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "oidc_config.0.client_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_config.0.client_secret_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccWorkforceConfig_oidcClientSecretWriteOnly(rName, endpoint, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkforceExists(ctx, t, resourceName, &workforce),
+					resource.TestCheckResourceAttr(resourceName, "oidc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_config.0.client_secret", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "oidc_config.0.client_secret_wo"),
+					resource.TestCheckResourceAttr(resourceName, "oidc_config.0.client_secret_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccWorkforce_sourceIPConfig(t *testing.T) {
 	ctx := acctest.Context(t)
 	var workforce awstypes.Workforce
@@ -536,3 +577,23 @@ resource "aws_sagemaker_workforce" "test" {
 }
 `, rName))
 }
+
+func testAccWorkforceConfig_oidcClientSecretWriteOnly(rName, endpoint string, clientSecretWOVersion int) string {
+	return acctest.ConfigCompose(testAccWorkforceConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_workforce" "test" {
+  workforce_name = %[1]q
+
+  oidc_config {
+    authorization_endpoint   = %[2]q
+    client_id                = %[1]q
+    client_secret_wo         = %[1]q
+    client_secret_wo_version = %[3]d
+    issuer                   = %[2]q
+    jwks_uri                 = %[2]q
+    logout_endpoint          = %[2]q
+    token_endpoint           = %[2]q
+    user_info_endpoint       = %[2]q
+  }
+}
+`, rName, endpoint, clientSecretWOVersion))
+}
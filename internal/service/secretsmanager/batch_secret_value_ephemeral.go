@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_secretsmanager_batch_secret_value, name="Batch Secret Value")
+func newBatchSecretValueEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &batchSecretValueEphemeralResource{}, nil
+}
+
+var (
+	_ ephemeral.EphemeralResourceWithValidateConfig = (*batchSecretValueEphemeralResource)(nil)
+)
+
+type batchSecretValueEphemeralResource struct {
+	framework.EphemeralResourceWithModel[batchSecretValueEphemeralResourceModel]
+}
+
+func (e *batchSecretValueEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"secret_ids": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 20),
+				},
+			},
+			"secret_values": schema.ListNestedAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[secretValueEntryModel](ctx),
+				Computed:   true,
+				Sensitive:  true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrARN: schema.StringAttribute{
+							Computed: true,
+						},
+						names.AttrCreatedDate: schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Computed:   true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Computed: true,
+						},
+						"secret_binary": schema.StringAttribute{
+							Computed: true,
+						},
+						"secret_string": schema.StringAttribute{
+							Computed: true,
+						},
+						"version_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"version_stages": schema.ListAttribute{
+							CustomType: fwtypes.ListOfStringType,
+							Computed:   true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[filterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+					listvalidator.ConflictsWith(path.MatchRoot("secret_ids")),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.FilterNameStringType](),
+							Required:   true,
+						},
+						names.AttrValues: schema.ListAttribute{
+							CustomType: fwtypes.ListOfStringType,
+							Required:   true,
+							Validators: []validator.List{
+								listvalidator.SizeBetween(1, 10),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *batchSecretValueEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	var data batchSecretValueEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SecretIDs.IsUnknown() || data.Filters.IsUnknown() {
+		return
+	}
+
+	if data.SecretIDs.IsNull() && len(data.Filters.Elements()) == 0 {
+		response.Diagnostics.AddAttributeError(
+			path.Root("secret_ids"),
+			"Missing Attribute Configuration",
+			fmt.Sprintf("Exactly one of %q or %q must be configured.", "secret_ids", names.AttrFilter),
+		)
+	}
+}
+
+func (e *batchSecretValueEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data batchSecretValueEphemeralResourceModel
+	conn := e.Meta().SecretsManagerClient(ctx)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input secretsmanager.BatchGetSecretValueInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.SecretIdList = fwflex.ExpandFrameworkStringValueSet(ctx, data.SecretIDs)
+
+	output, err := findSecretValueEntries(ctx, conn, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	secretValues := make([]secretValueEntryModel, 0, len(output))
+	for _, v := range output {
+		var secretValue secretValueEntryModel
+		smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Flatten(ctx, v, &secretValue, fwflex.WithIgnoredFieldNamesAppend("SecretBinary")))
+		if response.Diagnostics.HasError() {
+			return
+		}
+		secretValue.SecretBinary = fwflex.StringValueToFramework(ctx, string(v.SecretBinary))
+
+		secretValues = append(secretValues, secretValue)
+	}
+	data.SecretValues = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, secretValues)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+// findSecretValueEntries returns the values of all secrets matching the input.
+// Per-secret errors, such as a secret ID that cannot be found, are returned as a single joined error.
+func findSecretValueEntries(ctx context.Context, conn *secretsmanager.Client, input *secretsmanager.BatchGetSecretValueInput) ([]awstypes.SecretValueEntry, error) {
+	var (
+		output []awstypes.SecretValueEntry
+		errs   []error
+	)
+
+	pages := secretsmanager.NewBatchGetSecretValuePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Errors {
+			errs = append(errs, fmt.Errorf("Secrets Manager Secret (%s): %s: %s", aws.ToString(v.SecretId), aws.ToString(v.ErrorCode), aws.ToString(v.Message)))
+		}
+
+		output = append(output, page.SecretValues...)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+type batchSecretValueEphemeralResourceModel struct {
+	framework.WithRegionModel
+	Filters      fwtypes.ListNestedObjectValueOf[filterModel]           `tfsdk:"filter"`
+	SecretIDs    fwtypes.SetOfString                                    `tfsdk:"secret_ids" autoflex:"-"`
+	SecretValues fwtypes.ListNestedObjectValueOf[secretValueEntryModel] `tfsdk:"secret_values" autoflex:"-"`
+}
+
+type filterModel struct {
+	Key    fwtypes.StringEnum[awstypes.FilterNameStringType] `tfsdk:"key"`
+	Values fwtypes.ListOfString                              `tfsdk:"values"`
+}
+
+type secretValueEntryModel struct {
+	ARN           types.String         `tfsdk:"arn"`
+	CreatedDate   timetypes.RFC3339    `tfsdk:"created_date"`
+	Name          types.String         `tfsdk:"name"`
+	SecretBinary  types.String         `tfsdk:"secret_binary"`
+	SecretString  types.String         `tfsdk:"secret_string"`
+	VersionID     types.String         `tfsdk:"version_id"`
+	VersionStages fwtypes.ListOfString `tfsdk:"version_stages"`
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerBatchSecretValueEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchSecretValueEphemeralResourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_values"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							names.AttrARN:   knownvalue.NotNull(),
+							names.AttrName:  knownvalue.StringExact(rName + "-1"),
+							"secret_string": knownvalue.StringExact("secret-1"),
+						}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerBatchSecretValueEphemeral_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchSecretValueEphemeralResourceConfig_filter(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("secret_values"), knownvalue.ListSizeExact(2)),
+				},
+			},
+		},
+	})
+}
+
+func testAccBatchSecretValueEphemeralResourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index + 1}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  count = 2
+
+  secret_id     = aws_secretsmanager_secret.test[count.index].id
+  secret_string = "secret-${count.index + 1}"
+}
+`, rName)
+}
+
+func testAccBatchSecretValueEphemeralResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_batch_secret_value.test"),
+		testAccBatchSecretValueEphemeralResourceConfig_base(rName),
+		`
+ephemeral "aws_secretsmanager_batch_secret_value" "test" {
+  secret_ids = [aws_secretsmanager_secret_version.test[0].secret_id]
+}
+`)
+}
+
+func testAccBatchSecretValueEphemeralResourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_secretsmanager_batch_secret_value.test"),
+		testAccBatchSecretValueEphemeralResourceConfig_base(rName),
+		fmt.Sprintf(`
+ephemeral "aws_secretsmanager_batch_secret_value" "test" {
+  filter {
+    key    = "tag-value"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`, rName))
+}
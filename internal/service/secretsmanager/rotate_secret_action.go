@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	cloudtrailtypes "github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/action/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/actionwait"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwactions "github.com/hashicorp/terraform-provider-aws/internal/framework/actions"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	secretVersionStagePending = "AWSPENDING"
+)
+
+const (
+	// rotationStatusStarted indicates that the rotation function has not yet created the new secret version.
+	rotationStatusStarted = "STARTED"
+	// rotationStatusPending indicates that the new secret version exists, labeled AWSPENDING.
+	rotationStatusPending = secretVersionStagePending
+	// rotationStatusCurrent indicates that AWSCURRENT has moved to the new secret version.
+	rotationStatusCurrent = secretVersionStageCurrent
+	// rotationStatusFailed indicates that the rotation failed or was abandoned.
+	rotationStatusFailed = "FAILED"
+)
+
+const (
+	// CloudTrail events recorded by Secrets Manager when a rotation does not complete.
+	eventNameRotationAbandoned = "RotationAbandoned"
+	eventNameRotationFailed    = "RotationFailed"
+)
+
+// @Action(aws_secretsmanager_rotate_secret, name="Rotate Secret")
+func newRotateSecretAction(_ context.Context) (action.ActionWithConfigure, error) {
+	var a rotateSecretAction
+	a.SetDefaultInvokeTimeout(30 * time.Minute)
+
+	return &a, nil
+}
+
+var (
+	_ action.Action = (*rotateSecretAction)(nil)
+)
+
+type rotateSecretAction struct {
+	framework.ActionWithModel[rotateSecretActionModel]
+	framework.ActionWithTimeouts
+}
+
+type rotateSecretActionModel struct {
+	framework.WithRegionModel
+	LookupCloudTrailEvents types.Bool     `tfsdk:"lookup_cloudtrail_events"`
+	SecretID               types.String   `tfsdk:"secret_id"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (a *rotateSecretAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Immediately rotates a Secrets Manager secret using its configured rotation and waits for the AWSCURRENT staging label to move to the new secret version.",
+		Attributes: map[string]schema.Attribute{
+			"lookup_cloudtrail_events": schema.BoolAttribute{
+				Description: "Whether to look up the RotationFailed and RotationAbandoned CloudTrail events recorded for the secret when the rotation fails or times out, to report why. Requires the cloudtrail:LookupEvents permission.",
+				Optional:    true,
+			},
+			"secret_id": schema.StringAttribute{
+				Description: "Name or ARN of the secret to rotate. Rotation must already be configured for the secret.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx),
+		},
+	}
+}
+
+func (a *rotateSecretAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config rotateSecretActionModel
+
+	// Parse configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := a.InvokeTimeout(ctx, config.Timeouts)
+
+	// Get AWS client
+	conn := a.Meta().SecretsManagerClient(ctx)
+
+	secretID := fwflex.StringValueFromFramework(ctx, config.SecretID)
+
+	ctx = tflog.SetField(ctx, "secret_id", secretID)
+
+	tflog.Info(ctx, "Starting Secrets Manager rotate secret action", map[string]any{
+		names.AttrTimeout: timeout.String(),
+	})
+
+	// Send initial progress update
+	cb := fwactions.NewSendProgressFunc(resp)
+	cb(ctx, "Starting rotation for Secrets Manager secret %s...", secretID)
+
+	secret, err := findSecretByID(ctx, conn, secretID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Secret",
+			fmt.Sprintf("Could not read Secrets Manager secret %s: %s", secretID, err),
+		)
+		return
+	}
+
+	if !aws.ToBool(secret.RotationEnabled) {
+		resp.Diagnostics.AddError(
+			"Rotation Not Configured",
+			fmt.Sprintf("Secrets Manager secret %s does not have rotation configured. Configure rotation, for example with the aws_secretsmanager_secret_rotation resource, before rotating the secret.", secretID),
+		)
+		return
+	}
+
+	previousVersionID := secretVersionIDWithStage(secret.VersionIdsToStages, secretVersionStageCurrent)
+
+	input := secretsmanager.RotateSecretInput{
+		ClientRequestToken: aws.String(create.UniqueId(ctx)), // Needed because we're handling our own retries
+		RotateImmediately:  aws.Bool(true),
+		SecretId:           aws.String(secretID),
+	}
+
+	// AccessDeniedException: Secrets Manager cannot invoke the specified Lambda function.
+	// InvalidRequestException: Secrets Manager is unable to assume role (IAM propagation delay).
+	rotationStartTime := time.Now()
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.RotateSecret(ctx, &input)
+	}, errCodeAccessDeniedException, errCodeInvalidRequestException)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Rotate Secret",
+			fmt.Sprintf("Could not start rotation for Secrets Manager secret %s: %s", secretID, err),
+		)
+		return
+	}
+
+	versionID := aws.ToString(outputRaw.(*secretsmanager.RotateSecretOutput).VersionId)
+	ctx = tflog.SetField(ctx, "version_id", versionID)
+	cb(ctx, "Rotation started for Secrets Manager secret %s, new version %s (AWSCURRENT is version %s)", secretID, versionID, previousVersionID)
+
+	// Report each rotation step as the new version's staging labels change.
+	// Secrets Manager does not report a failed rotation in the secret's metadata, so a failure is detected
+	// from the secret's staging labels. The RotationFailed CloudTrail events it records are only looked up
+	// once the wait has ended, as LookupEvents is rate limited per account and Region.
+	lastStatus, failureReason := rotationStatusStarted, ""
+	_, err = actionwait.WaitForStatus(ctx, func(ctx context.Context) (actionwait.FetchResult[*secretsmanager.DescribeSecretOutput], error) {
+		output, err := findSecretByID(ctx, conn, secretID)
+		if err != nil {
+			return actionwait.FetchResult[*secretsmanager.DescribeSecretOutput]{}, fmt.Errorf("describing secret: %w", err)
+		}
+
+		status := rotationStatus(output.VersionIdsToStages[versionID])
+		switch currentVersionID := secretVersionIDWithStage(output.VersionIdsToStages, secretVersionStageCurrent); {
+		case status == rotationStatusCurrent:
+		case !aws.ToBool(output.RotationEnabled):
+			status, failureReason = rotationStatusFailed, "rotation was disabled"
+		case currentVersionID != previousVersionID:
+			status, failureReason = rotationStatusFailed, fmt.Sprintf("AWSCURRENT moved to version %s", currentVersionID)
+		case status == rotationStatusStarted && lastStatus == rotationStatusPending:
+			status, failureReason = rotationStatusFailed, fmt.Sprintf("the %s staging label was removed from version %s", secretVersionStagePending, versionID)
+		}
+
+		if status != lastStatus {
+			lastStatus = status
+			switch status {
+			case rotationStatusPending:
+				cb(ctx, "Secret version %s created and labeled %s, waiting for the rotation function to set and test it...", versionID, secretVersionStagePending)
+			case rotationStatusCurrent:
+				cb(ctx, "Secret version %s labeled %s", versionID, secretVersionStageCurrent)
+			}
+		}
+
+		return actionwait.FetchResult[*secretsmanager.DescribeSecretOutput]{Status: actionwait.Status(status), Value: output}, nil
+	}, actionwait.Options[*secretsmanager.DescribeSecretOutput]{
+		Timeout:          timeout,
+		Interval:         actionwait.FixedInterval(actionwait.DefaultPollInterval),
+		ProgressInterval: 60 * time.Second,
+		SuccessStates: []actionwait.Status{
+			rotationStatusCurrent,
+		},
+		TransitionalStates: []actionwait.Status{
+			rotationStatusStarted,
+			rotationStatusPending,
+		},
+		FailureStates: []actionwait.Status{
+			rotationStatusFailed,
+		},
+		ProgressSink: func(fr actionwait.FetchResult[any], meta actionwait.ProgressMeta) {
+			cb(ctx, "Rotation is currently %q (elapsed %s), continuing to wait for completion...", fr.Status, meta.Elapsed.Truncate(time.Second))
+		},
+	})
+
+	if err != nil {
+		// Look up why the rotation did not complete at most once, and only if requested.
+		var cloudTrailReason string
+		if config.LookupCloudTrailEvents.ValueBool() && (errs.IsA[*actionwait.TimeoutError](err) || errs.IsA[*actionwait.FailureStateError](err)) {
+			cloudTrailReason = findRotationFailure(ctx, a.Meta().CloudTrailClient(ctx), aws.ToString(secret.ARN), rotationStartTime)
+		}
+
+		switch {
+		case errs.IsA[*actionwait.TimeoutError](err) && cloudTrailReason != "":
+			resp.Diagnostics.AddError(
+				"Rotation Failed",
+				fmt.Sprintf("Rotation of Secrets Manager secret %s to version %s did not complete within %s: %s. Check the rotation function's logs for errors.", secretID, versionID, timeout, cloudTrailReason),
+			)
+		case errs.IsA[*actionwait.TimeoutError](err):
+			resp.Diagnostics.AddError(
+				"Timeout Waiting for Rotation to Complete",
+				fmt.Sprintf("Rotation of Secrets Manager secret %s to version %s did not complete within %s. Check the rotation function's logs for errors: %s", secretID, versionID, timeout, err),
+			)
+		case errs.IsA[*actionwait.FailureStateError](err):
+			if cloudTrailReason != "" {
+				failureReason = fmt.Sprintf("%s (%s)", failureReason, cloudTrailReason)
+			}
+			resp.Diagnostics.AddError(
+				"Rotation Failed",
+				fmt.Sprintf("Rotation of Secrets Manager secret %s to version %s failed: %s. Check the rotation function's logs for errors.", secretID, versionID, failureReason),
+			)
+		case errs.IsA[*actionwait.UnexpectedStateError](err):
+			resp.Diagnostics.AddError(
+				"Unexpected Rotation State",
+				fmt.Sprintf("Rotation of Secrets Manager secret %s entered unexpected state: %s", secretID, err),
+			)
+		default:
+			resp.Diagnostics.AddError(
+				"Failed While Waiting for Rotation to Complete",
+				fmt.Sprintf("Error waiting for rotation of Secrets Manager secret %s: %s", secretID, err),
+			)
+		}
+		return
+	}
+
+	// Final success message
+	cb(ctx, "Rotation of Secrets Manager secret %s completed successfully, AWSCURRENT moved from version %s to %s", secretID, previousVersionID, versionID)
+
+	tflog.Info(ctx, "Secrets Manager rotate secret action completed successfully")
+}
+
+// findRotationFailure returns a description of the first rotation failure that CloudTrail has recorded
+// for the specified secret since the specified time, or "" if there is none or CloudTrail cannot be queried.
+func findRotationFailure(ctx context.Context, conn *cloudtrail.Client, secretARN string, startTime time.Time) string {
+	for _, eventName := range []string{eventNameRotationFailed, eventNameRotationAbandoned} {
+		input := cloudtrail.LookupEventsInput{
+			LookupAttributes: []cloudtrailtypes.LookupAttribute{{
+				AttributeKey:   cloudtrailtypes.LookupAttributeKeyEventName,
+				AttributeValue: aws.String(eventName),
+			}},
+			StartTime: aws.Time(startTime),
+		}
+
+		pages := cloudtrail.NewLookupEventsPaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				tflog.Debug(ctx, "looking up Secrets Manager rotation CloudTrail events", map[string]any{
+					"error": err.Error(),
+				})
+				return ""
+			}
+
+			for _, v := range page.Events {
+				if strings.Contains(aws.ToString(v.CloudTrailEvent), secretARN) {
+					return fmt.Sprintf("CloudTrail recorded a %s event at %s", eventName, aws.ToTime(v.EventTime).Format(time.RFC3339))
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+func rotationStatus(stages []string) string {
+	switch {
+	case slices.Contains(stages, secretVersionStageCurrent):
+		return rotationStatusCurrent
+	case slices.Contains(stages, secretVersionStagePending):
+		return rotationStatusPending
+	default:
+		return rotationStatusStarted
+	}
+}
+
+func secretVersionIDWithStage(versionIDsToStages map[string][]string, stage string) string {
+	for versionID, stages := range versionIDsToStages {
+		if slices.Contains(stages, stage) {
+			return versionID
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerRotateSecretAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateSecretActionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotateSecretActionRotated(ctx, t, "aws_secretsmanager_secret_version.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerRotateSecretAction_notConfigured(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		CheckDestroy: testAccCheckSecretDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRotateSecretActionConfig_notConfigured(rName),
+				ExpectError: regexache.MustCompile(`Rotation Not Configured`),
+			},
+		},
+	})
+}
+
+// testAccCheckRotateSecretActionRotated verifies that AWSCURRENT has moved away from the version created by Terraform
+func testAccCheckRotateSecretActionRotated(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).SecretsManagerClient(ctx)
+
+		secretID := rs.Primary.Attributes["secret_id"]
+		output, err := tfsecretsmanager.FindSecretByID(ctx, conn, secretID)
+		if err != nil {
+			return fmt.Errorf("reading Secrets Manager Secret (%s): %w", secretID, err)
+		}
+
+		initialVersionID := rs.Primary.Attributes["version_id"]
+		if slices.Contains(output.VersionIdsToStages[initialVersionID], "AWSCURRENT") {
+			return fmt.Errorf("Secrets Manager Secret (%s) AWSCURRENT is still version %s", secretID, initialVersionID)
+		}
+		if !slices.Contains(output.VersionIdsToStages[initialVersionID], "AWSPREVIOUS") {
+			return fmt.Errorf("Secrets Manager Secret (%s) version %s is not AWSPREVIOUS: %v", secretID, initialVersionID, output.VersionIdsToStages)
+		}
+
+		return nil
+	}
+}
+
+func testAccRotateSecretActionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+resource "aws_iam_role_policy" "rotation" {
+  name = "%[1]s-rotation"
+  role = aws_iam_role.iam_for_lambda.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "secretsmanager:DescribeSecret",
+        "secretsmanager:GetSecretValue",
+        "secretsmanager:PutSecretValue",
+        "secretsmanager:UpdateSecretVersionStage",
+      ]
+      Resource = aws_secretsmanager_secret.test.arn
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/rotation.zip"
+  function_name = %[1]q
+  handler       = "rotation.handler"
+  role          = aws_iam_role.iam_for_lambda.arn
+  runtime       = "nodejs24.x"
+
+  depends_on = [aws_iam_role_policy.rotation]
+}
+
+resource "aws_lambda_permission" "test" {
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "secretsmanager.amazonaws.com"
+  statement_id  = "AllowExecutionFromSecretsManager"
+}
+
+resource "aws_secretsmanager_secret_rotation" "test" {
+  secret_id           = aws_secretsmanager_secret_version.test.secret_id
+  rotation_lambda_arn = aws_lambda_function.test.arn
+  rotate_immediately  = false
+
+  rotation_rules {
+    automatically_after_days = 30
+  }
+
+  depends_on = [aws_lambda_permission.test]
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_rotation.test.secret_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_secretsmanager_secret_rotation.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRotateSecretActionConfig_notConfigured(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string"
+}
+
+action "aws_secretsmanager_rotate_secret" "test" {
+  config {
+    secret_id = aws_secretsmanager_secret_version.test.secret_id
+  }
+}
+
+resource "terraform_data" "trigger" {
+  input = aws_secretsmanager_secret_version.test.version_id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_secretsmanager_rotate_secret.test]
+    }
+  }
+}
+`, rName)
+}
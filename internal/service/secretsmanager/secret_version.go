@@ -76,21 +76,36 @@ func resourceSecretVersion() *schema.Resource {
 					Optional:      true,
 					ForceNew:      true,
 					Sensitive:     true,
-					ConflictsWith: []string{"secret_string", "secret_string_wo"},
+					ConflictsWith: []string{"secret_binary_wo", "secret_string", "secret_string_wo"},
 					ValidateFunc:  verify.ValidBase64String,
 				},
+				"secret_binary_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"secret_binary", "secret_string", "secret_string_wo"},
+					RequiredWith:  []string{"secret_binary_wo_version"},
+					ValidateFunc:  verify.ValidBase64String,
+				},
+				"secret_binary_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					RequiredWith: []string{"secret_binary_wo"},
+				},
 				"secret_string": {
 					Type:          schema.TypeString,
 					Optional:      true,
 					Sensitive:     true,
-					ConflictsWith: []string{"secret_binary", "secret_string_wo"},
+					ConflictsWith: []string{"secret_binary", "secret_binary_wo", "secret_string_wo"},
 				},
 				"secret_string_wo": {
 					Type:          schema.TypeString,
 					Optional:      true,
 					WriteOnly:     true,
 					Sensitive:     true,
-					ConflictsWith: []string{"secret_binary", "secret_string"},
+					ConflictsWith: []string{"secret_binary", "secret_binary_wo", "secret_string"},
 					RequiredWith:  []string{"secret_string_wo_version"},
 				},
 				"secret_string_wo_version": {
@@ -133,6 +148,20 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	secretBinaryWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("secret_binary_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if secretBinaryWO != "" {
+		var err error
+		input.SecretBinary, err = inttypes.Base64Decode(secretBinaryWO)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if v, ok := d.GetOk("secret_string"); ok {
 		input.SecretString = aws.String(v.(string))
 	}
@@ -161,7 +190,7 @@ func resourceSecretVersionCreate(ctx context.Context, d *schema.ResourceData, me
 	d.SetId(secretVersionCreateResourceID(secretID, versionID))
 
 	_, err = tfresource.RetryWhenNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
-		return findSecretVersionForExistence(ctx, conn, secretID, versionID, secretStringWO != "")
+		return findSecretVersionForExistence(ctx, conn, secretID, versionID, secretStringWO != "" || secretBinaryWO != "")
 	})
 
 	if err != nil {
@@ -199,7 +228,7 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	hasWriteOnly := flex.HasWriteOnlyValue(d, "secret_string_wo")
+	hasSecretStringWO := flex.HasWriteOnlyValue(d, "secret_string_wo")
 	secretStringWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("secret_string_wo"))
 	diags = append(diags, di...)
 	if diags.HasError() {
@@ -207,9 +236,13 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	if secretStringWO != "" {
-		hasWriteOnly = true
+		hasSecretStringWO = true
 	}
 
+	// The secret binary is never read back when it was set from a write-only value.
+	_, hasSecretBinaryWO := d.GetOk("secret_binary_wo_version")
+	hasWriteOnly := hasSecretStringWO || hasSecretBinaryWO
+
 	d.Set("secret_id", secretID)
 
 	if hasWriteOnly {
@@ -246,7 +279,7 @@ func resourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("secret_string", nil)
 		d.Set("version_id", entry.versionEntry.VersionId)
 		d.Set("version_stages", entry.versionEntry.VersionStages)
-		d.Set("has_secret_string_wo", true)
+		d.Set("has_secret_string_wo", hasSecretStringWO)
 
 		return diags
 	}
@@ -413,7 +446,8 @@ func resourceSecretVersionDelete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	_, err = tfresource.RetryUntilNotFound(ctx, propagationTimeout, func(ctx context.Context) (any, error) {
-		hasWriteOnly := flex.HasWriteOnlyValue(d, "secret_string_wo")
+		_, hasSecretBinaryWO := d.GetOk("secret_binary_wo_version")
+		hasWriteOnly := flex.HasWriteOnlyValue(d, "secret_string_wo") || hasSecretBinaryWO
 		output, err := findSecretVersionForExistence(ctx, conn, secretID, versionID, hasWriteOnly)
 
 		if err != nil {
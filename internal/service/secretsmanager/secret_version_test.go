@@ -417,6 +417,54 @@ func TestAccSecretsManagerSecretVersion_base64Binary(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersion_base64BinaryWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version secretsmanager.GetSecretValueOutput
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfcversion.Must(tfcversion.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretVersionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionConfig_binaryWriteOnly(rName, "test-binary", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, t, resourceName, &version),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, secretResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "has_secret_string_wo", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "secret_binary", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_binary_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_binary_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "version_stages.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "version_stages.*", "AWSCURRENT"),
+				),
+			},
+			{
+				Config: testAccSecretVersionConfig_binaryWriteOnly(rName, "test-binary2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, t, resourceName, &version),
+					resource.TestCheckResourceAttr(resourceName, "secret_binary", ""),
+					resource.TestCheckResourceAttr(resourceName, "secret_binary_wo_version", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccSecretsManagerSecretVersion_versionStages(t *testing.T) {
 	ctx := acctest.Context(t)
 	var version secretsmanager.GetSecretValueOutput
@@ -1503,3 +1551,17 @@ resource "aws_secretsmanager_secret_version" "test" {
 }
 `, rName, secret)
 }
+
+func testAccSecretVersionConfig_binaryWriteOnly(rName, secret string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id                = aws_secretsmanager_secret.test.id
+  secret_binary_wo         = base64encode(%[2]q)
+  secret_binary_wo_version = %[3]d
+}
+`, rName, secret, version)
+}
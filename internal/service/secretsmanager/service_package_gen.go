@@ -22,8 +22,25 @@ import (
 
 type servicePackage struct{}
 
+func (p *servicePackage) Actions(ctx context.Context) []*inttypes.ServicePackageAction {
+	return []*inttypes.ServicePackageAction{
+		{
+			Factory:  newRotateSecretAction,
+			TypeName: "aws_secretsmanager_rotate_secret",
+			Name:     "Rotate Secret",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
+	}
+}
+
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newBatchSecretValueEphemeralResource,
+			TypeName: "aws_secretsmanager_batch_secret_value",
+			Name:     "Batch Secret Value",
+			Region:   inttypes.ResourceRegionDefault(),
+		},
 		{
 			Factory:  newRandomPasswordEphemeralResource,
 			TypeName: "aws_secretsmanager_random_password",
@@ -0,0 +1,42 @@
+// Minimal Secrets Manager rotation function used by acceptance tests.
+// The new secret value is the rotation's client request token.
+import {
+  DescribeSecretCommand,
+  GetSecretValueCommand,
+  PutSecretValueCommand,
+  SecretsManagerClient,
+  UpdateSecretVersionStageCommand,
+} from "@aws-sdk/client-secrets-manager";
+
+const client = new SecretsManagerClient({});
+
+export const handler = async (event) => {
+  const { SecretId: secretId, ClientRequestToken: token, Step: step } = event;
+
+  switch (step) {
+    case "createSecret":
+      try {
+        await client.send(new GetSecretValueCommand({ SecretId: secretId, VersionId: token, VersionStage: "AWSPENDING" }));
+      } catch (e) {
+        if (e.name !== "ResourceNotFoundException") {
+          throw e;
+        }
+        await client.send(new PutSecretValueCommand({ SecretId: secretId, ClientRequestToken: token, SecretString: token, VersionStages: ["AWSPENDING"] }));
+      }
+      return;
+    case "setSecret":
+    case "testSecret":
+      return;
+    case "finishSecret": {
+      const { VersionIdsToStages: stages } = await client.send(new DescribeSecretCommand({ SecretId: secretId }));
+      const current = Object.keys(stages).find((v) => stages[v].includes("AWSCURRENT"));
+      if (current === token) {
+        return;
+      }
+      await client.send(new UpdateSecretVersionStageCommand({ SecretId: secretId, VersionStage: "AWSCURRENT", MoveToVersionId: token, RemoveFromVersionId: current }));
+      return;
+    }
+    default:
+      throw new Error(`unknown step: ${step}`);
+  }
+};
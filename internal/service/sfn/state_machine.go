@@ -36,6 +36,7 @@ import (
 )
 
 // @SDKResource("aws_sfn_state_machine", name="State Machine")
+// @ArnFormat("stateMachine:{name}")
 // @Tags(identifierAttribute="id")
 // @ArnIdentity
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/sfn;sfn.DescribeStateMachineOutput")
@@ -18,12 +18,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -74,9 +76,23 @@ var (
 			ForceNew: true,
 		},
 		"platform_credential": {
-			Type:      schema.TypeString,
-			Required:  true,
-			Sensitive: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"platform_credential", "platform_credential_wo"},
+		},
+		"platform_credential_wo": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			Sensitive:    true,
+			ExactlyOneOf: []string{"platform_credential", "platform_credential_wo"},
+			RequiredWith: []string{"platform_credential_wo_version"},
+		},
+		"platform_credential_wo_version": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			RequiredWith: []string{"platform_credential_wo"},
 		},
 		"platform_principal": {
 			Type:      schema.TypeString,
@@ -133,6 +149,17 @@ func resourcePlatformApplicationCreate(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	// get write-only value from configuration
+	platformCredentialWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("platform_credential_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if platformCredentialWO != "" {
+		attributes[platformApplicationAttributeNamePlatformCredential] = platformCredentialWO
+	}
+
 	name := d.Get(names.AttrName).(string)
 	input := &sns.CreatePlatformApplicationInput{
 		Attributes: attributes,
@@ -199,7 +226,7 @@ func resourcePlatformApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChanges("apple_platform_bundle_id", "apple_platform_team_id", "platform_credential", "platform_principal") {
+	if d.HasChanges("apple_platform_bundle_id", "apple_platform_team_id", "platform_credential", "platform_credential_wo_version", "platform_principal") {
 		// If APNS platform was configured with token-based authentication then the only way to update them
 		// is to update all 4 attributes as they must be specified together in the request.
 		if d.HasChanges("apple_platform_team_id", "apple_platform_bundle_id") {
@@ -218,6 +245,18 @@ func resourcePlatformApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 
 		attributes[platformApplicationAttributeNamePlatformCredential] = d.Get("platform_credential").(string)
+
+		// get write-only value from configuration
+		platformCredentialWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("platform_credential_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if platformCredentialWO != "" {
+			attributes[platformApplicationAttributeNamePlatformCredential] = platformCredentialWO
+		}
+
 		// If the platform requires a principal it must also be specified, even if it didn't change
 		// since credential is stored as a hash, the only way to update principal is to update both
 		// as they must be specified together in the request.
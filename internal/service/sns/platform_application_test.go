@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfsns "github.com/hashicorp/terraform-provider-aws/internal/service/sns"
@@ -212,6 +213,42 @@ func TestAccSNSPlatformApplication_GCM_basic(t *testing.T) {
 	})
 }
 
+func TestAccSNSPlatformApplication_GCM_platformCredentialWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	apiKey := acctest.SkipIfEnvVarNotSet(t, "GCM_API_KEY")
+	resourceName := "aws_sns_platform_application.test"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckPlatformApplicationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPlatformApplicationConfig_gcmPlatformCredentialWriteOnly(rName, apiKey, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlatformApplicationExists(ctx, t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "platform_credential", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "platform_credential_wo"),
+					resource.TestCheckResourceAttr(resourceName, "platform_credential_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccPlatformApplicationConfig_gcmPlatformCredentialWriteOnly(rName, apiKey, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPlatformApplicationExists(ctx, t, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "platform_credential_wo"),
+					resource.TestCheckResourceAttr(resourceName, "platform_credential_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSNSPlatformApplication_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	apiKey := acctest.SkipIfEnvVarNotSet(t, "GCM_API_KEY")
@@ -645,3 +682,14 @@ resource "aws_sns_platform_application" "test" {
 }
 `, name, platform.Name, platform.Credential, platform.Principal, applePlatformTeamId, applePlatformBundleId)
 }
+
+func testAccPlatformApplicationConfig_gcmPlatformCredentialWriteOnly(rName, credentials string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_sns_platform_application" "test" {
+  name                           = %[1]q
+  platform                       = "GCM"
+  platform_credential_wo         = %[2]q
+  platform_credential_wo_version = %[3]d
+}
+`, rName, credentials, version)
+}
@@ -217,6 +217,7 @@ var (
 )
 
 // @SDKResource("aws_sns_topic", name="Topic")
+// @ArnFormat("{name}", resourceType="topic")
 // @Tags(identifierAttribute="arn")
 // @ArnIdentity
 // @Testing(preIdentityVersion="v6.4.0")
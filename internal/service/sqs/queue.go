@@ -196,6 +196,7 @@ var (
 )
 
 // @SDKResource("aws_sqs_queue", name="Queue")
+// @ArnFormat("{name}", attribute="arn", resourceType="queue")
 // @Tags(identifierAttribute="id")
 // @IdentityVersion(1)
 // @CustomInherentRegionIdentity("url", "parseQueueURL")
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sso"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource(aws_sso_role_credentials, name="Role Credentials")
+func newRoleCredentialsEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &roleCredentialsEphemeralResource{}, nil
+}
+
+type roleCredentialsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[roleCredentialsEphemeralResourceModel]
+}
+
+func (e *roleCredentialsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"access_token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"session_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *roleCredentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data roleCredentialsEphemeralResourceModel
+	conn := e.Meta().SSOClient(ctx)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := sso.GetRoleCredentialsInput{
+		AccessToken: fwflex.StringFromFramework(ctx, data.AccessToken),
+		AccountId:   fwflex.StringFromFramework(ctx, data.AccountID),
+		RoleName:    fwflex.StringFromFramework(ctx, data.RoleName),
+	}
+
+	output, err := conn.GetRoleCredentials(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.AccountID.ValueString()+"/"+data.RoleName.ValueString())
+		return
+	}
+
+	if output == nil || output.RoleCredentials == nil {
+		smerr.AddError(ctx, &response.Diagnostics, tfresource.NewEmptyResultError(), smerr.ID, data.AccountID.ValueString()+"/"+data.RoleName.ValueString())
+		return
+	}
+
+	credentials := output.RoleCredentials
+	// The expiration is returned in milliseconds since the Unix epoch.
+	expiration := time.UnixMilli(credentials.Expiration).UTC()
+
+	data.AccessKeyID = fwflex.StringToFramework(ctx, credentials.AccessKeyId)
+	data.Expiration = timetypes.NewRFC3339TimeValue(expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, credentials.SessionToken)
+
+	smerr.AddEnrich(ctx, &response.Diagnostics, response.Result.Set(ctx, &data))
+}
+
+type roleCredentialsEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AccessKeyID     types.String      `tfsdk:"access_key_id"`
+	AccessToken     types.String      `tfsdk:"access_token"`
+	AccountID       types.String      `tfsdk:"account_id"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration"`
+	RoleName        types.String      `tfsdk:"role_name"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	SessionToken    types.String      `tfsdk:"session_token"`
+}
@@ -15,12 +15,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/storagegateway"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/storagegateway/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -93,12 +95,26 @@ func resourceFileSystemAssociation() *schema.Resource {
 				},
 				names.AttrPassword: {
 					Type:      schema.TypeString,
-					Required:  true,
+					Optional:  true,
 					Sensitive: true,
 					ValidateFunc: validation.All(
 						validation.StringMatch(regexache.MustCompile(`^[ -~]+$`), ""),
 						validation.StringLenBetween(1, 1024),
 					),
+					ExactlyOneOf: []string{"password", "password_wo"},
+				},
+				"password_wo": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					Sensitive:    true,
+					ExactlyOneOf: []string{"password", "password_wo"},
+					RequiredWith: []string{"password_wo_version"},
+				},
+				"password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"password_wo"},
 				},
 				names.AttrTags:    tftags.TagsSchema(),
 				names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -129,6 +145,17 @@ func resourceFileSystemAssociationCreate(ctx context.Context, d *schema.Resource
 		UserName:    aws.String(d.Get(names.AttrUsername).(string)),
 	}
 
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if passwordWO != "" {
+		input.Password = aws.String(passwordWO)
+	}
+
 	if v, ok := d.GetOk("audit_destination_arn"); ok {
 		input.AuditDestinationARN = aws.String(v.(string))
 	}
@@ -193,6 +220,17 @@ func resourceFileSystemAssociationUpdate(ctx context.Context, d *schema.Resource
 			UserName:                 aws.String(d.Get(names.AttrUsername).(string)),
 		}
 
+		// get write-only value from configuration
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input.Password = aws.String(passwordWO)
+		}
+
 		if v, ok := d.GetOk("cache_attributes"); ok {
 			input.CacheAttributes = expandFileSystemAssociationCacheAttributes(v.([]any))
 		}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tffsx "github.com/hashicorp/terraform-provider-aws/internal/service/fsx"
@@ -58,6 +59,44 @@ func TestAccStorageGatewayFileSystemAssociation_basic(t *testing.T) {
 	})
 }
 
+func TestAccStorageGatewayFileSystemAssociation_passwordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var fileSystemAssociation awstypes.FileSystemAssociationInfo
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_file_system_association.test"
+	domainName := acctest.RandomDomainName(t)
+	username := "Admin"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.StorageGateway) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckFileSystemAssociationDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFileSystemAssociationConfig_passwordWriteOnly(rName, domainName, username, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileSystemAssociationExists(ctx, t, resourceName, &fileSystemAssociation),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPassword, ""),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccFileSystemAssociationConfig_passwordWriteOnly(rName, domainName, username, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFileSystemAssociationExists(ctx, t, resourceName, &fileSystemAssociation),
+					resource.TestCheckNoResourceAttr(resourceName, "password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageGatewayFileSystemAssociation_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var fileSystemAssociation awstypes.FileSystemAssociationInfo
@@ -472,3 +511,15 @@ resource "aws_storagegateway_file_system_association" "test" {
 }
 `, username, cache))
 }
+
+func testAccFileSystemAssociationConfig_passwordWriteOnly(rName, domainName, username string, version int) string {
+	return acctest.ConfigCompose(testAccFileSystemAssociationConfig_base(rName, domainName, username), fmt.Sprintf(`
+resource "aws_storagegateway_file_system_association" "test" {
+  gateway_arn         = aws_storagegateway_gateway.test.arn
+  location_arn        = aws_fsx_windows_file_system.test.arn
+  username            = %[1]q
+  password_wo         = aws_directory_service_directory.test.password
+  password_wo_version = %[2]d
+}
+`, username, version))
+}
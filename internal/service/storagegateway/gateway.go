@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/storagegateway"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/storagegateway/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -217,12 +218,26 @@ func resourceGateway() *schema.Resource {
 							},
 							names.AttrPassword: {
 								Type:      schema.TypeString,
-								Required:  true,
+								Optional:  true,
 								Sensitive: true,
 								ValidateFunc: validation.All(
 									validation.StringMatch(regexache.MustCompile(`^[ -~]+$`), ""),
 									validation.StringLenBetween(1, 1024),
 								),
+								ExactlyOneOf: []string{"smb_active_directory_settings.0.password", "smb_active_directory_settings.0.password_wo"},
+							},
+							"password_wo": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								WriteOnly:    true,
+								Sensitive:    true,
+								ExactlyOneOf: []string{"smb_active_directory_settings.0.password", "smb_active_directory_settings.0.password_wo"},
+								RequiredWith: []string{"smb_active_directory_settings.0.password_wo_version"},
+							},
+							"password_wo_version": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								RequiredWith: []string{"smb_active_directory_settings.0.password_wo"},
 							},
 							"timeout_in_seconds": {
 								Type:         schema.TypeInt,
@@ -253,6 +268,20 @@ func resourceGateway() *schema.Resource {
 						validation.StringMatch(regexache.MustCompile(`^[ -~]+$`), ""),
 						validation.StringLenBetween(6, 512),
 					),
+					ConflictsWith: []string{"smb_guest_password_wo"},
+				},
+				"smb_guest_password_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ConflictsWith: []string{"smb_guest_password"},
+					RequiredWith:  []string{"smb_guest_password_wo_version"},
+				},
+				"smb_guest_password_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"smb_guest_password_wo"},
 				},
 				"smb_security_strategy": {
 					Type:             schema.TypeString,
@@ -406,6 +435,17 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta any
 	if v, ok := d.GetOk("smb_active_directory_settings"); ok && len(v.([]any)) > 0 {
 		input := expandJoinDomainInput(v.([]any), d.Id())
 
+		// get write-only value from configuration
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("smb_active_directory_settings").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input.Password = aws.String(passwordWO)
+		}
+
 		_, err := conn.JoinDomain(ctx, input)
 
 		if err != nil {
@@ -417,10 +457,22 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 	}
 
-	if v, ok := d.GetOk("smb_guest_password"); ok && v.(string) != "" {
+	// get write-only value from configuration
+	smbGuestPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("smb_guest_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	smbGuestPassword := d.Get("smb_guest_password").(string)
+	if smbGuestPasswordWO != "" {
+		smbGuestPassword = smbGuestPasswordWO
+	}
+
+	if smbGuestPassword != "" {
 		input := storagegateway.SetSMBGuestPasswordInput{
 			GatewayARN: aws.String(d.Id()),
-			Password:   aws.String(v.(string)),
+			Password:   aws.String(smbGuestPassword),
 		}
 
 		_, err := conn.SetSMBGuestPassword(ctx, &input)
@@ -547,6 +599,7 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta any)
 		if v, ok := d.GetOk("smb_active_directory_settings"); ok && len(v.([]any)) > 0 {
 			configM := v.([]any)[0].(map[string]any)
 			tfMap[names.AttrPassword] = configM[names.AttrPassword]
+			tfMap["password_wo_version"] = configM["password_wo_version"]
 			tfMap["timeout_in_seconds"] = configM["timeout_in_seconds"]
 			tfMap[names.AttrUsername] = configM[names.AttrUsername]
 
@@ -647,6 +700,17 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any
 	if d.HasChange("smb_active_directory_settings") {
 		input := expandJoinDomainInput(d.Get("smb_active_directory_settings").([]any), d.Id())
 
+		// get write-only value from configuration
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("smb_active_directory_settings").IndexInt(0).GetAttr("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input.Password = aws.String(passwordWO)
+		}
+
 		_, err := conn.JoinDomain(ctx, input)
 
 		if err != nil {
@@ -658,12 +722,23 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta any
 		}
 	}
 
-	if d.HasChange("smb_guest_password") {
+	if d.HasChanges("smb_guest_password", "smb_guest_password_wo_version") {
 		input := storagegateway.SetSMBGuestPasswordInput{
 			GatewayARN: aws.String(d.Id()),
 			Password:   aws.String(d.Get("smb_guest_password").(string)),
 		}
 
+		// get write-only value from configuration
+		smbGuestPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("smb_guest_password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if smbGuestPasswordWO != "" {
+			input.Password = aws.String(smbGuestPasswordWO)
+		}
+
 		_, err := conn.SetSMBGuestPassword(ctx, &input)
 
 		if err != nil {
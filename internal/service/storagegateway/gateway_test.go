@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tfstoragegateway "github.com/hashicorp/terraform-provider-aws/internal/service/storagegateway"
@@ -582,6 +583,75 @@ func TestAccStorageGatewayGateway_smbGuestPassword(t *testing.T) {
 	})
 }
 
+func TestAccStorageGatewayGateway_smbGuestPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway awstypes.GatewayInfo
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckGatewayDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_smbGuestPasswordWriteOnly(rName, "myguestpassword1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, t, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "smb_guest_password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "smb_guest_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "smb_guest_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccGatewayConfig_smbGuestPasswordWriteOnly(rName, "myguestpassword2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, t, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "smb_guest_password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "smb_guest_password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "smb_guest_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageGatewayGateway_smbActiveDirectorySettingsPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var gateway awstypes.GatewayInfo
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_storagegateway_gateway.test"
+	domainName := acctest.RandomDomainName(t)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.StorageGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		CheckDestroy: testAccCheckGatewayDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGatewayConfig_smbActiveDirectorySettingsPasswordWriteOnly(rName, domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGatewayExists(ctx, t, resourceName, &gateway),
+					resource.TestCheckResourceAttr(resourceName, "smb_active_directory_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "smb_active_directory_settings.0.domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "smb_active_directory_settings.0.password", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "smb_active_directory_settings.0.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "smb_active_directory_settings.0.password_wo_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "smb_active_directory_settings.0.active_directory_status"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageGatewayGateway_smbSecurityStrategy(t *testing.T) {
 	ctx := acctest.Context(t)
 	var gateway awstypes.GatewayInfo
@@ -1569,3 +1639,37 @@ resource "aws_ec2_instance_state" "test" {
 }
 `)
 }
+
+func testAccGatewayConfig_smbGuestPasswordWriteOnly(rName, smbGuestPassword string, version int) string {
+	return acctest.ConfigCompose(testAccGatewayConfig_baseFileS3(rName), fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address            = aws_instance.test.public_ip
+  gateway_name                  = %[1]q
+  gateway_timezone              = "GMT"
+  gateway_type                  = "FILE_S3"
+  smb_guest_password_wo         = %[2]q
+  smb_guest_password_wo_version = %[3]d
+}
+`, rName, smbGuestPassword, version))
+}
+
+func testAccGatewayConfig_smbActiveDirectorySettingsPasswordWriteOnly(rName, domainName string) string {
+	return acctest.ConfigCompose(
+		testAccGatewaySMBActiveDirectorySettingsBaseConfig(rName),
+		testAccGatewayConfig_DirectoryServiceSimpleDirectory(rName, domainName),
+		fmt.Sprintf(`
+resource "aws_storagegateway_gateway" "test" {
+  gateway_ip_address = aws_instance.test.public_ip
+  gateway_name       = %[1]q
+  gateway_timezone   = "GMT"
+  gateway_type       = "FILE_S3"
+
+  smb_active_directory_settings {
+    domain_name         = aws_directory_service_directory.test.name
+    password_wo         = aws_directory_service_directory.test.password
+    password_wo_version = 1
+    username            = "Administrator"
+  }
+}
+`, rName))
+}
@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_sts_assume_role_credentials", name="Assume Role Credentials")
+func newAssumeRoleCredentialsEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &assumeRoleCredentialsEphemeralResource{}, nil
+}
+
+type assumeRoleCredentialsEphemeralResource struct {
+	framework.EphemeralResourceWithModel[assumeRoleCredentialsEphemeralResourceModel]
+}
+
+func (e *assumeRoleCredentialsEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The access key ID of the temporary credentials.",
+			},
+			"assumed_role_arn": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ARN of the assumed role session.",
+			},
+			"assumed_role_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the assumed role session, in the form `role-id:role-session-name`.",
+			},
+			"duration_seconds": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(900, 43200),
+				},
+				Description: "The duration, in seconds, of the role session. Value can range from 900 seconds (15 minutes) up to the maximum session duration setting of the role. Default is 3600 seconds (1 hour).",
+			},
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the credentials in RFC3339 format.",
+			},
+			names.AttrExternalID: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 1224),
+				},
+				Description: "A unique identifier that might be required when you assume a role in another account.",
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType:  fwtypes.IAMPolicyType,
+				Optional:    true,
+				Description: "An IAM policy in JSON format to use as an inline session policy.",
+			},
+			"policy_arns": schema.SetAttribute{
+				CustomType: fwtypes.SetOfARNType,
+				Optional:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(10),
+				},
+				Description: "The ARNs of IAM managed policies to use as managed session policies.",
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Required:    true,
+				Description: "The ARN of the role to assume.",
+			},
+			"role_session_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@-]*$`), ""),
+				},
+				Description: "An identifier for the assumed role session. Defaults to a unique name generated by the provider.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret access key of the temporary credentials.",
+			},
+			"serial_number": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(9, 256),
+					stringvalidator.AlsoRequires(path.MatchRoot("token_code")),
+				},
+				Description: "The identification number of the MFA device that is associated with the user who is making the call.",
+			},
+			"session_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The session token of the temporary credentials.",
+			},
+			"source_identity": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 64),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[\w+=,.@-]*$`), ""),
+				},
+				Description: "The source identity specified by the principal that is calling the operation.",
+			},
+			names.AttrTags: tftags.TagsAttribute(),
+			"token_code": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]{6}$`), "must be a 6-digit code"),
+					stringvalidator.AlsoRequires(path.MatchRoot("serial_number")),
+				},
+				Description: "The value provided by the MFA device.",
+			},
+			"transitive_tag_keys": schema.SetAttribute{
+				CustomType: fwtypes.SetOfStringType,
+				Optional:   true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(50),
+				},
+				Description: "The keys of the session tags that persist across role chaining.",
+			},
+		},
+	}
+}
+
+func (e *assumeRoleCredentialsEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().STSClient(ctx)
+	var data assumeRoleCredentialsEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input sts.AssumeRoleInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RoleSessionName.IsNull() {
+		input.RoleSessionName = aws.String(create.UniqueId(ctx))
+	}
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs) {
+		input.PolicyArns = append(input.PolicyArns, awstypes.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+	// expand tags since this is not using transparent tagging
+	input.Tags = expandTags(ctx, data.Tags)
+
+	output, err := conn.AssumeRole(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err, smerr.ID, data.RoleARN.ValueString())
+		return
+	}
+
+	data.AssumedRoleARN = fwflex.StringToFramework(ctx, output.AssumedRoleUser.Arn)
+	data.AssumedRoleID = fwflex.StringToFramework(ctx, output.AssumedRoleUser.AssumedRoleId)
+	data.AccessKeyID = fwflex.StringToFramework(ctx, output.Credentials.AccessKeyId)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(output.Credentials.Expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, output.Credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, output.Credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type assumeRoleCredentialsEphemeralResourceModel struct {
+	AccessKeyID       types.String        `tfsdk:"access_key_id" autoflex:"-"`
+	AssumedRoleARN    types.String        `tfsdk:"assumed_role_arn" autoflex:"-"`
+	AssumedRoleID     types.String        `tfsdk:"assumed_role_id" autoflex:"-"`
+	DurationSeconds   types.Int32         `tfsdk:"duration_seconds"`
+	Expiration        timetypes.RFC3339   `tfsdk:"expiration" autoflex:"-"`
+	ExternalID        types.String        `tfsdk:"external_id"`
+	Policy            fwtypes.IAMPolicy   `tfsdk:"policy"`
+	PolicyARNs        fwtypes.SetOfARN    `tfsdk:"policy_arns" autoflex:"-"`
+	RoleARN           fwtypes.ARN         `tfsdk:"role_arn"`
+	RoleSessionName   types.String        `tfsdk:"role_session_name"`
+	SecretAccessKey   types.String        `tfsdk:"secret_access_key" autoflex:"-"`
+	SerialNumber      types.String        `tfsdk:"serial_number"`
+	SessionToken      types.String        `tfsdk:"session_token" autoflex:"-"`
+	SourceIdentity    types.String        `tfsdk:"source_identity"`
+	Tags              tftags.Map          `tfsdk:"tags" autoflex:"-"`
+	TokenCode         types.String        `tfsdk:"token_code"`
+	TransitiveTagKeys fwtypes.SetOfString `tfsdk:"transitive_tag_keys"`
+}
+
+func expandTags(ctx context.Context, data tftags.Map) []awstypes.Tag {
+	if data.IsNull() {
+		return nil
+	}
+
+	tags := tftags.New(ctx, data)
+	apiObjects := make([]awstypes.Tag, 0, len(tags.Map()))
+	for k, v := range tags.Map() {
+		apiObjects = append(apiObjects, awstypes.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return apiObjects
+}
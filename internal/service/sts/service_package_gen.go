@@ -21,6 +21,18 @@ type servicePackage struct{}
 
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.ServicePackageEphemeralResource {
 	return []*inttypes.ServicePackageEphemeralResource{
+		{
+			Factory:  newAssumeRoleCredentialsEphemeralResource,
+			TypeName: "aws_sts_assume_role_credentials",
+			Name:     "Assume Role Credentials",
+			Region:   inttypes.ResourceRegionDisabled(),
+		},
+		{
+			Factory:  newSessionTokenEphemeralResource,
+			TypeName: "aws_sts_session_token",
+			Name:     "Session Token",
+			Region:   inttypes.ResourceRegionDisabled(),
+		},
 		{
 			Factory:  newWebIdentityTokenEphemeralResource,
 			TypeName: "aws_sts_web_identity_token",
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sts
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/smerr"
+)
+
+// @EphemeralResource("aws_sts_session_token", name="Session Token")
+func newSessionTokenEphemeralResource(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &sessionTokenEphemeralResource{}, nil
+}
+
+type sessionTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[sessionTokenEphemeralResourceModel]
+}
+
+func (e *sessionTokenEphemeralResource) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The access key ID of the temporary credentials.",
+			},
+			"duration_seconds": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(900, 129600),
+				},
+				Description: "The duration, in seconds, that the credentials should remain valid. Value can range from 900 seconds (15 minutes) to 129600 seconds (36 hours). Default is 43200 seconds (12 hours).",
+			},
+			"expiration": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				Description: "The expiration time of the credentials in RFC3339 format.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret access key of the temporary credentials.",
+			},
+			"serial_number": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(9, 256),
+					stringvalidator.AlsoRequires(path.MatchRoot("token_code")),
+				},
+				Description: "The identification number of the MFA device that is associated with the IAM user who is making the call.",
+			},
+			"session_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The session token of the temporary credentials.",
+			},
+			"token_code": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9]{6}$`), "must be a 6-digit code"),
+					stringvalidator.AlsoRequires(path.MatchRoot("serial_number")),
+				},
+				Description: "The value provided by the MFA device.",
+			},
+		},
+	}
+}
+
+func (e *sessionTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	conn := e.Meta().STSClient(ctx)
+	var data sessionTokenEphemeralResourceModel
+	smerr.AddEnrich(ctx, &response.Diagnostics, request.Config.Get(ctx, &data))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var input sts.GetSessionTokenInput
+	smerr.AddEnrich(ctx, &response.Diagnostics, fwflex.Expand(ctx, data, &input))
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetSessionToken(ctx, &input)
+	if err != nil {
+		smerr.AddError(ctx, &response.Diagnostics, err)
+		return
+	}
+
+	data.AccessKeyID = fwflex.StringToFramework(ctx, output.Credentials.AccessKeyId)
+	data.Expiration = timetypes.NewRFC3339TimePointerValue(output.Credentials.Expiration)
+	data.SecretAccessKey = fwflex.StringToFramework(ctx, output.Credentials.SecretAccessKey)
+	data.SessionToken = fwflex.StringToFramework(ctx, output.Credentials.SessionToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type sessionTokenEphemeralResourceModel struct {
+	AccessKeyID     types.String      `tfsdk:"access_key_id" autoflex:"-"`
+	DurationSeconds types.Int32       `tfsdk:"duration_seconds"`
+	Expiration      timetypes.RFC3339 `tfsdk:"expiration" autoflex:"-"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key" autoflex:"-"`
+	SerialNumber    types.String      `tfsdk:"serial_number"`
+	SessionToken    types.String      `tfsdk:"session_token" autoflex:"-"`
+	TokenCode       types.String      `tfsdk:"token_code"`
+}
@@ -6,9 +6,7 @@ package sts
 import (
 	"context"
 
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
-	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -92,20 +90,7 @@ func (e *webIdentityTokenEphemeralResource) Open(ctx context.Context, request ep
 	}
 
 	// expand tags since this is not using transparent tagging
-	if !data.Tags.IsNull() {
-		tags := tftags.New(ctx, data.Tags)
-		tagMap := make([]awstypes.Tag, 0, len(tags.Map()))
-		for k, v := range tags.Map() {
-			tag := awstypes.Tag{
-				Key:   aws.String(k),
-				Value: aws.String(v),
-			}
-
-			tagMap = append(tagMap, tag)
-		}
-
-		input.Tags = tagMap
-	}
+	input.Tags = expandTags(ctx, data.Tags)
 
 	output, err := conn.GetWebIdentityToken(ctx, &input)
 	if err != nil {
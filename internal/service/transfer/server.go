@@ -16,6 +16,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/transfer"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -149,10 +150,25 @@ func resourceServer() *schema.Resource {
 					ValidateFunc: verify.ValidARN,
 				},
 				"host_key": {
-					Type:         schema.TypeString,
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(0, 4096),
+					ConflictsWith: []string{"host_key_wo"},
+				},
+				"host_key_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					WriteOnly:     true,
+					Sensitive:     true,
+					ValidateFunc:  validation.StringLenBetween(0, 4096),
+					ConflictsWith: []string{"host_key"},
+					RequiredWith:  []string{"host_key_wo_version"},
+				},
+				"host_key_wo_version": {
+					Type:         schema.TypeInt,
 					Optional:     true,
-					Sensitive:    true,
-					ValidateFunc: validation.StringLenBetween(0, 4096),
+					RequiredWith: []string{"host_key_wo"},
 				},
 				"host_key_fingerprint": {
 					Type:     schema.TypeString,
@@ -387,6 +403,17 @@ func resourceServerCreate(ctx context.Context, d *schema.ResourceData, meta any)
 		input.HostKey = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	hostKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("host_key_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if hostKeyWO != "" {
+		input.HostKey = aws.String(hostKeyWO)
+	}
+
 	if v, ok := d.GetOk("identity_provider_type"); ok {
 		input.IdentityProviderType = awstypes.IdentityProviderType(v.(string))
 	}
@@ -702,6 +729,19 @@ func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 			}
 		}
 
+		if d.HasChange("host_key_wo_version") {
+			// get write-only value from configuration
+			hostKeyWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("host_key_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if hostKeyWO != "" {
+				input.HostKey = aws.String(hostKeyWO)
+			}
+		}
+
 		if d.HasChanges("directory_id", "function", "invocation_role", "sftp_authentication_methods", names.AttrURL) {
 			identityProviderDetails := &awstypes.IdentityProviderDetails{}
 
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	tftransfer "github.com/hashicorp/terraform-provider-aws/internal/service/transfer"
@@ -1241,6 +1242,36 @@ func testAccServer_hostKey(t *testing.T) {
 	})
 }
 
+func testAccServer_hostKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.DescribedServer
+	resourceName := "aws_transfer_server.test"
+	hostKey := "test-fixtures/transfer-ssh-rsa-key"
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+
+	acctest.Test(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TransferServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServerDestroy(ctx, t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerConfig_hostKeyWriteOnly(rName, hostKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServerExists(ctx, t, resourceName, &conf),
+					resource.TestCheckNoResourceAttr(resourceName, "host_key"),
+					resource.TestCheckNoResourceAttr(resourceName, "host_key_wo"),
+					resource.TestCheckResourceAttr(resourceName, "host_key_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "host_key_fingerprint", "SHA256:Z2pW9sPKDD/T34tVfCoolsRcECNTlekgaKvDn9t+9sg="),
+				),
+			},
+		},
+	})
+}
+
 func testAccServer_vpcEndpointID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.DescribedServer
@@ -2649,3 +2680,16 @@ resource "aws_transfer_server" "test" {
 }
 `, rName, ipAddressType))
 }
+
+func testAccServerConfig_hostKeyWriteOnly(rName, hostKey string) string {
+	return fmt.Sprintf(`
+resource "aws_transfer_server" "test" {
+  host_key_wo         = file(%[2]q)
+  host_key_wo_version = 1
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, hostKey)
+}
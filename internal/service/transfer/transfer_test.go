@@ -47,6 +47,7 @@ func TestAccTransfer_serial(t *testing.T) {
 			"Domain":                     testAccServer_domain,
 			"ForceDestroy":               testAccServer_forceDestroy,
 			"HostKey":                    testAccServer_hostKey,
+			"HostKeyWriteOnly":           testAccServer_hostKeyWriteOnly,
 			"IPAddressType":              testAccServer_ipAddressType,
 			"IPAddressTypeWithVPCAddressAllocationIDs": testAccServer_ipAddressTypeWithVPCAddressAllocationIDs,
 			"LambdaFunction":                                         testAccServer_lambdaFunction,
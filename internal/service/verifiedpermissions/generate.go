@@ -4,6 +4,7 @@
 //go:generate go run ../../generate/tags/main.go -ListTags -KVTValues=true -ServiceTagsMap -UpdateTags
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/identitytests/main.go
+//go:generate go run ../../generate/autoflexchecks/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package verifiedpermissions
@@ -247,6 +247,9 @@ func (r *policyStoreResource) Delete(ctx context.Context, request resource.Delet
 	}
 }
 
+// @AutoFlexCheck(expand="github.com/aws/aws-sdk-go-v2/service/verifiedpermissions;verifiedpermissions.CreatePolicyStoreInput", ignore="ClientToken;EncryptionSettings")
+// @AutoFlexCheck(expand="github.com/aws/aws-sdk-go-v2/service/verifiedpermissions;verifiedpermissions.UpdatePolicyStoreInput")
+// @AutoFlexCheck(flatten="github.com/aws/aws-sdk-go-v2/service/verifiedpermissions;verifiedpermissions.GetPolicyStoreOutput", ignore="CedarVersion;CreatedDate;EncryptionState;LastUpdatedDate")
 type policyStoreResourceModel struct {
 	framework.WithRegionModel
 	ARN                types.String                                        `tfsdk:"arn"`
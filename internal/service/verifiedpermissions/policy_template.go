@@ -239,6 +239,9 @@ func (r *policyTemplateResource) Delete(ctx context.Context, request resource.De
 	}
 }
 
+// @AutoFlexCheck(expand="github.com/aws/aws-sdk-go-v2/service/verifiedpermissions;verifiedpermissions.CreatePolicyTemplateInput", ignore="ClientToken;Name")
+// @AutoFlexCheck(expand="github.com/aws/aws-sdk-go-v2/service/verifiedpermissions;verifiedpermissions.UpdatePolicyTemplateInput", ignore="Name")
+// @AutoFlexCheck(flatten="github.com/aws/aws-sdk-go-v2/service/verifiedpermissions;verifiedpermissions.GetPolicyTemplateOutput", ignore="LastUpdatedDate;Name")
 type policyTemplateResourceModel struct {
 	framework.WithRegionModel
 	CreatedDate      timetypes.RFC3339 `tfsdk:"created_date"`
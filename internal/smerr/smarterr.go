@@ -10,6 +10,7 @@ import (
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -21,8 +22,12 @@ const (
 // This is smarterr wrapping to inject private context into keyvals for the SDK and Framework diagnostics.
 
 // Append enriches smarterr.Append with resource and service context if available.
+// The appended diagnostic's detail carries the error's machine-readable trailer.
 func Append(ctx context.Context, diags sdkdiag.Diagnostics, err error, keyvals ...any) sdkdiag.Diagnostics {
-	return smarterr.Append(ctx, diags, err, injectContext(ctx, keyvals...)...)
+	n := len(diags)
+	diags = smarterr.Append(ctx, diags, err, injectContext(ctx, keyvals...)...)
+	sdkWithTrailer(diags[n:], err)
+	return diags
 }
 
 // AppendOne enriches smarterr.AppendOne with resource and service context if available.
@@ -36,8 +41,11 @@ func AppendEnrich(ctx context.Context, existing sdkdiag.Diagnostics, incoming sd
 }
 
 // AddError enriches smarterr.AddError with resource and service context if available.
+// The added diagnostic's detail carries the error's machine-readable trailer.
 func AddError(ctx context.Context, diags *fwdiag.Diagnostics, err error, keyvals ...any) {
+	n := len(*diags)
 	smarterr.AddError(ctx, diags, err, injectContext(ctx, keyvals...)...)
+	fwWithTrailer((*diags)[n:], err)
 }
 
 // AddOne enriches smarterr.AddOne with resource and service context if available.
@@ -67,3 +75,35 @@ func injectContext(ctx context.Context, keyvals ...any) []any {
 	}
 	return keyvals
 }
+
+// sdkWithTrailer appends the error's machine-readable trailer to the details of the specified diagnostics.
+func sdkWithTrailer(diags sdkdiag.Diagnostics, err error) {
+	for i := range diags {
+		diags[i].Detail = errs.WithTrailer(diags[i].Detail, err)
+	}
+}
+
+// fwWithTrailer appends the error's machine-readable trailer to the details of the specified diagnostics.
+func fwWithTrailer(diags fwdiag.Diagnostics, err error) {
+	for i, d := range diags {
+		detail := errs.WithTrailer(d.Detail(), err)
+		if detail == d.Detail() {
+			continue
+		}
+
+		var v fwdiag.Diagnostic
+		switch d.Severity() {
+		case fwdiag.SeverityError:
+			v = fwdiag.NewErrorDiagnostic(d.Summary(), detail)
+		case fwdiag.SeverityWarning:
+			v = fwdiag.NewWarningDiagnostic(d.Summary(), detail)
+		default:
+			continue
+		}
+		if d, ok := d.(fwdiag.DiagnosticWithPath); ok {
+			v = fwdiag.WithPath(d.Path(), v)
+		}
+
+		diags[i] = v
+	}
+}
@@ -62,6 +62,12 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceQuota represents the service quota that creating a resource counts against.
+type ServicePackageResourceQuota struct {
+	ServiceCode string // Service Quotas service code, e.g. "vpc"
+	QuotaCode   string // Service Quotas quota code, e.g. "L-F678F1CE"
+}
+
 // ServicePackageAction represents a Terraform Plugin Framework action
 // implemented by a service package.
 type ServicePackageAction struct {
@@ -98,6 +104,7 @@ type ServicePackageFrameworkResource struct {
 	Name     string
 	Tags     unique.Handle[ServicePackageResourceTags]
 	Region   unique.Handle[ServicePackageResourceRegion]
+	Quota    unique.Handle[ServicePackageResourceQuota]
 	Identity Identity
 	Import   FrameworkImport
 }
@@ -129,6 +136,7 @@ type ServicePackageSDKResource struct {
 	Name     string
 	Tags     unique.Handle[ServicePackageResourceTags]
 	Region   unique.Handle[ServicePackageResourceRegion]
+	Quota    unique.Handle[ServicePackageResourceQuota]
 	Identity Identity
 	Import   SDKv2Import
 }
@@ -8738,7 +8738,6 @@ service "sso" {
   endpoint_info {
     endpoint_api_call   = "ListAccounts"
     endpoint_api_params = "AccessToken: aws.String(\"mock-access-token\")"
-    endpoint_only       = true
   }
 
   resource_prefix {
@@ -8748,7 +8747,6 @@ service "sso" {
   provider_package_correct = "sso"
   doc_prefix               = ["sso_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "ssoadmin" {
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package names
+
+import (
+	"strings"
+)
+
+// iamActionOverrides maps IAM actions derived from an operation name to the actual IAM action,
+// for operations whose IAM action is not named after the operation.
+var iamActionOverrides = map[string]string{
+	"lambda:Invoke":                         "lambda:InvokeFunction",
+	"lambda:InvokeAsync":                    "lambda:InvokeFunction",
+	"lambda:InvokeWithResponseStream":       "lambda:InvokeFunction",
+	"s3:CompleteMultipartUpload":            "s3:PutObject",
+	"s3:CopyObject":                         "s3:PutObject",
+	"s3:CreateMultipartUpload":              "s3:PutObject",
+	"s3:DeleteBucketEncryption":             "s3:PutEncryptionConfiguration",
+	"s3:DeleteBucketLifecycle":              "s3:PutLifecycleConfiguration",
+	"s3:DeleteBucketReplication":            "s3:PutReplicationConfiguration",
+	"s3:DeleteObjects":                      "s3:DeleteObject",
+	"s3:DeletePublicAccessBlock":            "s3:PutBucketPublicAccessBlock",
+	"s3:GetBucketEncryption":                "s3:GetEncryptionConfiguration",
+	"s3:GetBucketLifecycleConfiguration":    "s3:GetLifecycleConfiguration",
+	"s3:GetBucketNotificationConfiguration": "s3:GetBucketNotification",
+	"s3:GetBucketReplication":               "s3:GetReplicationConfiguration",
+	"s3:GetObjectLockConfiguration":         "s3:GetBucketObjectLockConfiguration",
+	"s3:GetPublicAccessBlock":               "s3:GetBucketPublicAccessBlock",
+	"s3:HeadBucket":                         "s3:ListBucket",
+	"s3:HeadObject":                         "s3:GetObject",
+	"s3:ListBuckets":                        "s3:ListAllMyBuckets",
+	"s3:ListMultipartUploads":               "s3:ListBucketMultipartUploads",
+	"s3:ListObjectVersions":                 "s3:ListBucketVersions",
+	"s3:ListObjects":                        "s3:ListBucket",
+	"s3:ListObjectsV2":                      "s3:ListBucket",
+	"s3:PutBucketEncryption":                "s3:PutEncryptionConfiguration",
+	"s3:PutBucketLifecycleConfiguration":    "s3:PutLifecycleConfiguration",
+	"s3:PutBucketNotificationConfiguration": "s3:PutBucketNotification",
+	"s3:PutBucketReplication":               "s3:PutReplicationConfiguration",
+	"s3:PutObjectLockConfiguration":         "s3:PutBucketObjectLockConfiguration",
+	"s3:PutPublicAccessBlock":               "s3:PutBucketPublicAccessBlock",
+	"s3:UploadPart":                         "s3:PutObject",
+	"s3:UploadPartCopy":                     "s3:PutObject",
+}
+
+// apiGatewayMethods maps API Gateway operation name prefixes to the HTTP method used as the IAM action.
+// API Gateway management IAM actions are named after the HTTP method of the REST call, e.g. "apigateway:GET".
+var apiGatewayMethods = []struct {
+	prefix, method string
+}{
+	{"Create", "POST"},
+	{"Delete", "DELETE"},
+	{"Flush", "DELETE"},
+	{"Get", "GET"},
+	{"Put", "PUT"},
+	{"Untag", "DELETE"},
+	{"Update", "PATCH"},
+}
+
+// IAMAction returns the IAM action required to call the specified operation of the specified
+// AWS SDK for Go v2 service ID, e.g. "s3:GetObject" for "S3" and "HeadObject".
+// Most IAM actions are named after the operation that requires them. inferred is true if the
+// action was derived that way rather than from a known mapping, in which case it may not exist.
+func IAMAction(serviceID, operation string) (action string, inferred bool, ok bool) {
+	prefix, ok := IAMActionPrefix(serviceID)
+	if !ok || operation == "" {
+		return "", false, false
+	}
+
+	switch serviceID {
+	case "API Gateway", "ApiGatewayV2":
+		for _, v := range apiGatewayMethods {
+			if strings.HasPrefix(operation, v.prefix) {
+				return prefix + ":" + v.method, false, true
+			}
+		}
+
+		// Most other API Gateway operations (Generate*, Import*, Test*, ...) are POSTs.
+		return prefix + ":POST", true, true
+	}
+
+	action = prefix + ":" + operation
+	if v, ok := iamActionOverrides[action]; ok {
+		return v, false, true
+	}
+
+	return action, true, true
+}
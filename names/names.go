@@ -168,6 +168,41 @@ func PartitionForRegion(region string) endpoints.Partition {
 	return PartitionForRegion(endpoints.UsEast1RegionID)
 }
 
+// ServicePrincipalNameForPartition returns the DNS suffix of the service principal name for the given service in the given partition.
+// SPN region unique taken from
+// https://github.com/aws/aws-cdk/blob/main/packages/aws-cdk-lib/region-info/lib/default.ts
+func ServicePrincipalNameForPartition(service string, partition endpoints.Partition) string {
+	if partitionID := partition.ID(); service != "" && partitionID != endpoints.AwsPartitionID {
+		switch partitionID {
+		case endpoints.AwsIsoPartitionID:
+			switch service {
+			case "cloudhsm",
+				"config",
+				"logs",
+				"workspaces":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsIsoBPartitionID:
+			switch service {
+			case "dms",
+				"logs":
+				return partition.DNSSuffix()
+			}
+		case endpoints.AwsCnPartitionID:
+			switch service {
+			case "codedeploy",
+				"elasticmapreduce",
+				"logs",
+				"ec2",
+				"s3":
+				return partition.DNSSuffix()
+			}
+		}
+	}
+
+	return "amazonaws.com"
+}
+
 // Type ServiceDatum corresponds closely to attributes and blocks in `data/names_data.hcl` and are
 // described in detail in README.md.
 type serviceDatum struct {
@@ -180,8 +215,12 @@ type serviceDatum struct {
 // serviceData key is the AWS provider service package
 var serviceData map[string]serviceDatum
 
+// iamActionPrefixes key is the AWS SDK for Go v2 service ID
+var iamActionPrefixes map[string]string
+
 func init() {
 	serviceData = make(map[string]serviceDatum)
+	iamActionPrefixes = make(map[string]string)
 
 	// Data from names_data.hcl
 	if err := readHCLIntoServiceData(); err != nil {
@@ -199,6 +238,10 @@ func readHCLIntoServiceData() error {
 	}
 
 	for _, l := range d {
+		if id, ns := l.SDKID(), l.ARNNamespace(); id != "" && ns != "" {
+			iamActionPrefixes[id] = ns
+		}
+
 		if l.Exclude() {
 			continue
 		}
@@ -297,6 +340,13 @@ func HumanFriendly(service string) (string, error) {
 	return "", fmt.Errorf("no service data found for %s", service)
 }
 
+// IAMActionPrefix returns the IAM action prefix (service namespace) for the specified
+// AWS SDK for Go v2 service ID, e.g. "elasticloadbalancing" for "Elastic Load Balancing v2".
+func IAMActionPrefix(serviceID string) (string, bool) {
+	v, ok := iamActionPrefixes[serviceID]
+	return v, ok
+}
+
 const (
 	ResourceTopLevelRegionAttributeDescription     = `Region where this resource will be [managed](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints). ` + topLevelRegionDefaultDescription
 	ListResourceTopLevelRegionAttributeDescription = `Region to [query](https://docs.aws.amazon.com/general/latest/gr/rande.html#regional-endpoints) for resources of this type. ` + topLevelRegionDefaultDescription
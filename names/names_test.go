@@ -386,3 +386,149 @@ func TestFullHumanFriendly(t *testing.T) {
 		})
 	}
 }
+
+func TestIAMActionPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+		OK       bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+			OK:       false,
+		},
+		{
+			TestName: "EC2",
+			Input:    "EC2",
+			Expected: "ec2",
+			OK:       true,
+		},
+		{
+			TestName: "Elastic Load Balancing v2",
+			Input:    "Elastic Load Balancing v2",
+			Expected: "elasticloadbalancing",
+			OK:       true,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: "",
+			OK:       false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := IAMActionPrefix(testCase.Input)
+
+			if ok != testCase.OK {
+				t.Errorf("got ok %t, expected %t", ok, testCase.OK)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIAMAction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName  string
+		ServiceID string
+		Operation string
+		Expected  string
+		Inferred  bool
+		OK        bool
+	}{
+		{
+			TestName:  "unknown service",
+			ServiceID: "doesnotexist",
+			Operation: "DoSomething",
+		},
+		{
+			TestName:  "no operation",
+			ServiceID: "EC2",
+		},
+		{
+			TestName:  "named after operation",
+			ServiceID: "EC2",
+			Operation: "RunInstances",
+			Expected:  "ec2:RunInstances",
+			Inferred:  true,
+			OK:        true,
+		},
+		{
+			TestName:  "S3 HeadObject",
+			ServiceID: "S3",
+			Operation: "HeadObject",
+			Expected:  "s3:GetObject",
+			OK:        true,
+		},
+		{
+			TestName:  "S3 ListObjectsV2",
+			ServiceID: "S3",
+			Operation: "ListObjectsV2",
+			Expected:  "s3:ListBucket",
+			OK:        true,
+		},
+		{
+			TestName:  "Lambda Invoke",
+			ServiceID: "Lambda",
+			Operation: "Invoke",
+			Expected:  "lambda:InvokeFunction",
+			OK:        true,
+		},
+		{
+			TestName:  "API Gateway GetRestApi",
+			ServiceID: "API Gateway",
+			Operation: "GetRestApi",
+			Expected:  "apigateway:GET",
+			OK:        true,
+		},
+		{
+			TestName:  "API Gateway V2 UpdateApi",
+			ServiceID: "ApiGatewayV2",
+			Operation: "UpdateApi",
+			Expected:  "apigateway:PATCH",
+			OK:        true,
+		},
+		{
+			TestName:  "API Gateway TestInvokeMethod",
+			ServiceID: "API Gateway",
+			Operation: "TestInvokeMethod",
+			Expected:  "apigateway:POST",
+			Inferred:  true,
+			OK:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, inferred, ok := IAMAction(testCase.ServiceID, testCase.Operation)
+
+			if ok != testCase.OK {
+				t.Errorf("got ok %t, expected %t", ok, testCase.OK)
+			}
+
+			if inferred != testCase.Inferred {
+				t.Errorf("got inferred %t, expected %t", inferred, testCase.Inferred)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package action
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed action.gtpl
+var actionTmpl string
+
+//go:embed actiontest.gtpl
+var actionTestTmpl string
+
+//go:embed websitedoc.gtpl
+var websiteTmpl string
+
+type TemplateData struct {
+	Action               string
+	ActionLower          string
+	ActionLowerCamel     string
+	ActionSnake          string
+	IncludeComments      bool
+	HumanFriendlyService string
+	SDKPackage           string
+	ServicePackage       string
+	Service              string
+	ServiceLower         string
+	AWSServiceName       string
+	HumanActionName      string
+	ProviderResourceName string
+}
+
+func Create(actionName, snakeName string, comments, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if actionName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if actionName == strings.ToLower(actionName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., StartBuild)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., start_build)")
+	}
+
+	if snakeName == "" {
+		snakeName = names.ToSnakeCase(actionName)
+	}
+
+	service, err := data.LookupService(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error looking up service package data for %q: %w", servicePackage, err)
+	}
+
+	templateData := TemplateData{
+		Action:               actionName,
+		ActionLower:          strings.ToLower(actionName),
+		ActionLowerCamel:     convert.ToLowercasePrefix(actionName),
+		ActionSnake:          snakeName,
+		HumanFriendlyService: service.HumanFriendly(),
+		IncludeComments:      comments,
+		SDKPackage:           service.GoV2Package(),
+		ServicePackage:       servicePackage,
+		Service:              service.ProviderNameUpper(),
+		ServiceLower:         strings.ToLower(service.ProviderNameUpper()),
+		AWSServiceName:       service.FullHumanFriendly(),
+		HumanActionName:      convert.ToHumanResName(actionName),
+		ProviderResourceName: convert.ToProviderResourceName(servicePackage, snakeName),
+	}
+
+	tmpl := actionTmpl
+	f := fmt.Sprintf("%s_action.go", snakeName)
+	if err = writeTemplate("newaction", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing action template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_action_test.go", snakeName)
+	if err = writeTemplate("actiontest", tf, actionTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing action test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "actions", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing action website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
+	}
+
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return fmt.Errorf("error executing template: %s", err)
+	}
+
+	if _, err := f.Write(buffer.Bytes()); err != nil {
+		f.Close() // ignore error; Write error takes precedence
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("error closing file (%s): %s", filename, err)
+	}
+
+	return nil
+}
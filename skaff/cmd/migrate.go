@@ -0,0 +1,31 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/migrate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateFile   string
+	migrateOutput string
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Create a Plugin Framework resource from a Plugin SDKv2 resource",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrate.Create(migrateFile, name, migrateOutput, !clearComments, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(migrateCmd)
+	migrateCmd.Flags().StringVarP(&migrateFile, "file", "i", "", "Plugin SDKv2 resource source file (e.g., repository.go)")
+	migrateCmd.Flags().StringVarP(&migrateOutput, "output", "o", "", "output file (default is the source file with a _framework.go suffix)")
+	migrateCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "do not include instructional comments in source")
+	migrateCmd.Flags().StringVarP(&name, "name", "n", "", "if skaff doesn't get it right, explicitly give the resource name (e.g., DBInstance)")
+	migrateCmd.Flags().BoolVarP(&force, "force", "f", false, "force creation, overwriting existing files")
+}
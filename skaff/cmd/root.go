@@ -10,7 +10,7 @@ import (
 )
 
 var rootCmd = &cobra.Command{
-	Use:   "skaff [resource|datasource|ephemeral|function]",
+	Use:   "skaff [action|resource|datasource|ephemeral|function|list|migrate]",
 	Short: "Create scaffolding for the Terraform AWS Provider",
 }
 
@@ -57,3 +57,55 @@ func ToAWSCapitalization(s string) string {
 	// TODO: This is incomplete
 	return strings.ReplaceAll(s, "VPC", "Vpc")
 }
+
+// initialisms are the words that are written in upper case in Go identifiers
+var initialisms = map[string]string{
+	"acl":   "ACL",
+	"ami":   "AMI",
+	"api":   "API",
+	"arn":   "ARN",
+	"arns":  "ARNs",
+	"az":    "AZ",
+	"cidr":  "CIDR",
+	"db":    "DB",
+	"dns":   "DNS",
+	"ebs":   "EBS",
+	"ec2":   "EC2",
+	"http":  "HTTP",
+	"https": "HTTPS",
+	"iam":   "IAM",
+	"id":    "ID",
+	"ids":   "IDs",
+	"ip":    "IP",
+	"ipv4":  "IPv4",
+	"ipv6":  "IPv6",
+	"json":  "JSON",
+	"kms":   "KMS",
+	"sns":   "SNS",
+	"sqs":   "SQS",
+	"ssl":   "SSL",
+	"tls":   "TLS",
+	"ttl":   "TTL",
+	"uri":   "URI",
+	"url":   "URL",
+	"vpc":   "VPC",
+}
+
+// ToFieldName converts a snake cased Terraform attribute name to the name of
+// the corresponding Go struct field, e.g. kms_key_id becomes KMSKeyID
+func ToFieldName(snakeName string) string {
+	var sb strings.Builder
+
+	for word := range strings.SplitSeq(snakeName, "_") {
+		if word == "" {
+			continue
+		}
+		if v, ok := initialisms[strings.ToLower(word)]; ok {
+			sb.WriteString(v)
+			continue
+		}
+		sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	return sb.String()
+}
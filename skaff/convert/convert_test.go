@@ -103,3 +103,42 @@ func TestToAWSCapitalization(t *testing.T) {
 		})
 	}
 }
+
+func TestToFieldName(t *testing.T) {
+	tests := map[string]struct {
+		s    string
+		want string
+	}{
+		"empty": {
+			s:    "",
+			want: "",
+		},
+		"single word": {
+			s:    "name",
+			want: "Name",
+		},
+		"multiple words": {
+			s:    "instance_type",
+			want: "InstanceType",
+		},
+		"initialisms": {
+			s:    "kms_key_id",
+			want: "KMSKeyID",
+		},
+		"plural initialism": {
+			s:    "security_group_ids",
+			want: "SecurityGroupIDs",
+		},
+		"digits": {
+			s:    "ipv6_address_count",
+			want: "IPv6AddressCount",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := ToFieldName(tt.s); got != tt.want {
+				t.Errorf("ToFieldName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
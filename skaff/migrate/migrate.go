@@ -0,0 +1,201 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed migrate.gtpl
+var migrateTmpl string
+
+type TemplateData struct {
+	Annotations        []string
+	Attributes         string
+	Blocks             string
+	CRUD               map[string]string
+	Identity           bool
+	Importer           bool
+	Imports            []string
+	IncludeComments    bool
+	Models             []*model
+	Resource           string
+	ResourceLowerCamel string
+	SchemaVersion      int
+	ServicePackage     string
+	SourceFile         string
+	StateUpgraders     []sdkStateUpgrader
+	Timeouts           []timeout
+	Unsupported        []string
+}
+
+type timeout struct {
+	Name    string
+	Default string
+}
+
+// Create migrates the Plugin SDKv2 resource in the specified file to a Plugin
+// Framework resource written to outputName.
+func Create(filename, resourceName, outputName string, comments, force bool) error {
+	if filename == "" {
+		return fmt.Errorf("error checking: no file given")
+	}
+
+	if resourceName != "" && resourceName == strings.ToLower(resourceName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	if outputName == "" {
+		outputName = strings.TrimSuffix(filename, ".go") + "_framework.go"
+	}
+
+	out, err := migrate(filename, src, resourceName, comments)
+	if err != nil {
+		return err
+	}
+
+	if err := writeFile(outputName, out, force); err != nil {
+		return fmt.Errorf("writing migrated resource: %w", err)
+	}
+
+	return nil
+}
+
+func migrate(filename string, src []byte, resourceName string, comments bool) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", filename, err)
+	}
+
+	renameImports(file)
+
+	res, err := parseResource(fset, filename, file)
+	if err != nil {
+		return nil, err
+	}
+
+	if resourceName == "" {
+		resourceName = strings.TrimPrefix(res.FuncName, "resource")
+	}
+	lowerCamel := convert.ToLowercasePrefix(resourceName)
+
+	g := newGenerator(fset)
+	attributes, blocks := g.schema(lowerCamel+"ResourceModel", res.Attributes)
+
+	templateData := TemplateData{
+		Attributes:         attributes,
+		Blocks:             blocks,
+		CRUD:               res.CRUD,
+		Importer:           res.Importer,
+		IncludeComments:    comments,
+		Models:             g.models,
+		Resource:           resourceName,
+		ResourceLowerCamel: lowerCamel,
+		SchemaVersion:      res.SchemaVersion,
+		ServicePackage:     file.Name.Name,
+		SourceFile:         filepath.Base(filename),
+		StateUpgraders:     res.StateUpgraders,
+		Unsupported:        res.Unsupported,
+	}
+
+	for _, annotation := range res.Annotations {
+		if v, ok := strings.CutPrefix(annotation, "@SDKResource("); ok {
+			annotation = "@FrameworkResource(" + v
+		}
+		for _, prefix := range []string{"@IdentityAttribute(", "@ArnIdentity", "@SingletonIdentity"} {
+			if strings.HasPrefix(annotation, prefix) {
+				templateData.Identity = true
+			}
+		}
+		templateData.Annotations = append(templateData.Annotations, annotation)
+	}
+
+	for _, name := range []string{"Create", "Read", "Update", "Delete"} {
+		if v, ok := res.Timeouts[name]; ok {
+			templateData.Timeouts = append(templateData.Timeouts, timeout{Name: name, Default: v})
+		}
+	}
+
+	g.use("context")
+	g.use("github.com/hashicorp/terraform-plugin-framework/resource")
+	g.use("github.com/hashicorp/terraform-plugin-framework/resource/schema")
+	g.use(pkgFramework)
+	if len(templateData.Timeouts) > 0 {
+		g.use("github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts")
+		g.use(pkgNames)
+	}
+
+	code := []string{attributes, blocks}
+	for _, m := range g.models {
+		for _, f := range m.Fields {
+			code = append(code, f.Type)
+		}
+	}
+	for _, t := range templateData.Timeouts {
+		code = append(code, t.Default)
+	}
+	g.useSourceImports(file, code...)
+	templateData.Imports = importGroups(g.Imports())
+
+	tplate, err := template.New("migrate").Parse(migrateTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, templateData); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	out, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return buffer.Bytes(), fmt.Errorf("error formatting migrated resource: %s", err)
+	}
+
+	return out, nil
+}
+
+// importGroups splits import specs into standard library and other groups.
+func importGroups(specs []string) []string {
+	std := slices.DeleteFunc(slices.Clone(specs), func(s string) bool { return strings.Contains(s, ".") })
+	other := slices.DeleteFunc(slices.Clone(specs), func(s string) bool { return !strings.Contains(s, ".") })
+
+	if len(std) == 0 || len(other) == 0 {
+		return append(std, other...)
+	}
+
+	return append(append(std, ""), other...)
+}
+
+func writeFile(filename string, b []byte, force bool) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
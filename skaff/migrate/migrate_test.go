@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSDKResource = `package example
+
+import (
+	"time"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_example_widget", name="Widget")
+// @Tags(identifierAttribute="arn")
+func resourceWidget() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWidgetCreate,
+		ReadWithoutTimeout:   resourceWidgetRead,
+		UpdateWithoutTimeout: resourceWidgetUpdate,
+		DeleteWithoutTimeout: resourceWidgetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceWidgetV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: widgetStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				names.AttrARN: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"size": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      10,
+								ValidateFunc: validation.IntBetween(1, 100),
+							},
+						},
+					},
+				},
+				names.AttrKMSKeyID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.AttrName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+				"security_group_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrState: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.WidgetState](),
+					DiffSuppressFunc: suppressState,
+				},
+				names.AttrTags:    tftags.TagsSchema(),
+				names.AttrTagsAll: tftags.TagsSchemaComputed(),
+				names.AttrType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"a", "b"}, false),
+				},
+			}
+		},
+	}
+}
+`
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	got, err := migrate("widget.go", []byte(testSDKResource), "", false)
+	if err != nil {
+		t.Fatalf("migrate: %s\n%s", err, got)
+	}
+
+	normalize := func(s string) string {
+		return strings.Join(strings.Fields(s), " ")
+	}
+
+	for _, want := range []string{
+		`// @FrameworkResource("aws_example_widget", name="Widget")`,
+		`// @Tags(identifierAttribute="arn")`,
+		`func newWidgetResource(_ context.Context) (resource.ResourceWithConfigure, error) {`,
+		`r.SetDefaultCreateTimeout(10 * time.Minute)`,
+		`r.SetDefaultDeleteTimeout(5 * time.Minute)`,
+		`// TODO: Migrate CustomizeDiff: verify.SetTagsDiff.`,
+		`framework.WithImportByID`,
+		`Version: 1,`,
+		`names.AttrARN: framework.ARNAttributeComputedOnly(),`,
+		`CustomType: fwtypes.NewListNestedObjectTypeOf[configurationModel](ctx),`,
+		`listvalidator.SizeAtMost(1),`,
+		`Default:  int64default.StaticInt64(10),`,
+		`int64validator.Between(1, 100),`,
+		`CustomType: fwtypes.ARNType,`,
+		`stringplanmodifier.RequiresReplace(),`,
+		`stringvalidator.LengthBetween(1, 64),`,
+		`CustomType:  fwtypes.SetOfStringType,`,
+		`CustomType: fwtypes.StringEnumType[awstypes.WidgetState](),`,
+		`// TODO: Migrate DiffSuppressFunc: suppressState.`,
+		`names.AttrTags:    tftags.TagsAttribute(),`,
+		`names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),`,
+		`stringvalidator.OneOf("a", "b"),`,
+		`0: {`,
+		`awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"`,
+		`// TODO: Migrate resourceWidgetCreate.`,
+		"Configuration    fwtypes.ListNestedObjectValueOf[configurationModel] `tfsdk:\"configuration\"`",
+		"KMSKeyID         fwtypes.ARN                                          `tfsdk:\"kms_key_id\"`",
+		"SecurityGroupIDs fwtypes.SetOfString                                  `tfsdk:\"security_group_ids\"`",
+		"State            fwtypes.StringEnum[awstypes.WidgetState]             `tfsdk:\"state\"`",
+		"Timeouts         timeouts.Value                                       `tfsdk:\"timeouts\"`",
+		"Size types.Int64 `tfsdk:\"size\"`",
+	} {
+		if !strings.Contains(normalize(string(got)), normalize(want)) {
+			t.Errorf("migrated resource does not contain %q", want)
+		}
+	}
+
+	if t.Failed() {
+		t.Logf("migrated resource:\n%s", got)
+	}
+}
+
+const testSDKResourceImportCollision = `package example
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/example/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+)
+
+func resourceGadget() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.GadgetModeFast,
+				ValidateDiagFunc: enum.Validate[types.GadgetMode](),
+			},
+		},
+	}
+}
+`
+
+func TestMigrateImportCollision(t *testing.T) {
+	t.Parallel()
+
+	got, err := migrate("gadget.go", []byte(testSDKResourceImportCollision), "", false)
+	if err != nil {
+		t.Fatalf("migrate: %s\n%s", err, got)
+	}
+
+	for _, want := range []string{
+		`awstypes "github.com/aws/aws-sdk-go-v2/service/example/types"`,
+		`"github.com/hashicorp/terraform-plugin-framework/types"`,
+		`CustomType: fwtypes.StringEnumType[awstypes.GadgetMode](),`,
+		`Default:    stringdefault.StaticString(string(awstypes.GadgetModeFast)),`,
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("migrated resource does not contain %q", want)
+		}
+	}
+
+	if t.Failed() {
+		t.Logf("migrated resource:\n%s", got)
+	}
+}
+
+const testSDKResourceMultiLineSource = `package example
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func resourceSprocket() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSprocketCreate,
+		ReadWithoutTimeout:   resourceSprocketRead,
+		DeleteWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+			return nil
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ForceNewIfChange("size", func(_ context.Context, old, new, meta any) bool {
+				return new.(int) < old.(int)
+			}),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == new
+				},
+			},
+		},
+	}
+}
+`
+
+func TestMigrateMultiLineSource(t *testing.T) {
+	t.Parallel()
+
+	got, err := migrate("sprocket.go", []byte(testSDKResourceMultiLineSource), "", false)
+	if err != nil {
+		t.Fatalf("migrate: %s\n%s", err, got)
+	}
+
+	for _, want := range []string{
+		`// TODO: Migrate CustomizeDiff: customdiff.Sequence(...) (sprocket.go:20).`,
+		`// TODO: Migrate DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {...} (sprocket.go:31).`,
+		`// TODO: Migrate func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {...} (sprocket.go:16).`,
+		`// TODO: Migrate resourceSprocketCreate.`,
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("migrated resource does not contain %q", want)
+		}
+	}
+
+	if t.Failed() {
+		t.Logf("migrated resource:\n%s", got)
+	}
+}
+
+const testSDKResourceSchemaFuncReference = `package example
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func resourceInstance() *schema.Resource {
+	return &schema.Resource{
+		SchemaFunc: resourceInstanceSchema,
+	}
+}
+
+func resourceInstanceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		names.AttrName: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"endpoint": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: endpointSchema,
+			},
+		},
+	}
+}
+`
+
+func TestMigrateSchemaFuncReference(t *testing.T) {
+	t.Parallel()
+
+	got, err := migrate("instance.go", []byte(testSDKResourceSchemaFuncReference), "", false)
+	if err != nil {
+		t.Fatalf("migrate: %s\n%s", err, got)
+	}
+
+	for _, want := range []string{
+		`names.AttrName: schema.StringAttribute{`,
+		`// TODO: Migrate Elem.Schema: endpointSchema.`,
+	} {
+		if !strings.Contains(string(got), want) {
+			t.Errorf("migrated resource does not contain %q", want)
+		}
+	}
+
+	if t.Failed() {
+		t.Logf("migrated resource:\n%s", got)
+	}
+}
+
+const testSDKResourceSchemaNotFound = `package example
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/example/shared"
+)
+
+func resourceInstance() *schema.Resource {
+	return &schema.Resource{
+		SchemaFunc: shared.InstanceSchema,
+	}
+}
+`
+
+func TestMigrateSchemaNotFound(t *testing.T) {
+	t.Parallel()
+
+	_, err := migrate("instance.go", []byte(testSDKResourceSchemaNotFound), "", false)
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if want := "no map[string]*schema.Schema literal found for resourceInstance SchemaFunc: shared.InstanceSchema"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q does not contain %q", err, want)
+	}
+}
@@ -0,0 +1,588 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// sdkResource is the part of a Plugin SDKv2 schema.Resource that is migrated.
+type sdkResource struct {
+	FuncName       string
+	Annotations    []string
+	Attributes     []*sdkAttribute
+	CRUD           map[string]string // e.g. "Create" => "resourceInstanceCreate"
+	Importer       bool
+	SchemaVersion  int
+	StateUpgraders []sdkStateUpgrader
+	Timeouts       map[string]string // e.g. "Create" => "10 * time.Minute"
+	Unsupported    []string
+}
+
+// sdkAttribute is a single schema.Schema entry.
+type sdkAttribute struct {
+	Key            string // source of the map key, e.g. `"instance_type"` or `names.AttrARN`
+	Name           string // Terraform attribute name
+	Type           string // e.g. "TypeString"
+	Required       bool
+	Optional       bool
+	Computed       bool
+	ForceNew       bool
+	Sensitive      bool
+	Description    string
+	Deprecated     string
+	Default        string
+	MaxItems       int
+	MinItems       int
+	Validators     []ast.Expr
+	ElemType       string          // element type of a collection of primitives
+	ElemValidators []ast.Expr      // validators of a collection of primitives' elements
+	Nested         []*sdkAttribute // attributes of a nested schema.Resource
+	IsNested       bool
+	Source         string // source of a value that is not a schema.Schema literal, e.g. tftags.TagsSchema()
+	Unsupported    []string
+}
+
+type sdkStateUpgrader struct {
+	Version int
+	Type    string
+	Upgrade string
+}
+
+type sdkParser struct {
+	fset  *token.FileSet
+	funcs map[string]*ast.FuncDecl
+}
+
+// parseResource parses a Plugin SDKv2 resource. The resource is the function
+// annotated with @SDKResource or, failing that, the first function named
+// resource* that returns *schema.Resource.
+func parseResource(fset *token.FileSet, filename string, file *ast.File) (*sdkResource, error) {
+	p := &sdkParser{
+		fset:  fset,
+		funcs: make(map[string]*ast.FuncDecl),
+	}
+
+	var decl *ast.FuncDecl
+	for _, d := range file.Decls {
+		fd, ok := d.(*ast.FuncDecl)
+		if !ok || fd.Recv != nil {
+			continue
+		}
+
+		p.funcs[fd.Name.Name] = fd
+
+		if !returnsSchemaResource(fd) {
+			continue
+		}
+
+		if slices.ContainsFunc(annotations(fd), func(s string) bool { return strings.HasPrefix(s, "@SDKResource(") }) {
+			decl = fd
+		} else if decl == nil && strings.HasPrefix(fd.Name.Name, "resource") {
+			decl = fd
+		}
+	}
+
+	if decl == nil {
+		return nil, fmt.Errorf("no SDKv2 resource found in %s", filename)
+	}
+
+	lit := p.resourceLit(decl.Body)
+	if lit == nil {
+		return nil, fmt.Errorf("no schema.Resource literal returned by %s", decl.Name.Name)
+	}
+
+	res := &sdkResource{
+		FuncName:    decl.Name.Name,
+		Annotations: annotations(decl),
+		CRUD:        make(map[string]string),
+		Timeouts:    make(map[string]string),
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch field := key.Name; field {
+		case "Schema", "SchemaFunc":
+			elts, ok := p.schemaMapElts(kv.Value)
+			if !ok {
+				return nil, fmt.Errorf("no map[string]*schema.Schema literal found for %s %s: %s", decl.Name.Name, field, p.summary(kv.Value))
+			}
+			res.Attributes = p.attributes(elts)
+		case "SchemaVersion":
+			res.SchemaVersion = intValue(kv.Value)
+		case "StateUpgraders":
+			res.StateUpgraders = p.stateUpgraders(kv.Value)
+		case "Timeouts":
+			res.Timeouts = p.timeouts(kv.Value)
+		case "Importer":
+			res.Importer = true
+		case "Create", "CreateContext", "CreateWithoutTimeout":
+			res.CRUD["Create"] = p.summary(kv.Value)
+		case "Read", "ReadContext", "ReadWithoutTimeout":
+			res.CRUD["Read"] = p.summary(kv.Value)
+		case "Update", "UpdateContext", "UpdateWithoutTimeout":
+			res.CRUD["Update"] = p.summary(kv.Value)
+		case "Delete", "DeleteContext", "DeleteWithoutTimeout":
+			res.CRUD["Delete"] = p.summary(kv.Value)
+		default:
+			res.Unsupported = append(res.Unsupported, fmt.Sprintf("%s: %s", field, p.summary(kv.Value)))
+		}
+	}
+
+	return res, nil
+}
+
+// reservedImportNames are the package names used by the migrated resource.
+var reservedImportNames = []string{"framework", "planmodifier", "resource", "schema", "timeouts", "types", "validator"}
+
+// renameImports renames imports, other than Plugin SDKv2 packages, whose names
+// collide with packages used by the migrated resource, e.g. the AWS SDK for Go
+// v2 service types package is renamed to awstypes.
+func renameImports(file *ast.File) {
+	renames := make(map[string]string)
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || strings.Contains(path, "terraform-plugin-sdk") {
+			continue
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if !slices.Contains(reservedImportNames, name) {
+			continue
+		}
+
+		alias := "tf" + name
+		if strings.HasPrefix(path, "github.com/aws/") {
+			alias = "aws" + name
+		}
+		spec.Name = ast.NewIdent(alias)
+		renames[name] = alias
+	}
+
+	if len(renames) == 0 {
+		return
+	}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				if alias, ok := renames[id.Name]; ok {
+					id.Name = alias
+				}
+			}
+		}
+		return true
+	})
+}
+
+func annotations(fd *ast.FuncDecl) []string {
+	var s []string
+
+	if fd.Doc == nil {
+		return s
+	}
+
+	for _, c := range fd.Doc.List {
+		if text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//")); strings.HasPrefix(text, "@") {
+			s = append(s, text)
+		}
+	}
+
+	return s
+}
+
+func returnsSchemaResource(fd *ast.FuncDecl) bool {
+	if fd.Type.Results == nil || len(fd.Type.Results.List) != 1 {
+		return false
+	}
+
+	star, ok := fd.Type.Results.List[0].Type.(*ast.StarExpr)
+
+	return ok && selectorName(star.X) == "schema.Resource"
+}
+
+// resourceLit returns the schema.Resource composite literal in the specified
+// expression or the first one returned from the specified function body.
+func (p *sdkParser) resourceLit(node ast.Node) *ast.CompositeLit {
+	switch n := node.(type) {
+	case *ast.UnaryExpr:
+		if lit, ok := n.X.(*ast.CompositeLit); ok && selectorName(lit.Type) == "schema.Resource" {
+			return lit
+		}
+	case *ast.CallExpr:
+		if id, ok := n.Fun.(*ast.Ident); ok {
+			if fd := p.funcs[id.Name]; fd != nil && fd.Body != nil {
+				return p.resourceLit(fd.Body)
+			}
+		}
+	case *ast.BlockStmt:
+		var lit *ast.CompositeLit
+		ast.Inspect(n, func(n ast.Node) bool {
+			if lit != nil {
+				return false
+			}
+			if _, ok := n.(*ast.FuncLit); ok {
+				return false
+			}
+			if r, ok := n.(*ast.ReturnStmt); ok && len(r.Results) == 1 {
+				lit = p.resourceLit(r.Results[0])
+			}
+			return true
+		})
+		return lit
+	}
+
+	return nil
+}
+
+// schemaMapElts returns the entries of the map[string]*schema.Schema literal
+// in the specified expression, following function literals and references to
+// or calls of functions declared in the same file. It reports whether the
+// literal was found.
+func (p *sdkParser) schemaMapElts(expr ast.Expr) ([]ast.Expr, bool) {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		if _, ok := e.Type.(*ast.MapType); ok {
+			return e.Elts, true
+		}
+	case *ast.ParenExpr:
+		return p.schemaMapElts(e.X)
+	case *ast.FuncLit:
+		return p.returnedSchemaMapElts(e.Body)
+	case *ast.Ident:
+		// e.g. SchemaFunc: resourceWidgetSchema.
+		if fd := p.funcs[e.Name]; fd != nil && fd.Body != nil {
+			return p.returnedSchemaMapElts(fd.Body)
+		}
+	case *ast.CallExpr:
+		if id, ok := e.Fun.(*ast.Ident); ok {
+			if fd := p.funcs[id.Name]; fd != nil && fd.Body != nil {
+				return p.returnedSchemaMapElts(fd.Body)
+			}
+		}
+		for _, arg := range e.Args {
+			if elts, ok := p.schemaMapElts(arg); ok {
+				return elts, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func (p *sdkParser) returnedSchemaMapElts(body *ast.BlockStmt) ([]ast.Expr, bool) {
+	var (
+		elts  []ast.Expr
+		found bool
+	)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if r, ok := n.(*ast.ReturnStmt); ok && len(r.Results) == 1 {
+			elts, found = p.schemaMapElts(r.Results[0])
+		}
+		return true
+	})
+
+	return elts, found
+}
+
+func (p *sdkParser) attributes(elts []ast.Expr) []*sdkAttribute {
+	var attrs []*sdkAttribute
+
+	for _, elt := range elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			attrs = append(attrs, p.attribute(kv))
+		}
+	}
+
+	slices.SortFunc(attrs, func(a, b *sdkAttribute) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return attrs
+}
+
+func (p *sdkParser) attribute(kv *ast.KeyValueExpr) *sdkAttribute {
+	attr := &sdkAttribute{
+		Key: p.source(kv.Key),
+	}
+
+	switch k := kv.Key.(type) {
+	case *ast.BasicLit:
+		attr.Name, _ = strconv.Unquote(k.Value)
+	case *ast.SelectorExpr:
+		// names.AttrXxx constants are the snake case of their suffix.
+		attr.Name = names.ToSnakeCase(strings.TrimPrefix(k.Sel.Name, "Attr"))
+	default:
+		attr.Name = attr.Key
+	}
+
+	value := kv.Value
+	if u, ok := value.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		value = u.X
+	}
+
+	lit, ok := value.(*ast.CompositeLit)
+	if !ok {
+		attr.Source = p.summary(kv.Value)
+		return attr
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch field := key.Name; field {
+		case "Type":
+			attr.Type = selectorSel(kv.Value)
+		case "Required":
+			attr.Required = isTrue(kv.Value)
+		case "Optional":
+			attr.Optional = isTrue(kv.Value)
+		case "Computed":
+			attr.Computed = isTrue(kv.Value)
+		case "ForceNew":
+			attr.ForceNew = isTrue(kv.Value)
+		case "Sensitive":
+			attr.Sensitive = isTrue(kv.Value)
+		case "Description":
+			attr.Description = p.source(kv.Value)
+		case "Deprecated":
+			attr.Deprecated = p.source(kv.Value)
+		case "Default":
+			attr.Default = p.source(kv.Value)
+		case "MaxItems":
+			attr.MaxItems = intValue(kv.Value)
+		case "MinItems":
+			attr.MinItems = intValue(kv.Value)
+		case "ValidateFunc", "ValidateDiagFunc":
+			attr.Validators = append(attr.Validators, flattenValidators(kv.Value)...)
+		case "ConfigMode":
+			// Attributes as blocks are either blocks or nested attributes in Plugin Framework.
+		case "Elem":
+			if r := p.resourceLit(kv.Value); r != nil {
+				attr.IsNested = true
+				for _, elt := range r.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok {
+						if key, ok := kv.Key.(*ast.Ident); ok && (key.Name == "Schema" || key.Name == "SchemaFunc") {
+							if elts, ok := p.schemaMapElts(kv.Value); ok {
+								attr.Nested = p.attributes(elts)
+							} else {
+								attr.Unsupported = append(attr.Unsupported, fmt.Sprintf("%s.%s: %s", field, key.Name, p.summary(kv.Value)))
+							}
+						}
+					}
+				}
+			} else if elem := p.elemSchema(kv.Value); elem != nil {
+				attr.ElemType = elem.Type
+				attr.ElemValidators = elem.Validators
+			} else {
+				attr.Unsupported = append(attr.Unsupported, fmt.Sprintf("%s: %s", field, p.summary(kv.Value)))
+			}
+		default:
+			attr.Unsupported = append(attr.Unsupported, fmt.Sprintf("%s: %s", field, p.summary(kv.Value)))
+		}
+	}
+
+	return attr
+}
+
+// elemSchema returns the element schema of a collection of primitives.
+func (p *sdkParser) elemSchema(expr ast.Expr) *sdkAttribute {
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		if lit, ok := u.X.(*ast.CompositeLit); ok && selectorName(lit.Type) == "schema.Schema" {
+			return p.attribute(&ast.KeyValueExpr{Key: ast.NewIdent("elem"), Value: u.X})
+		}
+	}
+
+	return nil
+}
+
+func (p *sdkParser) stateUpgraders(expr ast.Expr) []sdkStateUpgrader {
+	var upgraders []sdkStateUpgrader
+
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return upgraders
+	}
+
+	for _, elt := range lit.Elts {
+		lit, ok := elt.(*ast.CompositeLit)
+		if !ok {
+			continue
+		}
+
+		var upgrader sdkStateUpgrader
+		for _, elt := range lit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				switch selectorSel(kv.Key) {
+				case "Version":
+					upgrader.Version = intValue(kv.Value)
+				case "Type":
+					upgrader.Type = p.summary(kv.Value)
+				case "Upgrade":
+					upgrader.Upgrade = p.summary(kv.Value)
+				}
+			}
+		}
+		upgraders = append(upgraders, upgrader)
+	}
+
+	return upgraders
+}
+
+func (p *sdkParser) timeouts(expr ast.Expr) map[string]string {
+	timeouts := make(map[string]string)
+
+	if u, ok := expr.(*ast.UnaryExpr); ok {
+		expr = u.X
+	}
+
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return timeouts
+	}
+
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			value := kv.Value
+			// schema.DefaultTimeout(10 * time.Minute).
+			if call, ok := value.(*ast.CallExpr); ok && len(call.Args) == 1 {
+				value = call.Args[0]
+			}
+			timeouts[selectorSel(kv.Key)] = p.source(value)
+		}
+	}
+
+	return timeouts
+}
+
+func (p *sdkParser) source(node ast.Node) string {
+	var buf bytes.Buffer
+
+	if err := format.Node(&buf, p.fset, node); err != nil {
+		return fmt.Sprintf("%T", node)
+	}
+
+	return buf.String()
+}
+
+func (p *sdkParser) summary(node ast.Node) string {
+	return summary(p.fset, node)
+}
+
+// summary returns the source of a node for use in a single-line comment.
+// The source of multi-line nodes, e.g. func literals or customdiff.Sequence
+// calls, is abbreviated to its first and last lines and its position.
+func summary(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+
+	if err := format.Node(&buf, fset, node); err != nil {
+		return fmt.Sprintf("%T", node)
+	}
+
+	src := buf.String()
+	first, _, ok := strings.Cut(src, "\n")
+	if !ok {
+		return src
+	}
+	last := strings.TrimSpace(src[strings.LastIndex(src, "\n")+1:])
+	pos := fset.Position(node.Pos())
+
+	return fmt.Sprintf("%s...%s (%s:%d)", first, last, filepath.Base(pos.Filename), pos.Line)
+}
+
+// flattenValidators unwraps validation.All and validation.ToDiagFunc.
+func flattenValidators(expr ast.Expr) []ast.Expr {
+	if call, ok := expr.(*ast.CallExpr); ok {
+		switch selectorName(call.Fun) {
+		case "validation.All", "validation.AllDiag":
+			var validators []ast.Expr
+			for _, arg := range call.Args {
+				validators = append(validators, flattenValidators(arg)...)
+			}
+			return validators
+		case "validation.ToDiagFunc":
+			if len(call.Args) == 1 {
+				return flattenValidators(call.Args[0])
+			}
+		}
+	}
+
+	return []ast.Expr{expr}
+}
+
+// selectorName returns "pkg.Name" for a selector expression and "Name" for an identifier.
+func selectorName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			return x.Name + "." + e.Sel.Name
+		}
+	case *ast.IndexExpr:
+		return selectorName(e.X)
+	}
+
+	return ""
+}
+
+func selectorSel(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+
+	return ""
+}
+
+func isTrue(expr ast.Expr) bool {
+	id, ok := expr.(*ast.Ident)
+
+	return ok && id.Name == "true"
+}
+
+func intValue(expr ast.Expr) int {
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		if v, err := strconv.Atoi(lit.Value); err == nil {
+			return v
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,645 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/token"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+const (
+	pkgBoolDefault        = "github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	pkgBoolPlanModifier   = "github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	pkgFloat64Default     = "github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	pkgFloat64PlanMod     = "github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	pkgFloat64Validator   = "github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	pkgFramework          = "github.com/hashicorp/terraform-provider-aws/internal/framework"
+	pkgFWTypes            = "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	pkgInt64Default       = "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	pkgInt64PlanModifier  = "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	pkgInt64Validator     = "github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	pkgJSONTypes          = "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	pkgListPlanModifier   = "github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	pkgListValidator      = "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	pkgMapPlanModifier    = "github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	pkgMapValidator       = "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	pkgNames              = "github.com/hashicorp/terraform-provider-aws/names"
+	pkgPlanModifier       = "github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	pkgSetPlanModifier    = "github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	pkgSetValidator       = "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	pkgStringDefault      = "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	pkgStringPlanModifier = "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	pkgStringValidator    = "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	pkgTags               = "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	pkgTimeTypes          = "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	pkgTypes              = "github.com/hashicorp/terraform-plugin-framework/types"
+	pkgValidator          = "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// importAliases are the aliases this repository uses for imported packages.
+var importAliases = map[string]string{
+	pkgFWTypes: "fwtypes",
+	pkgTags:    "tftags",
+}
+
+// primitive describes how a Plugin SDKv2 primitive type maps to Plugin Framework.
+type primitive struct {
+	kind         string // e.g. "String", used in attribute, plan modifier and validator type names
+	pkg          string // e.g. "string", used in plan modifier, default and validator package names
+	modelType    string
+	elemType     string
+	defaultFunc  string
+	defaultPkg   string
+	planModPkg   string
+	validatorPkg string
+	listOfType   string
+	listOfModel  string
+	setOfType    string
+	setOfModel   string
+	mapOfType    string
+	mapOfModel   string
+}
+
+var primitives = map[string]primitive{
+	"TypeBool": {
+		kind:        "Bool",
+		pkg:         "bool",
+		modelType:   "types.Bool",
+		elemType:    "types.BoolType",
+		defaultFunc: "booldefault.StaticBool",
+		defaultPkg:  pkgBoolDefault,
+		planModPkg:  pkgBoolPlanModifier,
+	},
+	"TypeFloat": {
+		kind:         "Float64",
+		pkg:          "float64",
+		modelType:    "types.Float64",
+		elemType:     "types.Float64Type",
+		defaultFunc:  "float64default.StaticFloat64",
+		defaultPkg:   pkgFloat64Default,
+		planModPkg:   pkgFloat64PlanMod,
+		validatorPkg: pkgFloat64Validator,
+	},
+	"TypeInt": {
+		kind:         "Int64",
+		pkg:          "int64",
+		modelType:    "types.Int64",
+		elemType:     "types.Int64Type",
+		defaultFunc:  "int64default.StaticInt64",
+		defaultPkg:   pkgInt64Default,
+		planModPkg:   pkgInt64PlanModifier,
+		validatorPkg: pkgInt64Validator,
+		listOfType:   "fwtypes.ListOfInt64Type",
+		listOfModel:  "fwtypes.ListOfInt64",
+		setOfType:    "fwtypes.SetOfInt64Type",
+		setOfModel:   "fwtypes.SetOfInt64",
+	},
+	"TypeString": {
+		kind:         "String",
+		pkg:          "string",
+		modelType:    "types.String",
+		elemType:     "types.StringType",
+		defaultFunc:  "stringdefault.StaticString",
+		defaultPkg:   pkgStringDefault,
+		planModPkg:   pkgStringPlanModifier,
+		validatorPkg: pkgStringValidator,
+		listOfType:   "fwtypes.ListOfStringType",
+		listOfModel:  "fwtypes.ListOfString",
+		setOfType:    "fwtypes.SetOfStringType",
+		setOfModel:   "fwtypes.SetOfString",
+		mapOfType:    "fwtypes.MapOfStringType",
+		mapOfModel:   "fwtypes.MapOfString",
+	},
+}
+
+// collection describes how a Plugin SDKv2 collection type maps to Plugin Framework.
+type collection struct {
+	kind         string // "List", "Set" or "Map"
+	pkg          string // "list", "set" or "map"
+	planModPkg   string
+	validatorPkg string
+}
+
+var collections = map[string]collection{
+	"TypeList": {kind: "List", pkg: "list", planModPkg: pkgListPlanModifier, validatorPkg: pkgListValidator},
+	"TypeMap":  {kind: "Map", pkg: "map", planModPkg: pkgMapPlanModifier, validatorPkg: pkgMapValidator},
+	"TypeSet":  {kind: "Set", pkg: "set", planModPkg: pkgSetPlanModifier, validatorPkg: pkgSetValidator},
+}
+
+type model struct {
+	Name   string
+	Fields []modelField
+}
+
+type modelField struct {
+	Name string
+	Type string
+	Tag  string
+}
+
+// generator renders Plugin Framework schema and model source from Plugin SDKv2 attributes.
+type generator struct {
+	fset    *token.FileSet
+	aliases map[string]string
+	imports map[string]struct{}
+	models  []*model
+}
+
+func newGenerator(fset *token.FileSet) *generator {
+	return &generator{
+		fset:    fset,
+		aliases: maps.Clone(importAliases),
+		imports: make(map[string]struct{}),
+	}
+}
+
+func (g *generator) use(pkg string) {
+	g.imports[pkg] = struct{}{}
+}
+
+// Imports returns the import specs needed by the rendered source, sorted.
+func (g *generator) Imports() []string {
+	var specs []string
+
+	for pkg := range g.imports {
+		if alias, ok := g.aliases[pkg]; ok {
+			specs = append(specs, fmt.Sprintf("%s %q", alias, pkg))
+		} else {
+			specs = append(specs, fmt.Sprintf("%q", pkg))
+		}
+	}
+
+	slices.SortFunc(specs, func(a, b string) int {
+		return strings.Compare(importPath(a), importPath(b))
+	})
+
+	return specs
+}
+
+// useSourceImports adds the imports of the Plugin SDKv2 source that are
+// referenced by code copied verbatim into the rendered source, e.g. the
+// awstypes in fwtypes.StringEnumType[awstypes.WidgetState]().
+func (g *generator) useSourceImports(file *ast.File, code ...string) {
+	var lines []string
+	for _, c := range code {
+		for line := range strings.Lines(c) {
+			if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+				lines = append(lines, line)
+			}
+		}
+	}
+	src := strings.Join(lines, "")
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || strings.Contains(path, "terraform-plugin-sdk") {
+			continue
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+			g.aliases[path] = name
+		}
+
+		if regexache.MustCompile(`\b` + regexp.QuoteMeta(name) + `\.`).MatchString(src) {
+			g.use(path)
+		}
+	}
+}
+
+func importPath(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
+
+// schema renders the attributes and blocks of a (nested) schema and adds the
+// corresponding model to the generator's models.
+func (g *generator) schema(modelName string, attrs []*sdkAttribute) (attributes, blocks string) {
+	var a, b strings.Builder
+	m := &model{Name: modelName}
+	g.models = append(g.models, m)
+
+	for _, attr := range attrs {
+		fieldName := fieldName(attr)
+		fieldType := ""
+
+		if attr.IsNested && !(attr.Computed && !attr.Optional && !attr.Required) {
+			var src string
+			src, fieldType = g.block(attr, fieldName)
+			b.WriteString(src)
+		} else {
+			var src string
+			src, fieldType = g.attribute(attr, fieldName)
+			a.WriteString(src)
+		}
+
+		m.Fields = append(m.Fields, modelField{
+			Name: fieldName,
+			Type: fieldType,
+			Tag:  attr.Name,
+		})
+	}
+
+	return a.String(), b.String()
+}
+
+func (g *generator) attribute(attr *sdkAttribute, fieldName string) (string, string) {
+	var sb strings.Builder
+
+	key := attr.Key
+	if strings.HasPrefix(key, "names.") {
+		g.use(pkgNames)
+	}
+
+	// Well-known attributes.
+	switch {
+	case attr.Name == "tags" && (attr.Type == "TypeMap" || strings.Contains(attr.Source, "TagsSchema")):
+		g.use(pkgTags)
+		fmt.Fprintf(&sb, "%s: tftags.TagsAttribute(),\n", key)
+		return sb.String(), "tftags.Map"
+	case attr.Name == "tags_all" && (attr.Type == "TypeMap" || strings.Contains(attr.Source, "TagsSchema")):
+		g.use(pkgTags)
+		fmt.Fprintf(&sb, "%s: tftags.TagsAttributeComputedOnly(),\n", key)
+		return sb.String(), "tftags.Map"
+	case attr.Source != "":
+		fmt.Fprintf(&sb, "// TODO: Migrate %s: %s.\n", key, attr.Source)
+		return sb.String(), "types.String"
+	case attr.Name == "id" && attr.Computed && !attr.Optional && !attr.Required:
+		g.use(pkgFramework)
+		fmt.Fprintf(&sb, "%s: framework.IDAttribute(),\n", key)
+		g.use(pkgTypes)
+		return sb.String(), "types.String"
+	case attr.Name == "arn" && attr.Computed && !attr.Optional && !attr.Required:
+		g.use(pkgFramework)
+		fmt.Fprintf(&sb, "%s: framework.ARNAttributeComputedOnly(),\n", key)
+		g.use(pkgTypes)
+		return sb.String(), "types.String"
+	}
+
+	if attr.IsNested {
+		// Computed-only nested objects are list or set attributes.
+		c := collections[attr.Type]
+		nestedModel := g.nestedModelName(fieldName)
+		g.schema(nestedModel, attr.Nested)
+		g.use(pkgFWTypes)
+		g.use(pkgTypes)
+		fmt.Fprintf(&sb, "%s: schema.%sAttribute{\n", key, c.kind)
+		fmt.Fprintf(&sb, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", c.kind, nestedModel)
+		sb.WriteString("Computed: true,\n")
+		fmt.Fprintf(&sb, "ElementType: types.ObjectType{\nAttrTypes: fwtypes.AttributeTypesMust[%s](ctx),\n},\n", nestedModel)
+		g.common(&sb, attr)
+		g.unsupported(&sb, attr)
+		sb.WriteString("},\n")
+		return sb.String(), fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", c.kind, nestedModel)
+	}
+
+	if c, ok := collections[attr.Type]; ok {
+		return g.collectionAttribute(attr, key, c)
+	}
+
+	p, ok := primitives[attr.Type]
+	if !ok {
+		fmt.Fprintf(&sb, "// TODO: Migrate %s of unsupported type %q.\n", key, attr.Type)
+		return sb.String(), "types.String"
+	}
+
+	g.use(pkgTypes)
+	modelType := p.modelType
+
+	var customType string
+	var validators, todos []string
+	for _, v := range attr.Validators {
+		ct, mt, src, ok := g.validator(p, v)
+		switch {
+		case !ok:
+			todos = append(todos, fmt.Sprintf("// TODO: Migrate validation %s.", summary(g.fset, v)))
+		case ct != "":
+			customType, modelType = ct, mt
+		default:
+			validators = append(validators, src)
+		}
+	}
+
+	fmt.Fprintf(&sb, "%s: schema.%sAttribute{\n", key, p.kind)
+	if customType != "" {
+		fmt.Fprintf(&sb, "CustomType: %s,\n", customType)
+	}
+	g.flags(&sb, attr)
+	if attr.Default != "" {
+		g.use(p.defaultPkg)
+		def := attr.Default
+		if strings.HasPrefix(customType, "fwtypes.StringEnumType[") && !strings.HasPrefix(def, `"`) {
+			def = fmt.Sprintf("string(%s)", def)
+		}
+		fmt.Fprintf(&sb, "Default: %s(%s),\n", p.defaultFunc, def)
+	}
+	g.common(&sb, attr)
+	if attr.ForceNew {
+		g.use(pkgPlanModifier)
+		g.use(p.planModPkg)
+		fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n%splanmodifier.RequiresReplace(),\n},\n", p.kind, p.pkg)
+	}
+	if len(validators) > 0 {
+		g.use(pkgValidator)
+		fmt.Fprintf(&sb, "Validators: []validator.%s{\n%s,\n},\n", p.kind, strings.Join(validators, ",\n"))
+	}
+	for _, todo := range todos {
+		sb.WriteString(todo + "\n")
+	}
+	g.unsupported(&sb, attr)
+	sb.WriteString("},\n")
+
+	return sb.String(), modelType
+}
+
+func (g *generator) collectionAttribute(attr *sdkAttribute, key string, c collection) (string, string) {
+	var sb strings.Builder
+
+	elemType := attr.ElemType
+	if elemType == "" {
+		// Plugin SDKv2 maps default to strings.
+		elemType = "TypeString"
+	}
+	p := primitives[elemType]
+
+	g.use(pkgTypes)
+	modelType := "types." + c.kind
+
+	fmt.Fprintf(&sb, "%s: schema.%sAttribute{\n", key, c.kind)
+	customType, customModel := "", ""
+	switch c.kind {
+	case "List":
+		customType, customModel = p.listOfType, p.listOfModel
+	case "Set":
+		customType, customModel = p.setOfType, p.setOfModel
+	case "Map":
+		customType, customModel = p.mapOfType, p.mapOfModel
+	}
+	if customType != "" {
+		g.use(pkgFWTypes)
+		fmt.Fprintf(&sb, "CustomType: %s,\n", customType)
+		modelType = customModel
+	}
+	fmt.Fprintf(&sb, "ElementType: %s,\n", p.elemType)
+	g.flags(&sb, attr)
+	g.common(&sb, attr)
+	if attr.ForceNew {
+		g.use(pkgPlanModifier)
+		g.use(c.planModPkg)
+		fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n%splanmodifier.RequiresReplace(),\n},\n", c.kind, c.pkg)
+	}
+	if validators := g.sizeValidators(attr, c); len(validators) > 0 {
+		fmt.Fprintf(&sb, "Validators: []validator.%s{\n%s,\n},\n", c.kind, strings.Join(validators, ",\n"))
+	}
+	for _, v := range attr.ElemValidators {
+		fmt.Fprintf(&sb, "// TODO: Migrate element validation %s.\n", summary(g.fset, v))
+	}
+	g.unsupported(&sb, attr)
+	sb.WriteString("},\n")
+
+	return sb.String(), modelType
+}
+
+func (g *generator) block(attr *sdkAttribute, fieldName string) (string, string) {
+	var sb strings.Builder
+
+	key := attr.Key
+	if strings.HasPrefix(key, "names.") {
+		g.use(pkgNames)
+	}
+
+	c, ok := collections[attr.Type]
+	if !ok || c.kind == "Map" {
+		fmt.Fprintf(&sb, "// TODO: Migrate %s of unsupported type %q.\n", key, attr.Type)
+		return sb.String(), "types.String"
+	}
+
+	nestedModel := g.nestedModelName(fieldName)
+	attributes, blocks := g.schema(nestedModel, attr.Nested)
+
+	g.use(pkgFWTypes)
+	fmt.Fprintf(&sb, "%s: schema.%sNestedBlock{\n", key, c.kind)
+	fmt.Fprintf(&sb, "CustomType: fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", c.kind, nestedModel)
+	if attr.Description != "" {
+		fmt.Fprintf(&sb, "Description: %s,\n", attr.Description)
+	}
+	if attr.Deprecated != "" {
+		fmt.Fprintf(&sb, "DeprecationMessage: %s,\n", attr.Deprecated)
+	}
+	if attr.ForceNew {
+		g.use(pkgPlanModifier)
+		g.use(c.planModPkg)
+		fmt.Fprintf(&sb, "PlanModifiers: []planmodifier.%s{\n%splanmodifier.RequiresReplace(),\n},\n", c.kind, c.pkg)
+	}
+	validators := g.sizeValidators(attr, c)
+	if attr.Required {
+		g.use(pkgValidator)
+		g.use(c.validatorPkg)
+		validators = append([]string{c.pkg + "validator.IsRequired()"}, validators...)
+	}
+	if len(validators) > 0 {
+		fmt.Fprintf(&sb, "Validators: []validator.%s{\n%s,\n},\n", c.kind, strings.Join(validators, ",\n"))
+	}
+	if attr.Computed {
+		sb.WriteString("// TODO: Blocks cannot be Computed. Consider a nested attribute instead.\n")
+	}
+	g.unsupported(&sb, attr)
+	sb.WriteString("NestedObject: schema.NestedBlockObject{\n")
+	if attributes != "" {
+		fmt.Fprintf(&sb, "Attributes: map[string]schema.Attribute{\n%s},\n", attributes)
+	}
+	if blocks != "" {
+		fmt.Fprintf(&sb, "Blocks: map[string]schema.Block{\n%s},\n", blocks)
+	}
+	sb.WriteString("},\n")
+	sb.WriteString("},\n")
+
+	return sb.String(), fmt.Sprintf("fwtypes.%sNestedObjectValueOf[%s]", c.kind, nestedModel)
+}
+
+func (g *generator) flags(sb *strings.Builder, attr *sdkAttribute) {
+	if attr.Required {
+		sb.WriteString("Required: true,\n")
+	}
+	if attr.Optional {
+		sb.WriteString("Optional: true,\n")
+	}
+	// Plugin Framework requires attributes with defaults to be Computed.
+	if attr.Computed || attr.Default != "" {
+		sb.WriteString("Computed: true,\n")
+	}
+	if attr.Sensitive {
+		sb.WriteString("Sensitive: true,\n")
+	}
+}
+
+func (g *generator) common(sb *strings.Builder, attr *sdkAttribute) {
+	if attr.Description != "" {
+		fmt.Fprintf(sb, "Description: %s,\n", attr.Description)
+	}
+	if attr.Deprecated != "" {
+		fmt.Fprintf(sb, "DeprecationMessage: %s,\n", attr.Deprecated)
+	}
+}
+
+func (g *generator) unsupported(sb *strings.Builder, attr *sdkAttribute) {
+	for _, s := range attr.Unsupported {
+		fmt.Fprintf(sb, "// TODO: Migrate %s.\n", s)
+	}
+}
+
+func (g *generator) sizeValidators(attr *sdkAttribute, c collection) []string {
+	var validators []string
+
+	if attr.MinItems > 0 {
+		validators = append(validators, fmt.Sprintf("%svalidator.SizeAtLeast(%d)", c.pkg, attr.MinItems))
+	}
+	if attr.MaxItems > 0 {
+		validators = append(validators, fmt.Sprintf("%svalidator.SizeAtMost(%d)", c.pkg, attr.MaxItems))
+	}
+	if len(validators) > 0 {
+		g.use(pkgValidator)
+		g.use(c.validatorPkg)
+	}
+
+	return validators
+}
+
+// validator maps a Plugin SDKv2 validation function to either a Plugin
+// Framework custom type (and corresponding model type) or validator.
+func (g *generator) validator(p primitive, expr ast.Expr) (customType, modelType, src string, ok bool) {
+	name := selectorName(expr)
+	call, isCall := expr.(*ast.CallExpr)
+	if isCall {
+		name = selectorName(call.Fun)
+	}
+
+	args := func() string {
+		var s []string
+		for _, arg := range call.Args {
+			s = append(s, g.exprString(arg))
+		}
+		return strings.Join(s, ", ")
+	}
+
+	switch p.kind {
+	case "String":
+		switch name {
+		case "verify.ValidARN":
+			g.use(pkgFWTypes)
+			return "fwtypes.ARNType", "fwtypes.ARN", "", true
+		case "verify.ValidIAMPolicyJSON":
+			g.use(pkgFWTypes)
+			return "fwtypes.IAMPolicyType", "fwtypes.IAMPolicy", "", true
+		case "validation.StringIsJSON":
+			g.use(pkgJSONTypes)
+			return "jsontypes.NormalizedType{}", "jsontypes.Normalized", "", true
+		case "validation.IsRFC3339Time":
+			g.use(pkgTimeTypes)
+			return "timetypes.RFC3339Type{}", "timetypes.RFC3339", "", true
+		case "enum.Validate":
+			if isCall {
+				if index, ok := call.Fun.(*ast.IndexExpr); ok {
+					g.use(pkgFWTypes)
+					t := g.exprString(index.Index)
+					return fmt.Sprintf("fwtypes.StringEnumType[%s]()", t), fmt.Sprintf("fwtypes.StringEnum[%s]", t), "", true
+				}
+			}
+		case "validation.StringIsNotEmpty", "validation.StringIsNotWhiteSpace":
+			g.use(p.validatorPkg)
+			return "", "", "stringvalidator.LengthAtLeast(1)", true
+		case "validation.StringLenBetween":
+			if isCall {
+				g.use(p.validatorPkg)
+				return "", "", fmt.Sprintf("stringvalidator.LengthBetween(%s)", args()), true
+			}
+		case "validation.StringMatch":
+			if isCall {
+				g.use(p.validatorPkg)
+				return "", "", fmt.Sprintf("stringvalidator.RegexMatches(%s)", args()), true
+			}
+		case "validation.StringInSlice":
+			if isCall && len(call.Args) == 2 {
+				g.use(p.validatorPkg)
+				f := "OneOf"
+				if isTrue(call.Args[1]) {
+					f = "OneOfCaseInsensitive"
+				}
+				return "", "", fmt.Sprintf("stringvalidator.%s(%s)", f, g.sliceArgs(call.Args[0])), true
+			}
+		}
+	case "Int64", "Float64":
+		prefix := strings.TrimSuffix(p.kind, "64")
+		switch strings.TrimPrefix(name, "validation."+prefix) {
+		case "Between":
+			g.use(p.validatorPkg)
+			return "", "", fmt.Sprintf("%svalidator.Between(%s)", p.pkg, args()), true
+		case "AtLeast":
+			g.use(p.validatorPkg)
+			return "", "", fmt.Sprintf("%svalidator.AtLeast(%s)", p.pkg, args()), true
+		case "AtMost":
+			g.use(p.validatorPkg)
+			return "", "", fmt.Sprintf("%svalidator.AtMost(%s)", p.pkg, args()), true
+		case "InSlice":
+			if isCall && len(call.Args) == 1 {
+				g.use(p.validatorPkg)
+				return "", "", fmt.Sprintf("%svalidator.OneOf(%s)", p.pkg, g.sliceArgs(call.Args[0])), true
+			}
+		}
+	}
+
+	return "", "", "", false
+}
+
+// sliceArgs renders a slice argument as variadic arguments.
+func (g *generator) sliceArgs(expr ast.Expr) string {
+	if lit, ok := expr.(*ast.CompositeLit); ok {
+		var s []string
+		for _, elt := range lit.Elts {
+			s = append(s, g.exprString(elt))
+		}
+		return strings.Join(s, ", ")
+	}
+
+	return g.exprString(expr) + "..."
+}
+
+func (g *generator) exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+
+	if err := format.Node(&buf, g.fset, expr); err != nil {
+		return fmt.Sprintf("%T", expr)
+	}
+
+	return buf.String()
+}
+
+func (g *generator) nestedModelName(fieldName string) string {
+	name := convert.ToLowercasePrefix(fieldName) + "Model"
+
+	for i := 2; slices.ContainsFunc(g.models, func(m *model) bool { return m.Name == name }); i++ {
+		name = fmt.Sprintf("%s%dModel", convert.ToLowercasePrefix(fieldName), i)
+	}
+
+	return name
+}
+
+func fieldName(attr *sdkAttribute) string {
+	if name, ok := strings.CutPrefix(attr.Key, "names.Attr"); ok {
+		return name
+	}
+
+	return convert.ToFieldName(attr.Name)
+}